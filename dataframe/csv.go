@@ -0,0 +1,854 @@
+package dataframe
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/poopoothegorilla/fastframe/series"
+)
+
+// csvInferRows is the number of rows sampled when inferring the type of a CSV
+// column.
+const csvInferRows = 100
+
+// csvDateLayout is the layout used to write Date values.
+const csvDateLayout = "2006-01-02"
+
+// csvTimestampLayouts are the layouts tried, in order, when parsing a CSV value
+// as a timestamp.
+var csvTimestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// CSVOption configures how CSV data is read or written.
+type CSVOption func(*csvConfig)
+
+type csvConfig struct {
+	delimiter rune
+	null      string
+	precision int
+
+	noHeader   bool
+	names      []string
+	skipRows   int
+	comment    rune
+	nullValues []string
+	columns    []string
+}
+
+func newCSVConfig(opts ...CSVOption) *csvConfig {
+	cfg := &csvConfig{
+		precision: -1,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	return cfg
+}
+
+// WithCSVDelimiter sets the field delimiter of the CSV.
+func WithCSVDelimiter(delimiter rune) CSVOption {
+	return func(cfg *csvConfig) {
+		cfg.delimiter = delimiter
+	}
+}
+
+// WithCSVNull sets the token written in place of null values. The default is
+// an empty string.
+func WithCSVNull(null string) CSVOption {
+	return func(cfg *csvConfig) {
+		cfg.null = null
+	}
+}
+
+// WithCSVPrecision sets the number of digits written after the decimal point
+// of float values. A negative precision, the default, writes the smallest
+// number of digits needed to represent the value exactly.
+func WithCSVPrecision(precision int) CSVOption {
+	return func(cfg *csvConfig) {
+		cfg.precision = precision
+	}
+}
+
+// WithCSVNoHeader reads the first row of the CSV as data instead of as the
+// header. Columns are named by their position unless WithCSVNames is used.
+func WithCSVNoHeader() CSVOption {
+	return func(cfg *csvConfig) {
+		cfg.noHeader = true
+	}
+}
+
+// WithCSVNames sets the column names, replacing the names in the header.
+func WithCSVNames(names ...string) CSVOption {
+	return func(cfg *csvConfig) {
+		cfg.names = names
+	}
+}
+
+// WithCSVSkipRows skips the first n rows of the CSV, before the header.
+func WithCSVSkipRows(n int) CSVOption {
+	return func(cfg *csvConfig) {
+		cfg.skipRows = n
+	}
+}
+
+// WithCSVComment ignores lines beginning with the comment character.
+func WithCSVComment(comment rune) CSVOption {
+	return func(cfg *csvConfig) {
+		cfg.comment = comment
+	}
+}
+
+// WithCSVNullValues sets the values which are read as nulls in every column,
+// such as "NA" or "null". Empty values in non String columns are always read
+// as nulls.
+func WithCSVNullValues(values ...string) CSVOption {
+	return func(cfg *csvConfig) {
+		cfg.nullValues = values
+	}
+}
+
+// WithCSVColumns only reads the columns with the given names. Columns are
+// ordered as they appear in the CSV.
+func WithCSVColumns(names ...string) CSVOption {
+	return func(cfg *csvConfig) {
+		cfg.columns = names
+	}
+}
+
+// isNull reports whether val is one of the configured null values.
+func (cfg *csvConfig) isNull(val string) bool {
+	for _, v := range cfg.nullValues {
+		if val == v {
+			return true
+		}
+	}
+	return false
+}
+
+// NewFromCSV creates a DataFrame from a CSV reader.
+//
+// The CSV is read in batches of batchSize rows, or all at once if batchSize is
+// not positive, using a CSVReader. Columns with a type in tList are parsed
+// directly into that type. The type of every other column is inferred from the
+// first rows of the CSV. Supported types are Int8, Int16, Int32, Int64, Uint8,
+// Uint16, Uint32, Uint64, Float32, Float64, Decimal128, Boolean, Timestamp,
+// Date32, Date64, Duration, Binary, FixedSizeBinary and String, of which small
+// integer, unsigned, Decimal128, Date, Duration and binary columns are never
+// inferred. Dates are parsed with the timestamp layouts, durations with
+// time.ParseDuration, decimals with series.ParseDecimal and binary values as
+// hexadecimal digits. Empty values in non String columns are stored as
+// nulls. A panic is triggered if a value can not be parsed into the type of its
+// column. The CSV dialect is configured with CSVOptions, and the settings of r
+// are restored once the CSV is read.
+func NewFromCSV(pool memory.Allocator, r *csv.Reader, batchSize int, tList map[string]arrow.DataType, opts ...CSVOption) DataFrame {
+	defer func(comma, comment rune) {
+		r.Comma, r.Comment = comma, comment
+	}(r.Comma, r.Comment)
+
+	rdr := NewCSVReader(pool, r, batchSize, tList, opts...)
+	defer rdr.Release()
+
+	var records []array.Record
+	for rdr.Next() {
+		record := rdr.Record()
+		record.Retain()
+		defer record.Release()
+		records = append(records, record)
+	}
+	if err := rdr.Err(); err != nil {
+		panic(fmt.Sprintf("dataframe: new_from_csv: %s", err))
+	}
+
+	if len(records) == 0 {
+		record := rdr.newRecord()
+		defer record.Release()
+		records = append(records, record)
+	}
+
+	return newFromRecordBatches(pool, records)
+}
+
+// CSVReader reads Arrow records from a CSV reader in batches of rows. It
+// implements the Arrow array.RecordReader interface.
+//
+// Unless WithCSVNoHeader is used the first row of the CSV is used as the
+// header. Column types are resolved when the CSVReader is created, either from
+// the type list or by inferring them from the first rows of the CSV.
+type CSVReader struct {
+	refCount  int64
+	pool      memory.Allocator
+	r         *csv.Reader
+	batchSize int
+	cfg       *csvConfig
+
+	schema *arrow.Schema
+	cols   []csvColumn
+	// indices holds the position in the CSV rows of each column.
+	indices []int
+	// pending holds rows read during type inference which have not been
+	// emitted yet.
+	pending [][]string
+	line    int
+
+	cur  array.Record
+	err  error
+	done bool
+}
+
+// NewCSVReader creates a CSVReader which emits records of batchSize rows. If
+// batchSize is not positive all rows are emitted as a single record. A panic
+// is triggered if the header or the rows used for type inference can not be
+// read.
+//
+// WithCSVDelimiter and WithCSVComment set the Comma and Comment fields of r,
+// which are left set as r is read from until the last record is emitted.
+func NewCSVReader(pool memory.Allocator, r *csv.Reader, batchSize int, tList map[string]arrow.DataType, opts ...CSVOption) *CSVReader {
+	cfg := newCSVConfig(opts...)
+	if cfg.delimiter != 0 {
+		r.Comma = cfg.delimiter
+	}
+	if cfg.comment != 0 {
+		r.Comment = cfg.comment
+	}
+
+	rdr := &CSVReader{
+		refCount:  1,
+		pool:      pool,
+		r:         r,
+		batchSize: batchSize,
+		cfg:       cfg,
+	}
+	rdr.skipRows()
+	headers := rdr.readHeaders()
+
+	if len(cfg.columns) > 0 {
+		for _, name := range cfg.columns {
+			var found bool
+			for i, header := range headers {
+				if header == name {
+					rdr.indices = append(rdr.indices, i)
+					found = true
+					break
+				}
+			}
+			if !found {
+				panic(fmt.Sprintf("dataframe: new_csv_reader: no column named %q", name))
+			}
+		}
+		sort.Ints(rdr.indices)
+	} else {
+		rdr.indices = make([]int, len(headers))
+		for i := range headers {
+			rdr.indices[i] = i
+		}
+	}
+
+	var inferred bool
+	fields := make([]arrow.Field, len(rdr.indices))
+	rdr.cols = make([]csvColumn, len(rdr.indices))
+	for j, i := range rdr.indices {
+		header := headers[i]
+		t, ok := tList[header]
+		if !ok {
+			if !inferred {
+				rdr.readPending()
+				inferred = true
+			}
+			t = inferCSVType(rdr.pending, i, cfg)
+		}
+		fields[j] = arrow.Field{Name: header, Type: t, Nullable: true}
+		rdr.cols[j] = newCSVColumn(pool, t)
+	}
+	rdr.schema = arrow.NewSchema(fields, nil)
+
+	return rdr
+}
+
+// skipRows discards the rows skipped by WithCSVSkipRows.
+func (r *CSVReader) skipRows() {
+	if r.cfg.skipRows <= 0 {
+		return
+	}
+
+	// NOTE: skipped rows may have a different number of fields than the data.
+	fieldsPerRecord := r.r.FieldsPerRecord
+	r.r.FieldsPerRecord = -1
+	for i := 0; i < r.cfg.skipRows; i++ {
+		if _, err := r.r.Read(); err != nil {
+			panic(fmt.Sprintf("dataframe: new_csv_reader: %s", err))
+		}
+		r.line++
+	}
+	r.r.FieldsPerRecord = fieldsPerRecord
+}
+
+// readHeaders returns the column names from the header row, the WithCSVNames
+// option or the positions of the columns in the first row.
+func (r *CSVReader) readHeaders() []string {
+	row, err := r.r.Read()
+	if err != nil {
+		panic(fmt.Sprintf("dataframe: new_csv_reader: %s", err))
+	}
+	// NOTE: the csv.Reader may reuse the backing array of returned rows.
+	row = append([]string(nil), row...)
+	if r.cfg.noHeader {
+		r.pending = append(r.pending, row)
+	} else {
+		r.line++
+	}
+
+	if len(r.cfg.names) > 0 {
+		if len(r.cfg.names) != len(row) {
+			panic(fmt.Sprintf("dataframe: new_csv_reader: %d names given for %d columns", len(r.cfg.names), len(row)))
+		}
+		return append([]string(nil), r.cfg.names...)
+	}
+	if r.cfg.noHeader {
+		headers := make([]string, len(row))
+		for i := range row {
+			headers[i] = strconv.Itoa(i)
+		}
+		return headers
+	}
+
+	return row
+}
+
+// readPending reads the rows used for type inference.
+func (r *CSVReader) readPending() {
+	for len(r.pending) < csvInferRows {
+		row, err := r.r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			panic(fmt.Sprintf("dataframe: new_csv_reader: %s", err))
+		}
+		r.pending = append(r.pending, append([]string(nil), row...))
+	}
+}
+
+// Retain increases the reference count by 1.
+func (r *CSVReader) Retain() {
+	atomic.AddInt64(&r.refCount, 1)
+}
+
+// Release decreases the reference count by 1. When the reference count goes to
+// zero the current record and builders are released.
+func (r *CSVReader) Release() {
+	if atomic.AddInt64(&r.refCount, -1) == 0 {
+		if r.cur != nil {
+			r.cur.Release()
+			r.cur = nil
+		}
+		for _, c := range r.cols {
+			c.b.Release()
+		}
+		r.cols = nil
+	}
+}
+
+// Schema returns the Arrow schema of the records.
+func (r *CSVReader) Schema() *arrow.Schema {
+	return r.schema
+}
+
+// Record returns the current record. The record is only valid until the next
+// call to Next.
+func (r *CSVReader) Record() array.Record {
+	return r.cur
+}
+
+// Err returns the first error encountered while reading the CSV.
+func (r *CSVReader) Err() error {
+	return r.err
+}
+
+// Next reads the next batch of rows and reports whether a record was created.
+// When Next returns false Err should be checked.
+func (r *CSVReader) Next() bool {
+	if r.cur != nil {
+		r.cur.Release()
+		r.cur = nil
+	}
+	if r.done || r.err != nil {
+		return false
+	}
+
+	var n int
+	for r.batchSize <= 0 || n < r.batchSize {
+		row, err := r.nextRow()
+		if err == io.EOF {
+			r.done = true
+			break
+		}
+		if err != nil {
+			r.err = err
+			return false
+		}
+		r.line++
+
+		for j, c := range r.cols {
+			if r.indices[j] >= len(row) {
+				r.err = fmt.Errorf("row %d: column %q: missing value", r.line, r.schema.Field(j).Name)
+				return false
+			}
+			val := row[r.indices[j]]
+			if r.cfg.isNull(val) {
+				c.b.AppendNull()
+				continue
+			}
+			if err := c.append(val); err != nil {
+				r.err = fmt.Errorf("row %d: column %q: %w", r.line, r.schema.Field(j).Name, err)
+				return false
+			}
+		}
+		n++
+	}
+	if n == 0 {
+		return false
+	}
+
+	r.cur = r.newRecord()
+	return true
+}
+
+// nextRow returns the next pending row or reads a new row from the CSV.
+func (r *CSVReader) nextRow() ([]string, error) {
+	if len(r.pending) > 0 {
+		row := r.pending[0]
+		r.pending = r.pending[1:]
+		return row, nil
+	}
+
+	return r.r.Read()
+}
+
+// newRecord creates a record from the values appended to the builders and
+// resets the builders.
+func (r *CSVReader) newRecord() array.Record {
+	cols := make([]array.Interface, len(r.cols))
+	for i, c := range r.cols {
+		cols[i] = c.b.NewArray()
+		defer cols[i].Release()
+	}
+
+	return array.NewRecord(r.schema, cols, -1)
+}
+
+// inferCSVType returns the narrowest type which can hold every sampled value in
+// the i column of rows. Empty and null values are ignored.
+func inferCSVType(rows [][]string, i int, cfg *csvConfig) arrow.DataType {
+	if len(rows) > csvInferRows {
+		rows = rows[:csvInferRows]
+	}
+
+	isInt, isFloat, isBool, isTimestamp := true, true, true, true
+	var n int
+	for _, row := range rows {
+		// NOTE: short rows are reported by CSVReader.Next.
+		if i >= len(row) {
+			continue
+		}
+		val := row[i]
+		if val == "" || cfg.isNull(val) {
+			continue
+		}
+		n++
+
+		if isInt {
+			_, err := strconv.ParseInt(val, 10, 64)
+			isInt = err == nil
+		}
+		if isFloat {
+			_, err := strconv.ParseFloat(val, 64)
+			isFloat = err == nil
+		}
+		if isBool {
+			// NOTE: the values accepted as booleans must match csvColumn.append.
+			_, err := strconv.ParseBool(val)
+			isBool = err == nil
+		}
+		if isTimestamp {
+			_, err := parseCSVTime(val, time.UTC)
+			isTimestamp = err == nil
+		}
+	}
+
+	switch {
+	case n == 0:
+		return arrow.BinaryTypes.String
+	case isInt:
+		return arrow.PrimitiveTypes.Int64
+	case isFloat:
+		return arrow.PrimitiveTypes.Float64
+	case isBool:
+		return arrow.FixedWidthTypes.Boolean
+	case isTimestamp:
+		return arrow.FixedWidthTypes.Timestamp_ns
+	default:
+		return arrow.BinaryTypes.String
+	}
+}
+
+// csvColumn parses CSV values of a single type into an Arrow builder.
+type csvColumn struct {
+	typ arrow.DataType
+	b   array.Builder
+	loc *time.Location
+}
+
+// newCSVColumn returns a csvColumn for values of type t.
+func newCSVColumn(pool memory.Allocator, t arrow.DataType) csvColumn {
+	c := csvColumn{typ: t}
+	switch t := t.(type) {
+	case *arrow.Int32Type:
+		c.b = array.NewInt32Builder(pool)
+	case *arrow.Int64Type:
+		c.b = array.NewInt64Builder(pool)
+	case *arrow.Int8Type:
+		c.b = array.NewInt8Builder(pool)
+	case *arrow.Int16Type:
+		c.b = array.NewInt16Builder(pool)
+	case *arrow.Uint8Type:
+		c.b = array.NewUint8Builder(pool)
+	case *arrow.Uint16Type:
+		c.b = array.NewUint16Builder(pool)
+	case *arrow.Uint32Type:
+		c.b = array.NewUint32Builder(pool)
+	case *arrow.Uint64Type:
+		c.b = array.NewUint64Builder(pool)
+	case *arrow.Float32Type:
+		c.b = array.NewFloat32Builder(pool)
+	case *arrow.Float64Type:
+		c.b = array.NewFloat64Builder(pool)
+	case *arrow.BooleanType:
+		c.b = array.NewBooleanBuilder(pool)
+	case *arrow.TimestampType:
+		loc, err := series.TimeLocation(t.TimeZone)
+		if err != nil {
+			panic(fmt.Sprintf("dataframe: csv: %s", err))
+		}
+		c.b = array.NewTimestampBuilder(pool, t)
+		c.loc = loc
+	case *arrow.Date32Type:
+		c.b = array.NewDate32Builder(pool)
+	case *arrow.Date64Type:
+		c.b = array.NewDate64Builder(pool)
+	case *arrow.DurationType:
+		c.b = array.NewDurationBuilder(pool, t)
+	case *arrow.Decimal128Type:
+		c.b = array.NewDecimal128Builder(pool, t)
+	case *arrow.BinaryType:
+		c.b = array.NewBinaryBuilder(pool, t)
+	case *arrow.FixedSizeBinaryType:
+		c.b = array.NewFixedSizeBinaryBuilder(pool, t)
+	case *arrow.StringType:
+		c.b = array.NewStringBuilder(pool)
+	default:
+		panic(fmt.Sprintf("dataframe: csv: unsupported type %s", t))
+	}
+
+	return c
+}
+
+// append parses val and appends it to the builder. Empty values are appended
+// as nulls unless the column is a String.
+func (c csvColumn) append(val string) error {
+	if sb, ok := c.b.(*array.StringBuilder); ok {
+		sb.Append(val)
+		return nil
+	}
+	if val == "" {
+		c.b.AppendNull()
+		return nil
+	}
+
+	switch b := c.b.(type) {
+	case *array.Int32Builder:
+		v, err := strconv.ParseInt(val, 10, 32)
+		if err != nil {
+			return err
+		}
+		b.Append(int32(v))
+	case *array.Int64Builder:
+		v, err := strconv.ParseInt(val, 10, 64)
+		if err != nil {
+			return err
+		}
+		b.Append(v)
+	case *array.Int8Builder:
+		v, err := strconv.ParseInt(val, 10, 8)
+		if err != nil {
+			return err
+		}
+		b.Append(int8(v))
+	case *array.Int16Builder:
+		v, err := strconv.ParseInt(val, 10, 16)
+		if err != nil {
+			return err
+		}
+		b.Append(int16(v))
+	case *array.Uint8Builder:
+		v, err := strconv.ParseUint(val, 10, 8)
+		if err != nil {
+			return err
+		}
+		b.Append(uint8(v))
+	case *array.Uint16Builder:
+		v, err := strconv.ParseUint(val, 10, 16)
+		if err != nil {
+			return err
+		}
+		b.Append(uint16(v))
+	case *array.Uint32Builder:
+		v, err := strconv.ParseUint(val, 10, 32)
+		if err != nil {
+			return err
+		}
+		b.Append(uint32(v))
+	case *array.Uint64Builder:
+		v, err := strconv.ParseUint(val, 10, 64)
+		if err != nil {
+			return err
+		}
+		b.Append(v)
+	case *array.Float32Builder:
+		v, err := strconv.ParseFloat(val, 32)
+		if err != nil {
+			return err
+		}
+		b.Append(float32(v))
+	case *array.Float64Builder:
+		v, err := strconv.ParseFloat(val, 64)
+		if err != nil {
+			return err
+		}
+		b.Append(v)
+	case *array.BooleanBuilder:
+		v, err := strconv.ParseBool(val)
+		if err != nil {
+			return err
+		}
+		b.Append(v)
+	case *array.Decimal128Builder:
+		v, err := series.ParseDecimal(val, c.typ.(*arrow.Decimal128Type))
+		if err != nil {
+			return err
+		}
+		b.Append(v)
+	case *array.BinaryBuilder:
+		v, err := series.HexEncoding.Decode(val)
+		if err != nil {
+			return err
+		}
+		b.Append(v)
+	case *array.FixedSizeBinaryBuilder:
+		v, err := series.HexEncoding.Decode(val)
+		if err != nil {
+			return err
+		}
+		if w := c.typ.(*arrow.FixedSizeBinaryType).ByteWidth; len(v) != w {
+			return fmt.Errorf("parsing %q: %d bytes, expected %d", val, len(v), w)
+		}
+		b.Append(v)
+	case *array.TimestampBuilder:
+		v, err := parseCSVTime(val, c.loc)
+		if err != nil {
+			return err
+		}
+		b.Append(series.TimeToTimestamp(v, c.typ.(*arrow.TimestampType).Unit))
+	case *array.Date32Builder:
+		v, err := parseCSVTime(val, time.UTC)
+		if err != nil {
+			return err
+		}
+		b.Append(series.TimeToDate32(v))
+	case *array.Date64Builder:
+		v, err := parseCSVTime(val, time.UTC)
+		if err != nil {
+			return err
+		}
+		b.Append(arrow.Date64(int64(series.TimeToDate32(v)) * int64(24*time.Hour/time.Millisecond)))
+	case *array.DurationBuilder:
+		v, err := time.ParseDuration(val)
+		if err != nil {
+			return err
+		}
+		b.Append(arrow.Duration(v / series.UnitDuration(c.typ.(*arrow.DurationType).Unit)))
+	}
+
+	return nil
+}
+
+// parseCSVTime parses val with the first matching layout in
+// csvTimestampLayouts. Values without a time zone are parsed in loc.
+func parseCSVTime(val string, loc *time.Location) (time.Time, error) {
+	for _, layout := range csvTimestampLayouts {
+		if v, err := time.ParseInLocation(layout, val, loc); err == nil {
+			return v, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("parsing time %q: no matching layout", val)
+}
+
+// WriteCSV writes the DataFrame with a header row to a CSV writer.
+//
+// Null values are written as the token set by WithCSVNull, timestamps are
+// written in RFC 3339 format in the time zone of their type, dates as
+// 2006-01-02, durations as time.Duration strings such as 1h30m0s, decimals
+// with all the fractional digits of their scale and binary values as
+// hexadecimal digits. The delimiter set by WithCSVDelimiter only applies to
+// this write, the Comma field of w is restored before returning.
+func (df DataFrame) WriteCSV(w *csv.Writer, opts ...CSVOption) error {
+	df.Retain()
+	defer df.Release()
+
+	cfg := newCSVConfig(opts...)
+	if cfg.delimiter != 0 {
+		defer func(comma rune) { w.Comma = comma }(w.Comma)
+		w.Comma = cfg.delimiter
+	}
+
+	formatters := make([]func(int) string, len(df.series))
+	for i, s := range df.series {
+		f, err := newCSVFormatter(s, cfg)
+		if err != nil {
+			return fmt.Errorf("dataframe: write_csv: column %q: %w", s.Name(), err)
+		}
+		formatters[i] = f
+	}
+
+	if err := w.Write(df.Headers()); err != nil {
+		return fmt.Errorf("dataframe: write_csv: %w", err)
+	}
+
+	numRows, numCols := df.Dims()
+	row := make([]string, numCols)
+	for i := 0; i < numRows; i++ {
+		for j, s := range df.series {
+			if s.IsNull(i) {
+				row[j] = cfg.null
+				continue
+			}
+			row[j] = formatters[j](i)
+		}
+		if err := w.Write(row); err != nil {
+			return fmt.Errorf("dataframe: write_csv: %w", err)
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return fmt.Errorf("dataframe: write_csv: %w", err)
+	}
+
+	return nil
+}
+
+// newCSVFormatter returns a function which formats the value at position i of
+// the s Series as a CSV value.
+func newCSVFormatter(s series.Series, cfg *csvConfig) (func(int) string, error) {
+	switch a := s.Interface.(type) {
+	case *array.Int32:
+		return func(i int) string {
+			return strconv.FormatInt(int64(a.Value(i)), 10)
+		}, nil
+	case *array.Int64:
+		return func(i int) string {
+			return strconv.FormatInt(a.Value(i), 10)
+		}, nil
+	case *array.Int8:
+		return func(i int) string {
+			return strconv.FormatInt(int64(a.Value(i)), 10)
+		}, nil
+	case *array.Int16:
+		return func(i int) string {
+			return strconv.FormatInt(int64(a.Value(i)), 10)
+		}, nil
+	case *array.Uint8:
+		return func(i int) string {
+			return strconv.FormatUint(uint64(a.Value(i)), 10)
+		}, nil
+	case *array.Uint16:
+		return func(i int) string {
+			return strconv.FormatUint(uint64(a.Value(i)), 10)
+		}, nil
+	case *array.Uint32:
+		return func(i int) string {
+			return strconv.FormatUint(uint64(a.Value(i)), 10)
+		}, nil
+	case *array.Uint64:
+		return func(i int) string {
+			return strconv.FormatUint(uint64(a.Value(i)), 10)
+		}, nil
+	case *array.Float32:
+		return func(i int) string {
+			return strconv.FormatFloat(float64(a.Value(i)), 'f', cfg.precision, 32)
+		}, nil
+	case *array.Float64:
+		return func(i int) string {
+			return strconv.FormatFloat(a.Value(i), 'f', cfg.precision, 64)
+		}, nil
+	case *array.Boolean:
+		return func(i int) string {
+			return strconv.FormatBool(a.Value(i))
+		}, nil
+	case *array.Timestamp:
+		t := s.DataType().(*arrow.TimestampType)
+		loc, err := series.TimeLocation(t.TimeZone)
+		if err != nil {
+			return nil, err
+		}
+		return func(i int) string {
+			return series.TimestampToTime(a.Value(i), t.Unit).In(loc).Format(time.RFC3339Nano)
+		}, nil
+	case *array.Date32:
+		return func(i int) string {
+			return series.Date32ToTime(a.Value(i)).Format(csvDateLayout)
+		}, nil
+	case *array.Date64:
+		return func(i int) string {
+			return series.Date64ToTime(a.Value(i)).Format(csvDateLayout)
+		}, nil
+	case *array.Duration:
+		unit := series.UnitDuration(s.DataType().(*arrow.DurationType).Unit)
+		return func(i int) string {
+			return (time.Duration(a.Value(i)) * unit).String()
+		}, nil
+	case *array.Decimal128:
+		scale := s.DataType().(*arrow.Decimal128Type).Scale
+		return func(i int) string {
+			return series.FormatDecimal(a.Value(i), scale)
+		}, nil
+	case *array.Binary:
+		return func(i int) string {
+			return series.HexEncoding.Encode(a.Value(i))
+		}, nil
+	case *array.FixedSizeBinary:
+		return func(i int) string {
+			return series.HexEncoding.Encode(a.Value(i))
+		}, nil
+	case *array.String:
+		return a.Value, nil
+	default:
+		if s.DataType() == series.Categorical {
+			return func(i int) string {
+				return s.Value(i).(string)
+			}, nil
+		}
+		return nil, fmt.Errorf("unsupported type %s", s.DataType())
+	}
+}
@@ -1,7 +1,6 @@
 package dataframe
 
 import (
-	"encoding/csv"
 	"fmt"
 	"io"
 	"math"
@@ -10,9 +9,10 @@ import (
 
 	"github.com/apache/arrow/go/arrow"
 	"github.com/apache/arrow/go/arrow/array"
-	"github.com/apache/arrow/go/arrow/bitutil"
+	"github.com/apache/arrow/go/arrow/decimal128"
 	"github.com/apache/arrow/go/arrow/memory"
 	"github.com/poopoothegorilla/fastframe/series"
+	"gonum.org/v1/gonum/blas/blas64"
 	"gonum.org/v1/gonum/mat"
 )
 
@@ -24,6 +24,19 @@ type DataFrame struct {
 	reader io.Reader
 }
 
+// Axis is the direction a Series is broadcast across a DataFrame.
+type Axis int
+
+const (
+	// AxisRows broadcasts a Series holding a value for every column to each
+	// row, so the values of a column are combined with the value at the
+	// position of the column.
+	AxisRows Axis = iota
+	// AxisColumns broadcasts a Series holding a value for every row to each
+	// column, so the values of a column are combined with the Series.
+	AxisColumns
+)
+
 // NewFromRecords creates a DataFrame from Arrow records.
 //
 // TODO(poopoothegorilla): optimizations are needed here
@@ -50,87 +63,347 @@ func NewFromRecords(pool memory.Allocator, records []array.Record) DataFrame {
 	nulls := make([]bool, int(numRows))
 	for i, field := range schema.Fields() {
 		var rowi int
-		switch field.Type {
-		case arrow.PrimitiveTypes.Int32:
+		switch field.Type.ID() {
+		case arrow.INT32:
 			vals := make([]int32, int(numRows))
 			for _, record := range records {
-				var nullMask []byte
+				col := record.Column(i)
 				var newVals []int32
-				switch c := record.Column(i).(type) {
+				switch c := col.(type) {
 				case *array.Int32:
 					newVals = c.Int32Values()
-					nullMask = c.NullBitmapBytes()
 				case series.Series:
 					newVals = c.Interface.(*array.Int32).Int32Values()
-					nullMask = c.NullBitmapBytes()
 				}
 				for j, newVal := range newVals {
-					nulls[rowi] = bitutil.BitIsSet(nullMask, j)
+					nulls[rowi] = col.IsValid(j)
 					vals[rowi] = newVal
 					rowi++
 				}
 			}
 			ss[i] = series.FromInt32(pool, field, vals, nulls)
-		case arrow.PrimitiveTypes.Int64:
+		case arrow.INT64:
 			vals := make([]int64, int(numRows))
 			for _, record := range records {
-				var nullMask []byte
+				col := record.Column(i)
 				var newVals []int64
-				switch c := record.Column(i).(type) {
+				switch c := col.(type) {
 				case *array.Int64:
 					newVals = c.Int64Values()
-					nullMask = c.NullBitmapBytes()
 				case series.Series:
 					newVals = c.Interface.(*array.Int64).Int64Values()
-					nullMask = c.NullBitmapBytes()
 				}
 				for j, newVal := range newVals {
-					nulls[rowi] = bitutil.BitIsSet(nullMask, j)
+					nulls[rowi] = col.IsValid(j)
 					vals[rowi] = newVal
 					rowi++
 				}
 			}
 			ss[i] = series.FromInt64(pool, field, vals, nulls)
-		case arrow.PrimitiveTypes.Float32:
+		case arrow.INT8:
+			vals := make([]int8, int(numRows))
+			for _, record := range records {
+				col := record.Column(i)
+				var newVals []int8
+				switch c := col.(type) {
+				case *array.Int8:
+					newVals = c.Int8Values()
+				case series.Series:
+					newVals = c.Interface.(*array.Int8).Int8Values()
+				}
+				for j, newVal := range newVals {
+					nulls[rowi] = col.IsValid(j)
+					vals[rowi] = newVal
+					rowi++
+				}
+			}
+			ss[i] = series.FromInt8(pool, field, vals, nulls)
+		case arrow.INT16:
+			vals := make([]int16, int(numRows))
+			for _, record := range records {
+				col := record.Column(i)
+				var newVals []int16
+				switch c := col.(type) {
+				case *array.Int16:
+					newVals = c.Int16Values()
+				case series.Series:
+					newVals = c.Interface.(*array.Int16).Int16Values()
+				}
+				for j, newVal := range newVals {
+					nulls[rowi] = col.IsValid(j)
+					vals[rowi] = newVal
+					rowi++
+				}
+			}
+			ss[i] = series.FromInt16(pool, field, vals, nulls)
+		case arrow.UINT8:
+			vals := make([]uint8, int(numRows))
+			for _, record := range records {
+				col := record.Column(i)
+				var newVals []uint8
+				switch c := col.(type) {
+				case *array.Uint8:
+					newVals = c.Uint8Values()
+				case series.Series:
+					newVals = c.Interface.(*array.Uint8).Uint8Values()
+				}
+				for j, newVal := range newVals {
+					nulls[rowi] = col.IsValid(j)
+					vals[rowi] = newVal
+					rowi++
+				}
+			}
+			ss[i] = series.FromUint8(pool, field, vals, nulls)
+		case arrow.UINT16:
+			vals := make([]uint16, int(numRows))
+			for _, record := range records {
+				col := record.Column(i)
+				var newVals []uint16
+				switch c := col.(type) {
+				case *array.Uint16:
+					newVals = c.Uint16Values()
+				case series.Series:
+					newVals = c.Interface.(*array.Uint16).Uint16Values()
+				}
+				for j, newVal := range newVals {
+					nulls[rowi] = col.IsValid(j)
+					vals[rowi] = newVal
+					rowi++
+				}
+			}
+			ss[i] = series.FromUint16(pool, field, vals, nulls)
+		case arrow.UINT32:
+			vals := make([]uint32, int(numRows))
+			for _, record := range records {
+				col := record.Column(i)
+				var newVals []uint32
+				switch c := col.(type) {
+				case *array.Uint32:
+					newVals = c.Uint32Values()
+				case series.Series:
+					newVals = c.Interface.(*array.Uint32).Uint32Values()
+				}
+				for j, newVal := range newVals {
+					nulls[rowi] = col.IsValid(j)
+					vals[rowi] = newVal
+					rowi++
+				}
+			}
+			ss[i] = series.FromUint32(pool, field, vals, nulls)
+		case arrow.UINT64:
+			vals := make([]uint64, int(numRows))
+			for _, record := range records {
+				col := record.Column(i)
+				var newVals []uint64
+				switch c := col.(type) {
+				case *array.Uint64:
+					newVals = c.Uint64Values()
+				case series.Series:
+					newVals = c.Interface.(*array.Uint64).Uint64Values()
+				}
+				for j, newVal := range newVals {
+					nulls[rowi] = col.IsValid(j)
+					vals[rowi] = newVal
+					rowi++
+				}
+			}
+			ss[i] = series.FromUint64(pool, field, vals, nulls)
+		case arrow.FLOAT32:
 			vals := make([]float32, int(numRows))
 			for _, record := range records {
-				var nullMask []byte
+				col := record.Column(i)
 				var newVals []float32
-				switch c := record.Column(i).(type) {
+				switch c := col.(type) {
 				case *array.Float32:
 					newVals = c.Float32Values()
-					nullMask = c.NullBitmapBytes()
 				case series.Series:
 					newVals = c.Interface.(*array.Float32).Float32Values()
-					nullMask = c.NullBitmapBytes()
 				}
 				for j, newVal := range newVals {
-					nulls[rowi] = bitutil.BitIsSet(nullMask, j)
+					nulls[rowi] = col.IsValid(j)
 					vals[rowi] = newVal
 					rowi++
 				}
 			}
 			ss[i] = series.FromFloat32(pool, field, vals, nulls)
-		case arrow.PrimitiveTypes.Float64:
+		case arrow.FLOAT64:
 			vals := make([]float64, int(numRows))
 			for _, record := range records {
-				var nullMask []byte
+				col := record.Column(i)
 				var newVals []float64
-				switch c := record.Column(i).(type) {
+				switch c := col.(type) {
 				case *array.Float64:
 					newVals = c.Float64Values()
-					nullMask = c.NullBitmapBytes()
 				case series.Series:
 					newVals = c.Interface.(*array.Float64).Float64Values()
-					nullMask = c.NullBitmapBytes()
 				}
 				for j, newVal := range newVals {
-					nulls[rowi] = bitutil.BitIsSet(nullMask, j)
+					nulls[rowi] = col.IsValid(j)
 					vals[rowi] = newVal
 					rowi++
 				}
 			}
 			ss[i] = series.FromFloat64(pool, field, vals, nulls)
+		case arrow.STRING:
+			vals := make([]string, int(numRows))
+			for _, record := range records {
+				var c *array.String
+				switch col := record.Column(i).(type) {
+				case *array.String:
+					c = col
+				case series.Series:
+					c = col.Interface.(*array.String)
+				}
+				for j := 0; j < c.Len(); j++ {
+					nulls[rowi] = c.IsValid(j)
+					vals[rowi] = c.Value(j)
+					rowi++
+				}
+			}
+			ss[i] = series.FromString(pool, field, vals, nulls)
+		case arrow.BOOL:
+			vals := make([]bool, int(numRows))
+			for _, record := range records {
+				var c *array.Boolean
+				switch col := record.Column(i).(type) {
+				case *array.Boolean:
+					c = col
+				case series.Series:
+					c = col.Interface.(*array.Boolean)
+				}
+				for j := 0; j < c.Len(); j++ {
+					nulls[rowi] = c.IsValid(j)
+					vals[rowi] = c.Value(j)
+					rowi++
+				}
+			}
+			b := array.NewBooleanBuilder(pool)
+			b.AppendValues(vals, nulls)
+			ss[i] = series.FromArrow(pool, field, b.NewArray())
+			b.Release()
+		case arrow.TIMESTAMP:
+			vals := make([]arrow.Timestamp, int(numRows))
+			for _, record := range records {
+				var c *array.Timestamp
+				switch col := record.Column(i).(type) {
+				case *array.Timestamp:
+					c = col
+				case series.Series:
+					c = col.Interface.(*array.Timestamp)
+				}
+				for j, newVal := range c.TimestampValues() {
+					nulls[rowi] = c.IsValid(j)
+					vals[rowi] = newVal
+					rowi++
+				}
+			}
+			b := array.NewTimestampBuilder(pool, field.Type.(*arrow.TimestampType))
+			b.AppendValues(vals, nulls)
+			ss[i] = series.FromArrow(pool, field, b.NewArray())
+			b.Release()
+		case arrow.DATE32:
+			vals := make([]arrow.Date32, int(numRows))
+			for _, record := range records {
+				var c *array.Date32
+				switch col := record.Column(i).(type) {
+				case *array.Date32:
+					c = col
+				case series.Series:
+					c = col.Interface.(*array.Date32)
+				}
+				for j, newVal := range c.Date32Values() {
+					nulls[rowi] = c.IsValid(j)
+					vals[rowi] = newVal
+					rowi++
+				}
+			}
+			ss[i] = series.FromDate32(pool, field, vals, nulls)
+		case arrow.DATE64:
+			vals := make([]arrow.Date64, int(numRows))
+			for _, record := range records {
+				var c *array.Date64
+				switch col := record.Column(i).(type) {
+				case *array.Date64:
+					c = col
+				case series.Series:
+					c = col.Interface.(*array.Date64)
+				}
+				for j, newVal := range c.Date64Values() {
+					nulls[rowi] = c.IsValid(j)
+					vals[rowi] = newVal
+					rowi++
+				}
+			}
+			ss[i] = series.FromDate64(pool, field, vals, nulls)
+		case arrow.DURATION:
+			vals := make([]arrow.Duration, int(numRows))
+			for _, record := range records {
+				var c *array.Duration
+				switch col := record.Column(i).(type) {
+				case *array.Duration:
+					c = col
+				case series.Series:
+					c = col.Interface.(*array.Duration)
+				}
+				for j, newVal := range c.DurationValues() {
+					nulls[rowi] = c.IsValid(j)
+					vals[rowi] = newVal
+					rowi++
+				}
+			}
+			ss[i] = series.FromDuration(pool, field, vals, nulls)
+		case arrow.DECIMAL:
+			vals := make([]decimal128.Num, int(numRows))
+			for _, record := range records {
+				var c *array.Decimal128
+				switch col := record.Column(i).(type) {
+				case *array.Decimal128:
+					c = col
+				case series.Series:
+					c = col.Interface.(*array.Decimal128)
+				}
+				for j, newVal := range c.Values() {
+					nulls[rowi] = c.IsValid(j)
+					vals[rowi] = newVal
+					rowi++
+				}
+			}
+			ss[i] = series.FromDecimal128(pool, field, vals, nulls)
+		case arrow.BINARY, arrow.FIXED_SIZE_BINARY:
+			vals := make([][]byte, int(numRows))
+			for _, record := range records {
+				col := record.Column(i)
+				if s, ok := col.(series.Series); ok {
+					col = s.Interface
+				}
+				c := col.(interface{ Value(int) []byte })
+				for j := 0; j < col.Len(); j++ {
+					nulls[rowi] = col.IsValid(j)
+					vals[rowi] = c.Value(j)
+					rowi++
+				}
+			}
+			ss[i] = series.FromInterface(pool, field, vals, nulls)
+		case arrow.DICTIONARY:
+			// NOTE: records may hold different categories, so the values are
+			// encoded again.
+			vals := make([]string, int(numRows))
+			for _, record := range records {
+				c, ok := record.Column(i).(series.Series)
+				if !ok || c.DataType() != series.Categorical {
+					panic("dataframe: new_from_records: unsupported type")
+				}
+				for j := 0; j < c.Len(); j++ {
+					nulls[rowi] = c.IsValid(j)
+					vals[rowi] = c.Value(j).(string)
+					rowi++
+				}
+			}
+			f := field
+			f.Type = arrow.BinaryTypes.String
+			str := series.FromString(pool, f, vals, nulls)
+			ss[i] = str.AsCategorical()
+			str.Release()
 		default:
 			panic("dataframe: new_from_records: unsupported type")
 		}
@@ -142,6 +415,31 @@ func NewFromRecords(pool memory.Allocator, records []array.Record) DataFrame {
 	}
 }
 
+// newFromRecord creates a DataFrame which shares the columns of a single Arrow
+// record without copying them.
+func newFromRecord(pool memory.Allocator, record array.Record) DataFrame {
+	ss := make([]series.Series, record.NumCols())
+	for i, col := range record.Columns() {
+		col.Retain()
+		ss[i] = series.FromArrow(pool, record.Schema().Field(i), col)
+	}
+
+	return DataFrame{
+		pool:   pool,
+		series: ss,
+	}
+}
+
+// newFromRecordBatches creates a DataFrame from Arrow records, sharing the
+// columns when there is only one record.
+func newFromRecordBatches(pool memory.Allocator, records []array.Record) DataFrame {
+	if len(records) == 1 {
+		return newFromRecord(pool, records[0])
+	}
+
+	return NewFromRecords(pool, records)
+}
+
 // NewFromSeries creates a DataFrame from Series.
 func NewFromSeries(pool memory.Allocator, series []series.Series) DataFrame {
 	for _, s := range series {
@@ -154,64 +452,47 @@ func NewFromSeries(pool memory.Allocator, series []series.Series) DataFrame {
 	}
 }
 
-// NewFromCSV creates a DataFrame from a CSV reader.
-//
-// TODO(poopoothegorilla): change the batchSize and type List to Optional
-// Option params
-func NewFromCSV(pool memory.Allocator, r *csv.Reader, batchSize int, tList map[string]arrow.DataType) DataFrame {
-	// TODO(poopoothegorilla): add batching
-	rows, err := r.ReadAll()
-	if err != nil {
-		panic(fmt.Sprintf("dataframe: new_from_csv: %s", err))
-	}
-
-	var (
-		sb *array.StringBuilder
-		// i32b *array.Int32Builder
-		// i64b *array.Int64Builder
-		// f32b *array.Float32Builder
-		// f64b *array.Float64Builder
-	)
-
-	// TODO(poopoothegorilla): what about csvs without headers
-	headers := rows[0]
-	ss := make([]series.Series, len(headers))
-
-	for i, header := range headers {
-		field := arrow.Field{Name: header, Nullable: true}
-
-		switch tList[header] {
-		// TODO(poopoothegorilla: add more types)
-		// case arrow.PrimitiveTypes.Int32:
-		// case arrow.PrimitiveTypes.Int64:
-		// case arrow.PrimitiveTypes.Float32:
-		// case arrow.PrimitiveTypes.Float64:
-		case arrow.BinaryTypes.String:
-			// String is default
-			fallthrough
-		default:
-			// STRING
-			field.Type = arrow.BinaryTypes.String
-			if sb == nil {
-				sb = array.NewStringBuilder(pool)
-				defer sb.Release()
-			}
-			vals := make([]string, len(rows)-1)
-			for j, row := range rows {
-				if j == 0 {
-					continue
-				}
-				vals[j-1] = string(row[i])
+// NewFromMatrix creates a DataFrame of Float64 Series from a gonum Matrix, with
+// a Series per column of the Matrix. Series are named by names, or by their
+// column index if names is nil. A panic is triggered if the number of names
+// does not match the number of columns.
+func NewFromMatrix(pool memory.Allocator, m mat.Matrix, names []string) DataFrame {
+	r, c := m.Dims()
+	if names != nil && len(names) != c {
+		panic(fmt.Sprintf("dataframe: new_from_matrix: %d names for %d columns", len(names), c))
+	}
+
+	var raw blas64.General
+	rm, isRaw := m.(mat.RawMatrixer)
+	if isRaw {
+		raw = rm.RawMatrix()
+	}
+
+	ss := make([]series.Series, c)
+	for j := 0; j < c; j++ {
+		name := strconv.Itoa(j)
+		if names != nil {
+			name = names[j]
+		}
+		field := arrow.Field{Name: name, Type: arrow.PrimitiveTypes.Float64}
+
+		vals := make([]float64, r)
+		for i := range vals {
+			if isRaw {
+				vals[i] = raw.Data[i*raw.Stride+j]
+			} else {
+				vals[i] = m.At(i, j)
 			}
-			sb.AppendValues(vals, nil)
-			ss[i] = series.FromArrow(pool, field, sb.NewArray())
 		}
+		ss[j] = series.FromFloat64(pool, field, vals, nil)
 	}
+	defer func() {
+		for _, s := range ss {
+			s.Release()
+		}
+	}()
 
-	return DataFrame{
-		pool:   pool,
-		series: ss,
-	}
+	return NewFromSeries(pool, ss)
 }
 
 //////////////
@@ -252,6 +533,46 @@ func (df DataFrame) Dims() (r, c int) {
 	return df.series[0].Len(), len(df.series)
 }
 
+// ToDense returns the DataFrame values as a gonum Dense matrix. The values are
+// copied a Series at a time and are the same as those returned by At.
+func (df DataFrame) ToDense() *mat.Dense {
+	df.Retain()
+	defer df.Release()
+
+	r, c := df.Dims()
+	if r == 0 || c == 0 {
+		return &mat.Dense{}
+	}
+
+	data := make([]float64, r*c)
+	for j, s := range df.series {
+		switch a := s.Interface.(type) {
+		case *array.Int32:
+			for i, v := range a.Int32Values() {
+				data[i*c+j] = float64(v)
+			}
+		case *array.Int64:
+			for i, v := range a.Int64Values() {
+				data[i*c+j] = float64(v)
+			}
+		case *array.Float32:
+			for i, v := range a.Float32Values() {
+				data[i*c+j] = float64(v)
+			}
+		case *array.Float64:
+			for i, v := range a.Float64Values() {
+				data[i*c+j] = v
+			}
+		default:
+			for i := 0; i < r; i++ {
+				data[i*c+j] = s.At(i, 0)
+			}
+		}
+	}
+
+	return mat.NewDense(r, c, data)
+}
+
 //////////////
 // NOTE: for arrow Table interface
 //////////////
@@ -365,7 +686,6 @@ func (df DataFrame) SeriesByName(name string) series.Series {
 	}
 
 	panic(fmt.Sprintf("dataframe: series_by_name: no series contain name %q", name))
-	return series.Series{}
 }
 
 // ApplyToSeries applies a function to each Series in the DataFrame.
@@ -408,7 +728,9 @@ func (df DataFrame) Head(n int) DataFrame {
 
 	ss := make([]series.Series, len(df.series))
 	for i, s := range df.series {
-		ss[i] = s.Head(n)
+		s2 := s.Head(n)
+		defer s2.Release()
+		ss[i] = s2
 	}
 
 	return NewFromSeries(df.pool, ss)
@@ -421,7 +743,7 @@ func (df DataFrame) Record(i int) array.Record {
 	_, cs := df.Dims()
 	cols := make([]array.Interface, cs)
 	for j, s := range df.series {
-		slice := array.NewSlice(s, int64(i), int64(i+1))
+		slice := s.Truncate(int64(i), int64(i+1))
 		defer slice.Release()
 		cols[j] = slice
 	}
@@ -688,6 +1010,74 @@ func (df DataFrame) SelectRowsByIndices(indices []int) DataFrame {
 	return NewFromSeries(df.pool, ss)
 }
 
+// Filter returns a DataFrame with the rows where the Boolean mask is true.
+// Rows where the mask is null are dropped, as in series.Series.Filter.
+func (df DataFrame) Filter(mask series.Series) DataFrame {
+	df.Retain()
+	defer df.Release()
+
+	if int64(mask.Len()) != df.NumRows() {
+		panic("dataframe: filter: mask length does not match")
+	}
+
+	ss := make([]series.Series, len(df.series))
+	for i, s := range df.series {
+		s2 := s.Filter(mask)
+		defer s2.Release()
+		ss[i] = s2
+	}
+
+	return NewFromSeries(df.pool, ss)
+}
+
+// Explode returns a DataFrame with a row per element of the lists of the List
+// Series called col, which is replaced by a Series of the elements. The values
+// of the other Series are repeated for every element of their row. Null and
+// empty lists give a single row with a null element. A panic is triggered if
+// col is not a List Series.
+func (df DataFrame) Explode(col string) DataFrame {
+	df.Retain()
+	defer df.Release()
+
+	s := df.SeriesByName(col)
+	if _, ok := s.DataType().(*arrow.ListType); !ok {
+		panic(fmt.Sprintf("dataframe: explode: series %q is not a list series", col))
+	}
+	lens := s.ListLen()
+	defer lens.Release()
+	elems := s.ListValues()
+	defer elems.Release()
+
+	var rows, elemRows []int
+	var k int
+	for i, n := range lens.Values().([]int32) {
+		if lens.IsNull(i) || n == 0 {
+			rows = append(rows, i)
+			elemRows = append(elemRows, -1)
+			continue
+		}
+		for j := 0; j < int(n); j++ {
+			rows = append(rows, i)
+			elemRows = append(elemRows, k)
+			k++
+		}
+	}
+
+	ss := make([]series.Series, len(df.series))
+	for i, s := range df.series {
+		var s2 series.Series
+		if s.Name() == col {
+			s2 = elems.Take(elemRows)
+		} else {
+			s2 = s.Take(rows)
+		}
+		defer s2.Release()
+		ss[i] = s2
+	}
+
+	return NewFromSeries(df.pool, ss)
+}
+
 // DropNARowsBySeriesIndices ...
 func (df DataFrame) DropNARowsBySeriesIndices(seriesIndices []int) DataFrame {
 	df.Retain()
@@ -784,246 +1174,207 @@ func LeftJoinEM(leftDF DataFrame, leftName string, rightDF DataFrame, rightName
 	return NewFromRecords(leftDF.pool, resultRecords)
 }
 
-// LeftJoin ...
+// LeftJoin returns a DataFrame with every row of leftDF joined with each row of
+// rightDF where the rightName value equals the leftName value. The result has
+// the columns of leftDF followed by the columns of rightDF except rightName.
+// Rows of leftDF without a match are kept with nulls in the columns of
+// rightDF. Null keys never match. When both keys are categorical, they are
+// compared by code.
 func LeftJoin(leftDF DataFrame, leftName string, rightDF DataFrame, rightName string) DataFrame {
 	// TODO(poopoothegorilla): add check for series name overlaps
-	fields := make([]arrow.Field, len(leftDF.series)+len(rightDF.series)-1)
-	fieldIndices := make([]int, 0, len(fields))
-	for i, s := range leftDF.series {
-		fields[i] = s.Field()
-		fieldIndices = append(fieldIndices, i)
-	}
-	midIndice := len(leftDF.series)
-	var j int
-	for i, s := range rightDF.series {
-		// skip series used in join
-		if s.Name() == rightName {
-			continue
-		}
-		fields[midIndice+j] = s.Field()
-		fieldIndices = append(fieldIndices, i)
-		j++
-	}
-	schema := arrow.NewSchema(fields, nil)
-	rb := array.NewRecordBuilder(leftDF.pool, schema)
-	defer rb.Release()
+	matches := joinIndices("left_join", leftDF.SeriesByName(leftName), rightDF.SeriesByName(rightName))
+	leftRows, rightRows := joinRows(matches)
 
-	leftSeries := leftDF.SeriesByName(leftName)
-	for li := 0; li < leftSeries.Len(); li++ {
-		leftVals := leftSeries.Values()
-		var rightIndices []int
-		switch lf := leftVals.(type) {
-		case []int32:
-			rightIndices = rightDF.SeriesByName(rightName).FindIndices(lf[li])
-		case []int64:
-			rightIndices = rightDF.SeriesByName(rightName).FindIndices(lf[li])
-		case []float32:
-			rightIndices = rightDF.SeriesByName(rightName).FindIndices(lf[li])
-		case []float64:
-			rightIndices = rightDF.SeriesByName(rightName).FindIndices(lf[li])
-		default:
-			panic("dataframe: left_join: unknown type")
-		}
+	return joinSeries(leftDF.pool, leftDF, leftRows, rightDF, rightRows, rightName)
+}
 
-		var ri int
-		for {
-			for fi, b := range rb.Fields() {
-				if fi < midIndice {
-					switch fb := b.(type) {
-					case *array.Int32Builder:
-						v := leftDF.Series(fieldIndices[fi]).Int32(li)
-						fb.AppendValues([]int32{v}, nil)
-					case *array.Int64Builder:
-						v := leftDF.Series(fieldIndices[fi]).Int64(li)
-						fb.AppendValues([]int64{v}, nil)
-					case *array.Float32Builder:
-						v := leftDF.Series(fieldIndices[fi]).Float32(li)
-						fb.AppendValues([]float32{v}, nil)
-					case *array.Float64Builder:
-						v := leftDF.Series(fieldIndices[fi]).Float64(li)
-						fb.AppendValues([]float64{v}, nil)
-					default:
-						panic("dataframe: left_join: unknown type")
-					}
-					continue
-				}
-				if len(rightIndices) > 0 {
-					rightIndice := rightIndices[ri]
-					switch fb := b.(type) {
-					case *array.Int32Builder:
-						v := rightDF.Series(fieldIndices[fi]).Int32(rightIndice)
-						fb.AppendValues([]int32{v}, nil)
-					case *array.Int64Builder:
-						v := rightDF.Series(fieldIndices[fi]).Int64(rightIndice)
-						fb.AppendValues([]int64{v}, nil)
-					case *array.Float32Builder:
-						v := rightDF.Series(fieldIndices[fi]).Float32(rightIndice)
-						fb.AppendValues([]float32{v}, nil)
-					case *array.Float64Builder:
-						v := rightDF.Series(fieldIndices[fi]).Float64(rightIndice)
-						fb.AppendValues([]float64{v}, nil)
-					default:
-						panic("dataframe: left_join: unknown type")
-					}
-					continue
-				}
+// RightJoin returns a DataFrame with every row of rightDF joined with each row
+// of leftDF where the leftName value equals the rightName value. The result
+// has the columns of rightDF followed by the columns of leftDF except
+// leftName. Rows of rightDF without a match are kept with nulls in the columns
+// of leftDF. Null keys never match. When both keys are categorical, they are
+// compared by code.
+func RightJoin(leftDF DataFrame, leftName string, rightDF DataFrame, rightName string) DataFrame {
+	// TODO(poopoothegorilla): add check for series name overlaps
+	matches := joinIndices("right_join", rightDF.SeriesByName(rightName), leftDF.SeriesByName(leftName))
+	rightRows, leftRows := joinRows(matches)
 
-				b.AppendNull()
-			}
+	return joinSeries(rightDF.pool, rightDF, rightRows, leftDF, leftRows, leftName)
+}
 
-			ri++
-			if ri >= len(rightIndices) {
-				break
+// joinIndices returns, for every value of the from Series, the indices of the
+// equal values of the to Series. Categorical Series are compared by code.
+func joinIndices(method string, from, to series.Series) [][]int {
+	result := make([][]int, from.Len())
+
+	if from.DataType() == series.Categorical && to.DataType() == series.Categorical {
+		mapping := from.CategoryMapping(to)
+		fromCodes := from.Codes()
+		defer fromCodes.Release()
+		toCodes := to.Codes()
+		defer toCodes.Release()
+
+		categories := to.Categories()
+		defer categories.Release()
+
+		byCode := make([][]int, categories.Len())
+		for i, c := range toCodes.Values().([]int32) {
+			if toCodes.IsNull(i) {
+				continue
+			}
+			byCode[c] = append(byCode[c], i)
+		}
+		for i, c := range fromCodes.Values().([]int32) {
+			if fromCodes.IsNull(i) || mapping[c] < 0 {
+				continue
 			}
+			result[i] = byCode[mapping[c]]
 		}
+		return result
 	}
-	rec := rb.NewRecord()
-	defer rec.Release()
-
-	return NewFromRecords(leftDF.pool, []array.Record{rec})
-}
 
-// RightJoin ...
-func RightJoin(leftDF DataFrame, leftName string, rightDF DataFrame, rightName string) DataFrame {
-	// TODO(poopoothegorilla): add check for series name overlaps
-	fields := make([]arrow.Field, len(leftDF.series)+len(rightDF.series)-1)
-	fieldIndices := make([]int, 0, len(fields))
-	for i, s := range rightDF.series {
-		fields[i] = s.Field()
-		fieldIndices = append(fieldIndices, i)
-	}
-	midIndice := len(rightDF.series)
-	var j int
-	for i, s := range rightDF.series {
-		// skip series used in join
-		if s.Name() == rightName {
+	fromVals := from.Values()
+	for i := range result {
+		if from.IsNull(i) {
 			continue
 		}
-		fields[midIndice+j] = s.Field()
-		fieldIndices = append(fieldIndices, i)
-		j++
-	}
-	schema := arrow.NewSchema(fields, nil)
-	rb := array.NewRecordBuilder(leftDF.pool, schema)
-	defer rb.Release()
-
-	rightSeries := rightDF.SeriesByName(rightName)
-	for ri := 0; ri < rightSeries.Len(); ri++ {
-		rightVals := rightSeries.Values()
-		var leftIndices []int
-		switch rf := rightVals.(type) {
+		switch vals := fromVals.(type) {
 		case []int32:
-			leftIndices = leftDF.SeriesByName(leftName).FindIndices(rf[ri])
+			result[i] = to.FindIndices(vals[i])
 		case []int64:
-			leftIndices = leftDF.SeriesByName(leftName).FindIndices(rf[ri])
+			result[i] = to.FindIndices(vals[i])
+		case []int8:
+			result[i] = to.FindIndices(vals[i])
+		case []int16:
+			result[i] = to.FindIndices(vals[i])
+		case []uint8:
+			result[i] = to.FindIndices(vals[i])
+		case []uint16:
+			result[i] = to.FindIndices(vals[i])
+		case []uint32:
+			result[i] = to.FindIndices(vals[i])
+		case []uint64:
+			result[i] = to.FindIndices(vals[i])
 		case []float32:
-			leftIndices = leftDF.SeriesByName(leftName).FindIndices(rf[ri])
+			result[i] = to.FindIndices(vals[i])
 		case []float64:
-			leftIndices = leftDF.SeriesByName(leftName).FindIndices(rf[ri])
+			result[i] = to.FindIndices(vals[i])
+		case []string:
+			result[i] = to.FindIndices(vals[i])
+		case [][]byte:
+			result[i] = to.FindIndices(vals[i])
 		default:
-			panic("dataframe: right_join: unknown type")
+			panic(fmt.Sprintf("dataframe: %s: unknown type", method))
 		}
+	}
+	return result
+}
 
-		var li int
-		for {
-			for fi, b := range rb.Fields() {
-				if fi < midIndice {
-					switch fb := b.(type) {
-					case *array.Int32Builder:
-						v := rightDF.Series(fieldIndices[fi]).Int32(ri)
-						fb.AppendValues([]int32{v}, nil)
-					case *array.Int64Builder:
-						v := rightDF.Series(fieldIndices[fi]).Int64(ri)
-						fb.AppendValues([]int64{v}, nil)
-					case *array.Float32Builder:
-						v := rightDF.Series(fieldIndices[fi]).Float32(ri)
-						fb.AppendValues([]float32{v}, nil)
-					case *array.Float64Builder:
-						v := rightDF.Series(fieldIndices[fi]).Float64(ri)
-						fb.AppendValues([]float64{v}, nil)
-					default:
-						panic("dataframe: right_join: unknown type")
-					}
-					continue
-				}
-				if len(leftIndices) > 0 {
-					leftIndice := leftIndices[li]
-					switch fb := b.(type) {
-					case *array.Int32Builder:
-						v := leftDF.Series(fieldIndices[fi]).Int32(leftIndice)
-						fb.AppendValues([]int32{v}, nil)
-					case *array.Int64Builder:
-						v := leftDF.Series(fieldIndices[fi]).Int64(leftIndice)
-						fb.AppendValues([]int64{v}, nil)
-					case *array.Float32Builder:
-						v := leftDF.Series(fieldIndices[fi]).Float32(leftIndice)
-						fb.AppendValues([]float32{v}, nil)
-					case *array.Float64Builder:
-						v := leftDF.Series(fieldIndices[fi]).Float64(leftIndice)
-						fb.AppendValues([]float64{v}, nil)
-					default:
-						panic("dataframe: right_join: unknown type")
-					}
-					continue
-				}
-
-				b.AppendNull()
-			}
+// joinRows returns the row of each side for every row of a join, where -1
+// marks a row without a match.
+func joinRows(matches [][]int) ([]int, []int) {
+	rows := make([]int, 0, len(matches))
+	others := make([]int, 0, len(matches))
+	for i, is := range matches {
+		if len(is) == 0 {
+			rows = append(rows, i)
+			others = append(others, -1)
+			continue
+		}
+		for _, j := range is {
+			rows = append(rows, i)
+			others = append(others, j)
+		}
+	}
+	return rows, others
+}
 
-			li++
-			if li >= len(leftIndices) {
-				break
-			}
+// joinSeries returns a DataFrame with the columns of df taken at rows followed
+// by the columns of other taken at otherRows, except the otherKey column.
+func joinSeries(pool memory.Allocator, df DataFrame, rows []int, other DataFrame, otherRows []int, otherKey string) DataFrame {
+	ss := make([]series.Series, 0, len(df.series)+len(other.series))
+	for _, s := range df.series {
+		taken := s.Take(rows)
+		defer taken.Release()
+		ss = append(ss, taken)
+	}
+	for _, s := range other.series {
+		if s.Name() == otherKey {
+			continue
 		}
+		taken := s.Take(otherRows)
+		defer taken.Release()
+		ss = append(ss, taken)
 	}
-	rec := rb.NewRecord()
-	defer rec.Release()
 
-	return NewFromRecords(rightDF.pool, []array.Record{rec})
+	return NewFromSeries(pool, ss)
 }
 
 // Max ...
-func (df DataFrame) Max() float64 {
+func (df DataFrame) Max(opts ...series.AggOption) float64 {
 	df.Retain()
 	defer df.Release()
 
-	var max float64
-	for i, col := range df.series {
-		fval := col.Max()
-		if max >= fval && i != 0 {
-			continue
+	// NOTE: columns without values give NaN, which is only returned when nulls
+	// are not skipped.
+	skipNA := series.SkipsNA(opts...)
+	max := math.NaN()
+	for _, col := range df.series {
+		fval := col.Max(opts...)
+		switch {
+		case math.IsNaN(fval):
+			if !skipNA {
+				return fval
+			}
+		case math.IsNaN(max) || fval > max:
+			max = fval
 		}
-		max = fval
 	}
 	return max
 }
 
 // Min ...
-func (df DataFrame) Min() float64 {
+func (df DataFrame) Min(opts ...series.AggOption) float64 {
 	df.Retain()
 	defer df.Release()
 
-	var min float64
-	for i, col := range df.series {
-		fval := col.Min()
-		if min <= fval && i != 0 {
-			continue
+	// NOTE: columns without values give NaN, which is only returned when nulls
+	// are not skipped.
+	skipNA := series.SkipsNA(opts...)
+	min := math.NaN()
+	for _, col := range df.series {
+		fval := col.Min(opts...)
+		switch {
+		case math.IsNaN(fval):
+			if !skipNA {
+				return fval
+			}
+		case math.IsNaN(min) || fval < min:
+			min = fval
 		}
-		min = fval
 	}
 	return min
 }
 
 // Mean ...
-func (df DataFrame) Mean() float64 {
+func (df DataFrame) Mean(opts ...series.AggOption) float64 {
 	df.Retain()
 	defer df.Release()
 
+	// NOTE: columns without values give NaN, which is only returned when nulls
+	// are not skipped.
+	skipNA := series.SkipsNA(opts...)
 	var total float64
+	var n int
 	for _, col := range df.series {
-		total += col.Mean()
+		fval := col.Mean(opts...)
+		if math.IsNaN(fval) && skipNA {
+			continue
+		}
+		total += fval
+		n++
 	}
-	return total / float64(len(df.series))
+	return total / float64(n)
 }
 
 // Median ...
@@ -1060,13 +1411,13 @@ func (df DataFrame) Sqrt() DataFrame {
 
 // STD ...
 // Sum ...
-func (df DataFrame) Sum() float64 {
+func (df DataFrame) Sum(opts ...series.AggOption) float64 {
 	df.Retain()
 	defer df.Release()
 
 	var sum float64
 	for _, col := range df.series {
-		sum += col.Sum()
+		sum += col.Sum(opts...)
 	}
 	return sum
 }
@@ -1098,6 +1449,224 @@ func (df DataFrame) Subtract(df2 DataFrame) DataFrame {
 	return NewFromSeries(df.pool, ss)
 }
 
+// Multiply multiplies the Series of two DataFrames of equal dimensions by
+// position, as in series.Series.Multiply.
+func (df DataFrame) Multiply(df2 DataFrame) DataFrame {
+	return df.arith("multiply", df2, func(s, s2 series.Series) series.Series {
+		return s.Multiply(s2)
+	})
+}
+
+// Divide divides the Series of two DataFrames of equal dimensions by position,
+// as in series.Series.Divide.
+func (df DataFrame) Divide(df2 DataFrame, opts ...series.DivideOption) DataFrame {
+	return df.arith("divide", df2, func(s, s2 series.Series) series.Series {
+		return s.Divide(s2, opts...)
+	})
+}
+
+// Mod returns the remainders of the Series of two DataFrames of equal
+// dimensions by position, as in series.Series.Mod.
+func (df DataFrame) Mod(df2 DataFrame, opts ...series.DivideOption) DataFrame {
+	return df.arith("mod", df2, func(s, s2 series.Series) series.Series {
+		return s.Mod(s2, opts...)
+	})
+}
+
+// Pow raises the Series of the DataFrame to the powers of the Series of df2 by
+// position, as in series.Series.Pow.
+func (df DataFrame) Pow(df2 DataFrame) DataFrame {
+	return df.arith("pow", df2, func(s, s2 series.Series) series.Series {
+		return s.Pow(s2)
+	})
+}
+
+// arith returns a DataFrame of fn applied to the Series of the DataFrame and
+// the Series of df2 at the same position.
+func (df DataFrame) arith(method string, df2 DataFrame, fn func(s, s2 series.Series) series.Series) DataFrame {
+	df.Retain()
+	defer df.Release()
+	df2.Retain()
+	defer df2.Release()
+
+	nRows, nCols := df.Dims()
+	nRows2, nCols2 := df2.Dims()
+	if nRows != nRows2 {
+		panic(fmt.Sprintf("dataframe: %s: number of rows not equal", method))
+	}
+	if nCols != nCols2 {
+		panic(fmt.Sprintf("dataframe: %s: number of cols not equal", method))
+	}
+
+	ss := make([]series.Series, nCols)
+	for i, col := range df.series {
+		s := fn(col, df2.series[i])
+		defer s.Release()
+		ss[i] = s
+	}
+
+	return NewFromSeries(df.pool, ss)
+}
+
+// ArithScalar applies the operation op to every value of the DataFrame and the
+// scalar v, as in series.Series.ArithScalar.
+func (df DataFrame) ArithScalar(op series.ArithOp, v interface{}, opts ...series.DivideOption) DataFrame {
+	df.Retain()
+	defer df.Release()
+
+	ss := make([]series.Series, len(df.series))
+	for i, col := range df.series {
+		s := col.ArithScalar(op, v, opts...)
+		defer s.Release()
+		ss[i] = s
+	}
+
+	return NewFromSeries(df.pool, ss)
+}
+
+// AddScalar adds the scalar v to every value of the DataFrame.
+func (df DataFrame) AddScalar(v interface{}) DataFrame {
+	return df.ArithScalar(series.OpAdd, v)
+}
+
+// SubScalar subtracts the scalar v from every value of the DataFrame.
+func (df DataFrame) SubScalar(v interface{}) DataFrame {
+	return df.ArithScalar(series.OpSubtract, v)
+}
+
+// MulScalar multiplies every value of the DataFrame by the scalar v.
+func (df DataFrame) MulScalar(v interface{}) DataFrame {
+	return df.ArithScalar(series.OpMultiply, v)
+}
+
+// DivScalar divides every value of the DataFrame by the scalar v.
+func (df DataFrame) DivScalar(v interface{}, opts ...series.DivideOption) DataFrame {
+	return df.ArithScalar(series.OpDivide, v, opts...)
+}
+
+// ModScalar returns the remainders of dividing every value of the DataFrame by
+// the scalar v.
+func (df DataFrame) ModScalar(v interface{}, opts ...series.DivideOption) DataFrame {
+	return df.ArithScalar(series.OpMod, v, opts...)
+}
+
+// PowScalar raises every value of the DataFrame to the power v.
+func (df DataFrame) PowScalar(v interface{}) DataFrame {
+	return df.ArithScalar(series.OpPow, v)
+}
+
+// Broadcast applies the operation op to the values of the DataFrame and the
+// Series s broadcast along axis. With AxisRows s holds a value for every
+// column, such as the means of the columns, and each column is combined with
+// its value as in series.Series.ArithScalar, where a null value gives a column
+// of nulls. With AxisColumns s holds a value
+// for every row and each column is combined with s as in
+// series.Series.Arith. A panic is triggered if the length of s does not match
+// the axis.
+func (df DataFrame) Broadcast(op series.ArithOp, s series.Series, axis Axis, opts ...series.DivideOption) DataFrame {
+	df.Retain()
+	defer df.Release()
+	s.Retain()
+	defer s.Release()
+
+	nRows, nCols := df.Dims()
+	switch axis {
+	case AxisRows:
+		if s.Len() != nCols {
+			panic(fmt.Sprintf("dataframe: broadcast: series has %d values, expected %d columns", s.Len(), nCols))
+		}
+	case AxisColumns:
+		if s.Len() != nRows {
+			panic(fmt.Sprintf("dataframe: broadcast: series has %d values, expected %d rows", s.Len(), nRows))
+		}
+	default:
+		panic(fmt.Sprintf("dataframe: broadcast: unknown axis %d", axis))
+	}
+
+	ss := make([]series.Series, nCols)
+	for i, col := range df.series {
+		var res series.Series
+		if axis == AxisRows {
+			var v interface{}
+			if s.IsValid(i) {
+				v = s.Value(i)
+			}
+			res = col.ArithScalar(op, v, opts...)
+		} else {
+			res = col.Arith(op, s, opts...)
+		}
+		defer res.Release()
+		ss[i] = res
+	}
+
+	return NewFromSeries(df.pool, ss)
+}
+
+// Cumulative returns a DataFrame with the running result of the operation op
+// over every column, as in series.Series.Cumulative.
+func (df DataFrame) Cumulative(op series.CumOp, opts ...series.AggOption) DataFrame {
+	df.Retain()
+	defer df.Release()
+
+	ss := make([]series.Series, len(df.series))
+	for i, col := range df.series {
+		s := col.Cumulative(op, opts...)
+		defer s.Release()
+		ss[i] = s
+	}
+
+	return NewFromSeries(df.pool, ss)
+}
+
+// GroupCumulative returns a DataFrame with the running result of the operation
+// op over every column except by, computed separately for each group of rows
+// with equal values in the by column as in series.Series.CumulativeBy. The by
+// column is kept as is.
+func (df DataFrame) GroupCumulative(by string, op series.CumOp, opts ...series.AggOption) DataFrame {
+	df.Retain()
+	defer df.Release()
+
+	keys := df.SeriesByName(by)
+	ss := make([]series.Series, len(df.series))
+	for i, col := range df.series {
+		if col.Name() == by {
+			ss[i] = col
+			continue
+		}
+		s := col.CumulativeBy(op, keys, opts...)
+		defer s.Release()
+		ss[i] = s
+	}
+
+	return NewFromSeries(df.pool, ss)
+}
+
+// CumSum returns a DataFrame with the running sum of every column.
+func (df DataFrame) CumSum(opts ...series.AggOption) DataFrame {
+	return df.Cumulative(series.OpCumSum, opts...)
+}
+
+// CumProd returns a DataFrame with the running product of every column.
+func (df DataFrame) CumProd(opts ...series.AggOption) DataFrame {
+	return df.Cumulative(series.OpCumProd, opts...)
+}
+
+// CumMin returns a DataFrame with the running minimum of every column.
+func (df DataFrame) CumMin(opts ...series.AggOption) DataFrame {
+	return df.Cumulative(series.OpCumMin, opts...)
+}
+
+// CumMax returns a DataFrame with the running maximum of every column.
+func (df DataFrame) CumMax(opts ...series.AggOption) DataFrame {
+	return df.Cumulative(series.OpCumMax, opts...)
+}
+
+// CumCount returns a DataFrame with the running number of non null values of
+// every column.
+func (df DataFrame) CumCount() DataFrame {
+	return df.Cumulative(series.OpCumCount)
+}
+
 // Map ...
 // Where ...
 
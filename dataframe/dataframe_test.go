@@ -1,13 +1,26 @@
 package dataframe_test
 
 import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
 	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
 	"math"
+	"os"
+	"reflect"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/apache/arrow/go/arrow"
 	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/decimal128"
+	"github.com/apache/arrow/go/arrow/ipc"
 	"github.com/apache/arrow/go/arrow/memory"
 	"github.com/poopoothegorilla/fastframe/dataframe"
 	"github.com/poopoothegorilla/fastframe/series"
@@ -107,6 +120,53 @@ func TestNewFromRecords(t *testing.T) {
 				[]int{0, 2, 4},
 			},
 		},
+		{
+			scenario: "small and unsigned integers",
+			inRecords: func(pool memory.Allocator) []array.Record {
+				fields := []arrow.Field{
+					arrow.Field{Name: "f1-i8", Type: arrow.PrimitiveTypes.Int8},
+					arrow.Field{Name: "f2-u32", Type: arrow.PrimitiveTypes.Uint32},
+					arrow.Field{Name: "f3-u64", Type: arrow.PrimitiveTypes.Uint64},
+				}
+				schema := arrow.NewSchema(fields, nil)
+
+				var result []array.Record
+				for _, n := range []int{0, 2} {
+					bi8 := array.NewInt8Builder(pool)
+					defer bi8.Release()
+					bu32 := array.NewUint32Builder(pool)
+					defer bu32.Release()
+					bu64 := array.NewUint64Builder(pool)
+					defer bu64.Release()
+
+					bi8.AppendValues([]int8{int8(-n), int8(n + 1)}, []bool{true, n == 0})
+					bu32.AppendValues([]uint32{uint32(n), 1<<32 - 1}, nil)
+					bu64.AppendValues([]uint64{uint64(n), 1<<64 - 1}, []bool{n != 0, true})
+
+					arri8 := bi8.NewArray()
+					defer arri8.Release()
+					arru32 := bu32.NewArray()
+					defer arru32.Release()
+					arru64 := bu64.NewArray()
+					defer arru64.Release()
+
+					result = append(result, array.NewRecord(schema, []array.Interface{arri8, arru32, arru64}, -1))
+				}
+				return result
+			},
+			expNumCols: 3,
+			expNumRows: 4,
+			exp: []interface{}{
+				[]int8{0, 1, -2, 3},
+				[]uint32{0, 1<<32 - 1, 2, 1<<32 - 1},
+				[]uint64{0, 1<<64 - 1, 2, 1<<64 - 1},
+			},
+			expNAIndices: [][]int{
+				[]int{3},
+				[]int{},
+				[]int{0},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -176,58 +236,2084 @@ func TestNewFromSeries(t *testing.T) {
 		},
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.scenario, func(t *testing.T) {
-			pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-			defer pool.AssertSize(t, 0)
+	for _, tt := range tests {
+		t.Run(tt.scenario, func(t *testing.T) {
+			pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer pool.AssertSize(t, 0)
+
+			inSeries := tt.inSeries(pool)
+			for i := range inSeries {
+				defer inSeries[i].Release()
+			}
+
+			act := dataframe.NewFromSeries(pool, inSeries)
+			defer act.Release()
+
+			numR, numC := act.Dims()
+			require.Equal(t, tt.expNumCols, numC)
+			require.Equal(t, tt.expNumRows, numR)
+
+			for i := range tt.exp {
+				assert.Equal(t, tt.exp[i], act.Series(i).Values())
+			}
+		})
+	}
+}
+
+func TestNewFromCSV(t *testing.T) {
+	tests := []struct {
+		scenario string
+
+		inCSV       string
+		inBatchSize int
+		inTypes     map[string]arrow.DataType
+		inOptions   []dataframe.CSVOption
+
+		expNumCols   int
+		expNumRows   int
+		expHeaders   []string
+		expTypes     []arrow.DataType
+		exp          []interface{}
+		expNAIndices [][]int
+	}{
+		{
+			// TODO: CHANGE THE FIELDS TO BE ALL TYPES
+			scenario: "valid",
+			inCSV: `"f1-f64","f2-f64","f3-f64","f4-f64","f5-str"
+1,11,111,1111,john
+2,22,222,2222,jim
+3,33,333,3333,julie
+4,44,444,4444,alex
+5,55,555,5555,shaydul`,
+			inTypes: map[string]arrow.DataType{
+				"f1-f64": arrow.BinaryTypes.String,
+				"f2-f64": arrow.BinaryTypes.String,
+				"f3-f64": arrow.BinaryTypes.String,
+				"f4-f64": arrow.BinaryTypes.String,
+			},
+			expNumCols: 5,
+			expNumRows: 5,
+			exp: []interface{}{
+				[]string{"1", "2", "3", "4", "5"},
+				[]string{"11", "22", "33", "44", "55"},
+				[]string{"111", "222", "333", "444", "555"},
+				[]string{"1111", "2222", "3333", "4444", "5555"},
+				[]string{"john", "jim", "julie", "alex", "shaydul"},
+			},
+		},
+		{
+			scenario: "typed",
+			inCSV: `"f1-i32","f2-i64","f3-f32","f4-f64","f5-bool"
+1,11,1.5,1111.25,true
+2,,2.5,2222.25,false
+3,33,,3333.25,
+4,44,4.5,,true`,
+			inTypes: map[string]arrow.DataType{
+				"f1-i32":  arrow.PrimitiveTypes.Int32,
+				"f2-i64":  arrow.PrimitiveTypes.Int64,
+				"f3-f32":  arrow.PrimitiveTypes.Float32,
+				"f4-f64":  arrow.PrimitiveTypes.Float64,
+				"f5-bool": arrow.FixedWidthTypes.Boolean,
+			},
+			expNumCols: 5,
+			expNumRows: 4,
+			expTypes: []arrow.DataType{
+				arrow.PrimitiveTypes.Int32,
+				arrow.PrimitiveTypes.Int64,
+				arrow.PrimitiveTypes.Float32,
+				arrow.PrimitiveTypes.Float64,
+				arrow.FixedWidthTypes.Boolean,
+			},
+			exp: []interface{}{
+				[]int32{1, 2, 3, 4},
+				[]int64{11, 0, 33, 44},
+				[]float32{1.5, 2.5, 0, 4.5},
+				[]float64{1111.25, 2222.25, 3333.25, 0},
+				[]bool{true, false, false, true},
+			},
+			expNAIndices: [][]int{
+				[]int{},
+				[]int{1},
+				[]int{2},
+				[]int{3},
+				[]int{2},
+			},
+		},
+		{
+			scenario: "inferred",
+			inCSV: `"f1-i64","f2-f64","f3-bool","f4-str","f5-empty"
+1,1.5,TRUE,john,
+2,,false,2,
+,3,True,julie,`,
+			expNumCols: 5,
+			expNumRows: 3,
+			expTypes: []arrow.DataType{
+				arrow.PrimitiveTypes.Int64,
+				arrow.PrimitiveTypes.Float64,
+				arrow.FixedWidthTypes.Boolean,
+				arrow.BinaryTypes.String,
+				arrow.BinaryTypes.String,
+			},
+			exp: []interface{}{
+				[]int64{1, 2, 0},
+				[]float64{1.5, 0, 3},
+				[]bool{true, false, true},
+				[]string{"john", "2", "julie"},
+				[]string{"", "", ""},
+			},
+			expNAIndices: [][]int{
+				[]int{2},
+				[]int{1},
+				[]int{},
+				[]int{},
+				[]int{},
+			},
+		},
+		{
+			scenario: "inferred mixed case bool",
+			inCSV: `"f1-bool","f2-str"
+t,TrUe
+F,false`,
+			expNumCols: 2,
+			expNumRows: 2,
+			expTypes: []arrow.DataType{
+				arrow.FixedWidthTypes.Boolean,
+				arrow.BinaryTypes.String,
+			},
+			exp: []interface{}{
+				[]bool{true, false},
+				[]string{"TrUe", "false"},
+			},
+			expNAIndices: [][]int{
+				[]int{},
+				[]int{},
+			},
+		},
+		{
+			scenario: "batched",
+			inCSV: `"f1-i64","f2-str","f3-f64"
+1,a,1.5
+2,b,
+,c,3.5
+4,,4.5
+5,e,5.5`,
+			inBatchSize: 2,
+			inTypes: map[string]arrow.DataType{
+				"f2-str": arrow.BinaryTypes.String,
+			},
+			expNumCols: 3,
+			expNumRows: 5,
+			expTypes: []arrow.DataType{
+				arrow.PrimitiveTypes.Int64,
+				arrow.BinaryTypes.String,
+				arrow.PrimitiveTypes.Float64,
+			},
+			exp: []interface{}{
+				[]int64{1, 2, 0, 4, 5},
+				[]string{"a", "b", "c", "", "e"},
+				[]float64{1.5, 0, 3.5, 4.5, 5.5},
+			},
+			expNAIndices: [][]int{
+				[]int{2},
+				[]int{},
+				[]int{1},
+			},
+		},
+		{
+			scenario: "no header",
+			inCSV: `1,john
+2,jim`,
+			inOptions: []dataframe.CSVOption{
+				dataframe.WithCSVNoHeader(),
+			},
+			expNumCols: 2,
+			expNumRows: 2,
+			expHeaders: []string{"0", "1"},
+			exp: []interface{}{
+				[]int64{1, 2},
+				[]string{"john", "jim"},
+			},
+		},
+		{
+			scenario: "no header with names",
+			inCSV: `1,john
+2,jim`,
+			inTypes: map[string]arrow.DataType{
+				"id": arrow.PrimitiveTypes.Int32,
+			},
+			inOptions: []dataframe.CSVOption{
+				dataframe.WithCSVNoHeader(),
+				dataframe.WithCSVNames("id", "name"),
+			},
+			expNumCols: 2,
+			expNumRows: 2,
+			expHeaders: []string{"id", "name"},
+			exp: []interface{}{
+				[]int32{1, 2},
+				[]string{"john", "jim"},
+			},
+		},
+		{
+			scenario: "skipped rows, comments and delimiter",
+			inCSV: `exported 2020-05-01
+source: ratings
+id;name
+# a comment
+1;john
+# another comment
+2;jim`,
+			inOptions: []dataframe.CSVOption{
+				dataframe.WithCSVSkipRows(2),
+				dataframe.WithCSVComment('#'),
+				dataframe.WithCSVDelimiter(';'),
+			},
+			expNumCols: 2,
+			expNumRows: 2,
+			expHeaders: []string{"id", "name"},
+			exp: []interface{}{
+				[]int64{1, 2},
+				[]string{"john", "jim"},
+			},
+		},
+		{
+			scenario: "null values",
+			inCSV: `"f1-i64","f2-f64","f3-str"
+1,NA,john
+null,2.5,-
+3,-,
+NA,4.5,julie`,
+			inOptions: []dataframe.CSVOption{
+				dataframe.WithCSVNullValues("", "NA", "null", "-"),
+			},
+			expNumCols: 3,
+			expNumRows: 4,
+			expTypes: []arrow.DataType{
+				arrow.PrimitiveTypes.Int64,
+				arrow.PrimitiveTypes.Float64,
+				arrow.BinaryTypes.String,
+			},
+			exp: []interface{}{
+				[]int64{1, 0, 3, 0},
+				[]float64{0, 2.5, 0, 4.5},
+				[]string{"john", "", "", "julie"},
+			},
+			expNAIndices: [][]int{
+				[]int{1, 3},
+				[]int{0, 2},
+				[]int{1, 2},
+			},
+		},
+		{
+			scenario: "selected columns",
+			inCSV: `"f1-i64","f2-str","f3-f64"
+1,john,1.5
+2,jim,2.5`,
+			inOptions: []dataframe.CSVOption{
+				dataframe.WithCSVColumns("f3-f64", "f1-i64"),
+			},
+			expNumCols: 2,
+			expNumRows: 2,
+			expHeaders: []string{"f1-i64", "f3-f64"},
+			exp: []interface{}{
+				[]int64{1, 2},
+				[]float64{1.5, 2.5},
+			},
+		},
+		{
+			scenario: "header only",
+			inCSV:    `"f1-i64","f2-str"`,
+			inTypes: map[string]arrow.DataType{
+				"f1-i64": arrow.PrimitiveTypes.Int64,
+			},
+			expNumCols: 2,
+			expNumRows: 0,
+			expTypes: []arrow.DataType{
+				arrow.PrimitiveTypes.Int64,
+				arrow.BinaryTypes.String,
+			},
+		},
+		{
+			scenario: "typed small and unsigned integers",
+			inCSV: `"f1-i8","f2-i16","f3-u8","f4-u64"
+-128,-300,255,18446744073709551615
+127,,0,`,
+			inTypes: map[string]arrow.DataType{
+				"f1-i8":  arrow.PrimitiveTypes.Int8,
+				"f2-i16": arrow.PrimitiveTypes.Int16,
+				"f3-u8":  arrow.PrimitiveTypes.Uint8,
+				"f4-u64": arrow.PrimitiveTypes.Uint64,
+			},
+			expNumCols: 4,
+			expNumRows: 2,
+			expTypes: []arrow.DataType{
+				arrow.PrimitiveTypes.Int8,
+				arrow.PrimitiveTypes.Int16,
+				arrow.PrimitiveTypes.Uint8,
+				arrow.PrimitiveTypes.Uint64,
+			},
+			exp: []interface{}{
+				[]int8{-128, 127},
+				[]int16{-300, 0},
+				[]uint8{255, 0},
+				[]uint64{1<<64 - 1, 0},
+			},
+			expNAIndices: [][]int{
+				[]int{},
+				[]int{1},
+				[]int{},
+				[]int{1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.scenario, func(t *testing.T) {
+			pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer pool.AssertSize(t, 0)
+
+			inCSVReader := csv.NewReader(strings.NewReader(tt.inCSV))
+
+			act := dataframe.NewFromCSV(pool, inCSVReader, tt.inBatchSize, tt.inTypes, tt.inOptions...)
+			defer act.Release()
+			assert.Equal(t, ',', inCSVReader.Comma)
+			assert.Equal(t, rune(0), inCSVReader.Comment)
+
+			numR, numC := act.Dims()
+			require.Equal(t, tt.expNumCols, numC)
+			require.Equal(t, tt.expNumRows, numR)
+
+			if tt.expHeaders != nil {
+				assert.Equal(t, tt.expHeaders, act.Headers())
+			}
+			for i := range tt.expTypes {
+				assert.Equal(t, tt.expTypes[i], act.Series(i).DataType())
+			}
+			for i := range tt.exp {
+				assert.Equal(t, tt.exp[i], act.Series(i).Values())
+			}
+			for i := range tt.expNAIndices {
+				assert.Equal(t, tt.expNAIndices[i], act.Series(i).NAIndices())
+			}
+		})
+	}
+}
+
+func TestCSVReader(t *testing.T) {
+	tests := []struct {
+		scenario string
+
+		inCSV             string
+		inFieldsPerRecord int
+		inBatchSize       int
+		inTypes           map[string]arrow.DataType
+
+		expSchema  *arrow.Schema
+		expNumRows []int64
+		expErr     string
+	}{
+		{
+			scenario: "batches",
+			inCSV: `"f1-i32","f2-str"
+1,a
+2,b
+3,c
+4,d
+5,e`,
+			inBatchSize: 2,
+			inTypes: map[string]arrow.DataType{
+				"f1-i32": arrow.PrimitiveTypes.Int32,
+			},
+			expSchema: arrow.NewSchema([]arrow.Field{
+				arrow.Field{Name: "f1-i32", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+				arrow.Field{Name: "f2-str", Type: arrow.BinaryTypes.String, Nullable: true},
+			}, nil),
+			expNumRows: []int64{2, 2, 1},
+		},
+		{
+			scenario: "single batch",
+			inCSV: `"f1-i64"
+1
+2
+3`,
+			inBatchSize: -1,
+			expSchema: arrow.NewSchema([]arrow.Field{
+				arrow.Field{Name: "f1-i64", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+			}, nil),
+			expNumRows: []int64{3},
+		},
+		{
+			scenario: "invalid value",
+			inCSV: `"f1-i32"
+1
+2
+three
+4`,
+			inBatchSize: 2,
+			inTypes: map[string]arrow.DataType{
+				"f1-i32": arrow.PrimitiveTypes.Int32,
+			},
+			expSchema: arrow.NewSchema([]arrow.Field{
+				arrow.Field{Name: "f1-i32", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+			}, nil),
+			expNumRows: []int64{2},
+			expErr:     `row 4: column "f1-i32"`,
+		},
+		{
+			scenario: "short row",
+			inCSV: `a,b
+1,2
+3
+`,
+			inFieldsPerRecord: -1,
+			inBatchSize:       -1,
+			expSchema: arrow.NewSchema([]arrow.Field{
+				arrow.Field{Name: "a", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+				arrow.Field{Name: "b", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+			}, nil),
+			expErr: `row 3: column "b": missing value`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.scenario, func(t *testing.T) {
+			pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer pool.AssertSize(t, 0)
+
+			inCSVReader := csv.NewReader(strings.NewReader(tt.inCSV))
+			inCSVReader.FieldsPerRecord = tt.inFieldsPerRecord
+
+			var rdr array.RecordReader = dataframe.NewCSVReader(pool, inCSVReader, tt.inBatchSize, tt.inTypes)
+			defer rdr.Release()
+
+			assert.True(t, tt.expSchema.Equal(rdr.Schema()))
+
+			var actNumRows []int64
+			for rdr.Next() {
+				actNumRows = append(actNumRows, rdr.Record().NumRows())
+			}
+			assert.Equal(t, tt.expNumRows, actNumRows)
+
+			err := rdr.(*dataframe.CSVReader).Err()
+			if tt.expErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expErr)
+		})
+	}
+}
+
+func TestNewFromCSVTimestamp(t *testing.T) {
+	tests := []struct {
+		scenario string
+
+		inCSV   string
+		inTypes map[string]arrow.DataType
+
+		expType arrow.DataType
+		exp     []arrow.Timestamp
+	}{
+		{
+			scenario: "inferred",
+			inCSV: `"ts"
+2020-01-02
+2020-01-02 03:04:05
+2020-01-02T03:04:05Z`,
+			expType: arrow.FixedWidthTypes.Timestamp_ns,
+			exp: []arrow.Timestamp{
+				1577923200000000000,
+				1577934245000000000,
+				1577934245000000000,
+			},
+		},
+		{
+			scenario: "typed with unit and time zone",
+			inCSV: `"ts"
+2020-01-02
+2020-01-02T03:04:05Z`,
+			inTypes: map[string]arrow.DataType{
+				"ts": &arrow.TimestampType{Unit: arrow.Second, TimeZone: "America/New_York"},
+			},
+			expType: &arrow.TimestampType{Unit: arrow.Second, TimeZone: "America/New_York"},
+			exp: []arrow.Timestamp{
+				1577941200,
+				1577934245,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.scenario, func(t *testing.T) {
+			pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer pool.AssertSize(t, 0)
+
+			inCSVReader := csv.NewReader(strings.NewReader(tt.inCSV))
+
+			act := dataframe.NewFromCSV(pool, inCSVReader, -1, tt.inTypes)
+			defer act.Release()
+
+			s := act.Series(0)
+			assert.Equal(t, tt.expType, s.DataType())
+			assert.Equal(t, tt.exp, s.Interface.(*array.Timestamp).TimestampValues())
+		})
+	}
+}
+
+func TestNewFromCSVInvalidValue(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	inCSVReader := csv.NewReader(strings.NewReader(`"f1-i32"
+1
+two`))
+	inTypes := map[string]arrow.DataType{"f1-i32": arrow.PrimitiveTypes.Int32}
+
+	defer func() {
+		r := recover()
+		require.NotNil(t, r)
+		assert.Contains(t, r, `row 3: column "f1-i32"`)
+	}()
+	dataframe.NewFromCSV(pool, inCSVReader, -1, inTypes)
+}
+
+func TestWriteCSV(t *testing.T) {
+	tests := []struct {
+		scenario string
+
+		inDataFrame func(memory.Allocator) dataframe.DataFrame
+		inOptions   []dataframe.CSVOption
+
+		exp string
+	}{
+		{
+			scenario: "default options",
+			inDataFrame: func(pool memory.Allocator) dataframe.DataFrame {
+				tsType := &arrow.TimestampType{Unit: arrow.Second, TimeZone: "UTC"}
+				tsb := array.NewTimestampBuilder(pool, tsType)
+				defer tsb.Release()
+				tsb.AppendValues([]arrow.Timestamp{1577934245, 0, 1577941200}, []bool{true, false, true})
+				bb := array.NewBooleanBuilder(pool)
+				defer bb.Release()
+				bb.AppendValues([]bool{true, false, true}, nil)
+
+				ss := []series.Series{
+					series.FromInt32(pool, arrow.Field{Name: "f1-i32", Type: arrow.PrimitiveTypes.Int32}, []int32{1, 2, 3}, []bool{true, false, true}),
+					series.FromInt64(pool, arrow.Field{Name: "f2-i64", Type: arrow.PrimitiveTypes.Int64}, []int64{11, 22, 33}, nil),
+					series.FromFloat32(pool, arrow.Field{Name: "f3-f32", Type: arrow.PrimitiveTypes.Float32}, []float32{1.5, 2.25, 3}, nil),
+					series.FromFloat64(pool, arrow.Field{Name: "f4-f64", Type: arrow.PrimitiveTypes.Float64}, []float64{0.1, 2.125, 3}, nil),
+					series.FromString(pool, arrow.Field{Name: "f5-str", Type: arrow.BinaryTypes.String}, []string{"john", "jim, jr", ""}, []bool{true, true, false}),
+					series.FromArrow(pool, arrow.Field{Name: "f6-bool", Type: arrow.FixedWidthTypes.Boolean}, bb.NewArray()),
+					series.FromArrow(pool, arrow.Field{Name: "f7-ts", Type: tsType}, tsb.NewArray()),
+				}
+				for _, s := range ss {
+					defer s.Release()
+				}
+
+				return dataframe.NewFromSeries(pool, ss)
+			},
+			exp: `f1-i32,f2-i64,f3-f32,f4-f64,f5-str,f6-bool,f7-ts
+1,11,1.5,0.1,john,true,2020-01-02T03:04:05Z
+,22,2.25,2.125,"jim, jr",false,
+3,33,3,3,,true,2020-01-02T05:00:00Z
+`,
+		},
+		{
+			scenario: "null, precision and delimiter options",
+			inDataFrame: func(pool memory.Allocator) dataframe.DataFrame {
+				ss := []series.Series{
+					series.FromInt64(pool, arrow.Field{Name: "f1-i64", Type: arrow.PrimitiveTypes.Int64}, []int64{1, 2}, []bool{false, true}),
+					series.FromFloat64(pool, arrow.Field{Name: "f2-f64", Type: arrow.PrimitiveTypes.Float64}, []float64{0.125, 2}, nil),
+				}
+				for _, s := range ss {
+					defer s.Release()
+				}
+
+				return dataframe.NewFromSeries(pool, ss)
+			},
+			inOptions: []dataframe.CSVOption{
+				dataframe.WithCSVNull("NA"),
+				dataframe.WithCSVPrecision(2),
+				dataframe.WithCSVDelimiter(';'),
+			},
+			exp: `f1-i64;f2-f64
+NA;0.12
+2;2.00
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.scenario, func(t *testing.T) {
+			pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer pool.AssertSize(t, 0)
+
+			df := tt.inDataFrame(pool)
+			defer df.Release()
+
+			var act strings.Builder
+			w := csv.NewWriter(&act)
+			err := df.WriteCSV(w, tt.inOptions...)
+			require.NoError(t, err)
+			assert.Equal(t, tt.exp, act.String())
+			assert.Equal(t, ',', w.Comma)
+		})
+	}
+}
+
+func TestWriteCSVRoundTrip(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	inCSV := `f1-i64,f2-f64,f3-str
+1,1.5,john
+,2.5,jim
+3,,julie
+`
+	df := dataframe.NewFromCSV(pool, csv.NewReader(strings.NewReader(inCSV)), -1, nil)
+	defer df.Release()
+
+	var act strings.Builder
+	err := df.WriteCSV(csv.NewWriter(&act))
+	require.NoError(t, err)
+	assert.Equal(t, inCSV, act.String())
+}
+
+func TestCSVTemporal(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	inCSV := `d32,d64,dur
+2020-01-02,2020-01-03,1h30m0s
+,1970-01-01,
+1969-12-31,2020-01-04,1.5s
+`
+	inTypes := map[string]arrow.DataType{
+		"d32": arrow.FixedWidthTypes.Date32,
+		"d64": arrow.FixedWidthTypes.Date64,
+		"dur": arrow.FixedWidthTypes.Duration_ms,
+	}
+	df := dataframe.NewFromCSV(pool, csv.NewReader(strings.NewReader(inCSV)), -1, inTypes)
+	defer df.Release()
+
+	assert.Equal(t, []arrow.Date32{18263, 0, -1}, df.Series(0).Values())
+	assert.Equal(t, []int{1}, df.Series(0).NAIndices())
+	assert.Equal(t, []arrow.Date64{1578009600000, 0, 1578096000000}, df.Series(1).Values())
+	assert.Equal(t, []arrow.Duration{5400000, 0, 1500}, df.Series(2).Values())
+	assert.Equal(t, []int{1}, df.Series(2).NAIndices())
+
+	var act strings.Builder
+	require.NoError(t, df.WriteCSV(csv.NewWriter(&act)))
+	assert.Equal(t, inCSV, act.String())
+
+	head := df.Head(1)
+	defer head.Release()
+
+	var buf bytes.Buffer
+	require.NoError(t, head.WriteNDJSON(&buf))
+	assert.Equal(t, "{\"d32\":\"2020-01-02\",\"d64\":\"2020-01-03\",\"dur\":\"1h30m0s\"}\n", buf.String())
+}
+
+func TestCSVDecimal(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	inCSV := `id,amount
+1,0.10
+2,0.20
+3,
+4,12345678.99
+`
+	dt := &arrow.Decimal128Type{Precision: 12, Scale: 2}
+	df := dataframe.NewFromCSV(pool, csv.NewReader(strings.NewReader(inCSV)), -1, map[string]arrow.DataType{"amount": dt})
+	defer df.Release()
+
+	assert.Equal(t, dt, df.Series(1).DataType())
+	assert.Equal(t, []int{2}, df.Series(1).NAIndices())
+	assert.Equal(t, "12345679.29", series.FormatDecimal(df.Series(1).DecimalSum(), dt.Scale))
+
+	var act strings.Builder
+	require.NoError(t, df.WriteCSV(csv.NewWriter(&act)))
+	assert.Equal(t, inCSV, act.String())
+
+	var buf bytes.Buffer
+	require.NoError(t, df.WriteNDJSON(&buf))
+	assert.Equal(t, "{\"id\":1,\"amount\":0.10}\n{\"id\":2,\"amount\":0.20}\n{\"id\":3,\"amount\":null}\n{\"id\":4,\"amount\":12345678.99}\n", buf.String())
+
+	assert.Panics(t, func() {
+		bad := dataframe.NewFromCSV(pool, csv.NewReader(strings.NewReader("amount\n0.125\n")), -1, map[string]arrow.DataType{"amount": dt})
+		bad.Release()
+	})
+}
+
+func TestCSVBinary(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	inCSV := `id,hash
+1,00ff
+2,
+3,cafe
+`
+	dt := &arrow.FixedSizeBinaryType{ByteWidth: 2}
+	df := dataframe.NewFromCSV(pool, csv.NewReader(strings.NewReader(inCSV)), -1, map[string]arrow.DataType{"hash": dt})
+	defer df.Release()
+
+	assert.Equal(t, dt, df.Series(1).DataType())
+	assert.Equal(t, [][]byte{{0x00, 0xff}, nil, {0xca, 0xfe}}, df.Series(1).Values())
+
+	var act strings.Builder
+	require.NoError(t, df.WriteCSV(csv.NewWriter(&act)))
+	assert.Equal(t, inCSV, act.String())
+
+	var buf bytes.Buffer
+	require.NoError(t, df.WriteNDJSON(&buf))
+	assert.Equal(t, "{\"id\":1,\"hash\":\"00ff\"}\n{\"id\":2,\"hash\":null}\n{\"id\":3,\"hash\":\"cafe\"}\n", buf.String())
+
+	assert.Panics(t, func() {
+		bad := dataframe.NewFromCSV(pool, csv.NewReader(strings.NewReader("hash\n00ff00\n")), -1, map[string]arrow.DataType{"hash": dt})
+		bad.Release()
+	})
+}
+
+func TestIPC(t *testing.T) {
+	tests := []struct {
+		scenario string
+
+		inDataFrame func(memory.Allocator) dataframe.DataFrame
+
+		expNumRows   int
+		exp          []interface{}
+		expNAIndices [][]int
+	}{
+		{
+			scenario: "all types",
+			inDataFrame: func(pool memory.Allocator) dataframe.DataFrame {
+				ss := []series.Series{
+					series.FromInt32(pool, arrow.Field{Name: "f1-i32", Type: arrow.PrimitiveTypes.Int32}, []int32{1, 2, 3}, []bool{true, false, true}),
+					series.FromInt64(pool, arrow.Field{Name: "f2-i64", Type: arrow.PrimitiveTypes.Int64}, []int64{11, 22, 33}, nil),
+					series.FromFloat32(pool, arrow.Field{Name: "f3-f32", Type: arrow.PrimitiveTypes.Float32}, []float32{1.5, 2.5, 3.5}, nil),
+					series.FromFloat64(pool, arrow.Field{Name: "f4-f64", Type: arrow.PrimitiveTypes.Float64}, []float64{1.25, 2.25, 3.25}, []bool{false, true, true}),
+					series.FromString(pool, arrow.Field{Name: "f5-str", Type: arrow.BinaryTypes.String}, []string{"john", "jim", "julie"}, nil),
+				}
+				for _, s := range ss {
+					defer s.Release()
+				}
+
+				return dataframe.NewFromSeries(pool, ss)
+			},
+			expNumRows: 3,
+			exp: []interface{}{
+				[]int32{1, 2, 3},
+				[]int64{11, 22, 33},
+				[]float32{1.5, 2.5, 3.5},
+				[]float64{1.25, 2.25, 3.25},
+				[]string{"john", "jim", "julie"},
+			},
+			expNAIndices: [][]int{
+				[]int{1},
+				[]int{},
+				[]int{},
+				[]int{0},
+				[]int{},
+			},
+		},
+		{
+			scenario: "no rows",
+			inDataFrame: func(pool memory.Allocator) dataframe.DataFrame {
+				ss := []series.Series{
+					series.FromInt64(pool, arrow.Field{Name: "f1-i64", Type: arrow.PrimitiveTypes.Int64}, []int64{}, nil),
+				}
+				for _, s := range ss {
+					defer s.Release()
+				}
+
+				return dataframe.NewFromSeries(pool, ss)
+			},
+			expNumRows: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.scenario, func(t *testing.T) {
+			pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer pool.AssertSize(t, 0)
+
+			df := tt.inDataFrame(pool)
+			defer df.Release()
+
+			check := func(t *testing.T, act dataframe.DataFrame) {
+				assert.True(t, df.Schema().Equal(act.Schema()))
+				assert.Equal(t, tt.expNumRows, int(act.NumRows()))
+				for i := range tt.exp {
+					assert.Equal(t, tt.exp[i], act.Series(i).Values())
+					assert.Equal(t, tt.expNAIndices[i], act.Series(i).NAIndices())
+				}
+			}
+
+			t.Run("stream", func(t *testing.T) {
+				var buf bytes.Buffer
+				require.NoError(t, df.WriteIPCStream(&buf))
+
+				act := dataframe.NewFromIPCStream(pool, &buf)
+				defer act.Release()
+				check(t, act)
+			})
+
+			t.Run("file", func(t *testing.T) {
+				f, err := ioutil.TempFile("", "fastframe-*.arrow")
+				require.NoError(t, err)
+				defer os.Remove(f.Name())
+				defer f.Close()
+
+				require.NoError(t, df.WriteIPCFile(f))
+
+				act := dataframe.NewFromIPCFile(pool, f)
+				defer act.Release()
+				check(t, act)
+			})
+		})
+	}
+}
+
+func TestNewFromIPCNoRecords(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	schema := arrow.NewSchema([]arrow.Field{
+		arrow.Field{Name: "f1-i64", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+		arrow.Field{Name: "f2-str", Type: arrow.BinaryTypes.String, Nullable: true},
+	}, nil)
+
+	t.Run("stream", func(t *testing.T) {
+		var buf bytes.Buffer
+		w := ipc.NewWriter(&buf, ipc.WithSchema(schema), ipc.WithAllocator(pool))
+		require.NoError(t, w.Close())
+
+		act := dataframe.NewFromIPCStream(pool, &buf)
+		defer act.Release()
+
+		assert.True(t, schema.Equal(act.Schema()))
+		assert.Equal(t, 0, int(act.NumRows()))
+	})
+
+	t.Run("file", func(t *testing.T) {
+		f, err := ioutil.TempFile("", "fastframe-*.arrow")
+		require.NoError(t, err)
+		defer os.Remove(f.Name())
+		defer f.Close()
+
+		w, err := ipc.NewFileWriter(f, ipc.WithSchema(schema), ipc.WithAllocator(pool))
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+
+		act := dataframe.NewFromIPCFile(pool, f)
+		defer act.Release()
+
+		assert.True(t, schema.Equal(act.Schema()))
+		assert.Equal(t, 0, int(act.NumRows()))
+	})
+}
+
+func TestNewFromIPCStreamMultipleRecords(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	schema := arrow.NewSchema([]arrow.Field{
+		arrow.Field{Name: "f1-i64", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+		arrow.Field{Name: "f2-str", Type: arrow.BinaryTypes.String, Nullable: true},
+	}, nil)
+
+	var buf bytes.Buffer
+	w := ipc.NewWriter(&buf, ipc.WithSchema(schema), ipc.WithAllocator(pool))
+	rb := array.NewRecordBuilder(pool, schema)
+	defer rb.Release()
+
+	rb.Field(0).(*array.Int64Builder).AppendValues([]int64{1, 2}, []bool{true, false})
+	rb.Field(1).(*array.StringBuilder).AppendValues([]string{"a", "b"}, nil)
+	rec1 := rb.NewRecord()
+	defer rec1.Release()
+	rb.Field(0).(*array.Int64Builder).AppendValues([]int64{3}, nil)
+	rb.Field(1).(*array.StringBuilder).AppendValues([]string{"c"}, []bool{false})
+	rec2 := rb.NewRecord()
+	defer rec2.Release()
+
+	require.NoError(t, w.Write(rec1))
+	require.NoError(t, w.Write(rec2))
+	require.NoError(t, w.Close())
+
+	act := dataframe.NewFromIPCStream(pool, &buf)
+	defer act.Release()
+
+	assert.Equal(t, []int64{1, 2, 3}, act.Series(0).Values())
+	assert.Equal(t, []int{1}, act.Series(0).NAIndices())
+	assert.Equal(t, []string{"a", "b", "c"}, act.Series(1).Values())
+	assert.Equal(t, []int{2}, act.Series(1).NAIndices())
+}
+
+func TestParquet(t *testing.T) {
+	newDataFrame := func(pool memory.Allocator) dataframe.DataFrame {
+		ts := &arrow.TimestampType{Unit: arrow.Microsecond, TimeZone: "UTC"}
+		tb := array.NewTimestampBuilder(pool, ts)
+		defer tb.Release()
+		tb.AppendValues([]arrow.Timestamp{1000000, 2000000, 3000000, 4000000}, []bool{true, true, false, true})
+		tsArr := tb.NewArray()
+
+		bb := array.NewBooleanBuilder(pool)
+		defer bb.Release()
+		bb.AppendValues([]bool{true, false, true, false}, nil)
+		boolArr := bb.NewArray()
+
+		ss := []series.Series{
+			series.FromInt32(pool, arrow.Field{Name: "f1-i32", Type: arrow.PrimitiveTypes.Int32}, []int32{1, 2, 3, 4}, []bool{true, false, true, true}),
+			series.FromInt64(pool, arrow.Field{Name: "f2-i64", Type: arrow.PrimitiveTypes.Int64}, []int64{11, 22, 33, 44}, nil),
+			series.FromFloat32(pool, arrow.Field{Name: "f3-f32", Type: arrow.PrimitiveTypes.Float32}, []float32{1.5, 2.5, 3.5, 4.5}, nil),
+			series.FromFloat64(pool, arrow.Field{Name: "f4-f64", Type: arrow.PrimitiveTypes.Float64}, []float64{1.25, 2.25, 3.25, 4.25}, []bool{false, true, true, true}),
+			series.FromString(pool, arrow.Field{Name: "f5-str", Type: arrow.BinaryTypes.String}, []string{"john", "jim", "julie", "jane"}, nil),
+			series.FromArrow(pool, arrow.Field{Name: "f6-bool", Type: arrow.FixedWidthTypes.Boolean}, boolArr),
+			series.FromArrow(pool, arrow.Field{Name: "f7-ts", Type: ts}, tsArr),
+			series.FromInt8(pool, arrow.Field{Name: "f8-i8", Type: arrow.PrimitiveTypes.Int8}, []int8{-128, 0, 1, 127}, nil),
+			series.FromUint8(pool, arrow.Field{Name: "f9-u8", Type: arrow.PrimitiveTypes.Uint8}, []uint8{0, 1, 200, 255}, []bool{true, true, true, false}),
+			series.FromUint64(pool, arrow.Field{Name: "f10-u64", Type: arrow.PrimitiveTypes.Uint64}, []uint64{0, 1, 1 << 63, 1<<64 - 1}, nil),
+			series.FromDate32(pool, arrow.Field{Name: "f11-d32", Type: arrow.FixedWidthTypes.Date32}, []arrow.Date32{-1, 0, 18262, 0}, []bool{true, true, true, false}),
+			series.FromDate64(pool, arrow.Field{Name: "f12-d64", Type: arrow.FixedWidthTypes.Date64}, []arrow.Date64{-86400000, 0, 1577836800000, 1577840400000}, nil),
+		}
+		for _, s := range ss {
+			defer s.Release()
+		}
+
+		return dataframe.NewFromSeries(pool, ss)
+	}
+
+	tests := []struct {
+		scenario string
+
+		inWriteOptions []dataframe.ParquetOption
+		inReadOptions  []dataframe.ParquetOption
+
+		expHeaders   []string
+		expTypes     []arrow.DataType
+		exp          []interface{}
+		expNAIndices [][]int
+	}{
+		{
+			scenario:   "all types",
+			expHeaders: []string{"f1-i32", "f2-i64", "f3-f32", "f4-f64", "f5-str", "f6-bool", "f7-ts", "f8-i8", "f9-u8", "f10-u64", "f11-d32", "f12-d64"},
+			expTypes: []arrow.DataType{
+				arrow.PrimitiveTypes.Int32,
+				arrow.PrimitiveTypes.Int64,
+				arrow.PrimitiveTypes.Float32,
+				arrow.PrimitiveTypes.Float64,
+				arrow.BinaryTypes.String,
+				arrow.FixedWidthTypes.Boolean,
+				&arrow.TimestampType{Unit: arrow.Microsecond, TimeZone: "UTC"},
+				arrow.PrimitiveTypes.Int8,
+				arrow.PrimitiveTypes.Uint8,
+				arrow.PrimitiveTypes.Uint64,
+				arrow.FixedWidthTypes.Date32,
+				arrow.FixedWidthTypes.Date32,
+			},
+			exp: []interface{}{
+				[]int32{1, 0, 3, 4},
+				[]int64{11, 22, 33, 44},
+				[]float32{1.5, 2.5, 3.5, 4.5},
+				[]float64{0, 2.25, 3.25, 4.25},
+				[]string{"john", "jim", "julie", "jane"},
+				[]bool{true, false, true, false},
+				[]arrow.Timestamp{1000000, 2000000, 0, 4000000},
+				[]int8{-128, 0, 1, 127},
+				[]uint8{0, 1, 200, 0},
+				[]uint64{0, 1, 1 << 63, 1<<64 - 1},
+				[]arrow.Date32{-1, 0, 18262, 0},
+				[]arrow.Date32{-1, 0, 18262, 18262},
+			},
+			expNAIndices: [][]int{
+				[]int{1},
+				[]int{},
+				[]int{},
+				[]int{0},
+				[]int{},
+				[]int{},
+				[]int{2},
+				[]int{},
+				[]int{3},
+				[]int{},
+				[]int{3},
+				[]int{},
+			},
+		},
+		{
+			scenario: "compression",
+			inWriteOptions: []dataframe.ParquetOption{
+				dataframe.WithParquetCompression(dataframe.ParquetGzip),
+			},
+			inReadOptions: []dataframe.ParquetOption{
+				dataframe.WithParquetColumns("f2-i64"),
+			},
+			expHeaders: []string{"f2-i64"},
+			expTypes:   []arrow.DataType{arrow.PrimitiveTypes.Int64},
+			exp: []interface{}{
+				[]int64{11, 22, 33, 44},
+			},
+			expNAIndices: [][]int{
+				[]int{},
+			},
+		},
+		{
+			scenario: "selected columns and row groups",
+			inWriteOptions: []dataframe.ParquetOption{
+				dataframe.WithParquetCompression(dataframe.ParquetUncompressed),
+				dataframe.WithParquetRowGroupSize(1),
+			},
+			inReadOptions: []dataframe.ParquetOption{
+				dataframe.WithParquetColumns("f5-str", "f1-i32"),
+				dataframe.WithParquetRowGroups(3, 1),
+			},
+			expHeaders: []string{"f1-i32", "f5-str"},
+			expTypes:   []arrow.DataType{arrow.PrimitiveTypes.Int32, arrow.BinaryTypes.String},
+			exp: []interface{}{
+				[]int32{0, 4},
+				[]string{"jim", "jane"},
+			},
+			expNAIndices: [][]int{
+				[]int{0},
+				[]int{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.scenario, func(t *testing.T) {
+			pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer pool.AssertSize(t, 0)
+
+			df := newDataFrame(pool)
+			defer df.Release()
+
+			var buf bytes.Buffer
+			require.NoError(t, df.WriteParquet(&buf, tt.inWriteOptions...))
+
+			r := bytes.NewReader(buf.Bytes())
+			act := dataframe.NewFromParquet(pool, r, r.Size(), tt.inReadOptions...)
+			defer act.Release()
+
+			assert.Equal(t, tt.expHeaders, act.Headers())
+			for i := range tt.expTypes {
+				assert.Equal(t, tt.expTypes[i], act.Series(i).DataType())
+				assert.Equal(t, tt.expNAIndices[i], act.Series(i).NAIndices())
+			}
+			for i := range tt.exp {
+				assert.Equal(t, tt.exp[i], act.Series(i).Values())
+			}
+		})
+	}
+}
+
+func TestWriteParquetUnsupported(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	typ := &arrow.DurationType{Unit: arrow.Second}
+	s := series.FromDuration(pool, arrow.Field{Name: "f1-dur", Type: typ}, []arrow.Duration{1, 2}, nil)
+	defer s.Release()
+	df := dataframe.NewFromSeries(pool, []series.Series{s})
+	defer df.Release()
+
+	var buf bytes.Buffer
+	assert.EqualError(t, df.WriteParquet(&buf), `dataframe: write_parquet: column "f1-dur": unsupported type duration`)
+}
+
+func TestWriteCategorical(t *testing.T) {
+	newDataFrame := func(pool memory.Allocator) dataframe.DataFrame {
+		str := series.FromString(pool, arrow.Field{Name: "f1-cat", Type: arrow.BinaryTypes.String}, []string{"b", "a", "", "b"}, []bool{true, true, false, true})
+		defer str.Release()
+		cat := str.AsCategorical()
+		defer cat.Release()
+
+		return dataframe.NewFromSeries(pool, []series.Series{cat})
+	}
+
+	check := func(t *testing.T, act dataframe.DataFrame) {
+		assert.Equal(t, arrow.BinaryTypes.String, act.Series(0).DataType())
+		assert.Equal(t, []string{"b", "a", "", "b"}, act.Series(0).Values())
+		assert.Equal(t, []int{2}, act.Series(0).NAIndices())
+	}
+
+	t.Run("ipc stream", func(t *testing.T) {
+		pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+		defer pool.AssertSize(t, 0)
+
+		df := newDataFrame(pool)
+		defer df.Release()
+
+		var buf bytes.Buffer
+		require.NoError(t, df.WriteIPCStream(&buf))
+
+		act := dataframe.NewFromIPCStream(pool, &buf)
+		defer act.Release()
+		check(t, act)
+	})
+
+	t.Run("ipc file", func(t *testing.T) {
+		pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+		defer pool.AssertSize(t, 0)
+
+		df := newDataFrame(pool)
+		defer df.Release()
+
+		f, err := ioutil.TempFile("", "fastframe-*.arrow")
+		require.NoError(t, err)
+		defer os.Remove(f.Name())
+		defer f.Close()
+
+		require.NoError(t, df.WriteIPCFile(f))
+
+		act := dataframe.NewFromIPCFile(pool, f)
+		defer act.Release()
+		check(t, act)
+	})
+
+	t.Run("parquet", func(t *testing.T) {
+		pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+		defer pool.AssertSize(t, 0)
+
+		df := newDataFrame(pool)
+		defer df.Release()
+
+		var buf bytes.Buffer
+		require.NoError(t, df.WriteParquet(&buf))
+
+		r := bytes.NewReader(buf.Bytes())
+		act := dataframe.NewFromParquet(pool, r, r.Size())
+		defer act.Release()
+		check(t, act)
+	})
+}
+
+func TestNewFromJSON(t *testing.T) {
+	tests := []struct {
+		scenario string
+
+		inJSON   string
+		inNDJSON bool
+		inTypes  map[string]arrow.DataType
+
+		expNumRows   int
+		expHeaders   []string
+		expTypes     []arrow.DataType
+		exp          []interface{}
+		expNAIndices [][]int
+	}{
+		{
+			scenario: "array of objects",
+			inJSON: `[
+				{"id": 1, "score": 1.5, "name": "jim", "ok": true, "raw": {"b": 1, "a": [2]}},
+				{"id": 2, "score": 2, "name": null, "ok": false, "raw": "x"},
+				{"id": 3, "name": "julie", "ok": null, "extra": [1, 2]}
+			]`,
+			expNumRows: 3,
+			expHeaders: []string{"id", "score", "name", "ok", "raw", "extra"},
+			expTypes: []arrow.DataType{
+				arrow.PrimitiveTypes.Int64,
+				arrow.PrimitiveTypes.Float64,
+				arrow.BinaryTypes.String,
+				arrow.FixedWidthTypes.Boolean,
+				arrow.BinaryTypes.String,
+				arrow.ListOf(arrow.PrimitiveTypes.Int64),
+			},
+			exp: []interface{}{
+				[]int64{1, 2, 3},
+				[]float64{1.5, 2, 0},
+				[]string{"jim", "", "julie"},
+				[]bool{true, false, false},
+				[]string{`{"b":1,"a":[2]}`, "x", ""},
+				[][]interface{}{nil, nil, {int64(1), int64(2)}},
+			},
+			expNAIndices: [][]int{
+				[]int{},
+				[]int{2},
+				[]int{1},
+				[]int{2},
+				[]int{2},
+				[]int{0, 1},
+			},
+		},
+		{
+			scenario: "ndjson",
+			inJSON: `{"id": 1, "name": "jim"}
+{"id": 2, "name": 7}
+{"name": "julie"}
+`,
+			inNDJSON:   true,
+			expNumRows: 3,
+			expHeaders: []string{"id", "name"},
+			expTypes: []arrow.DataType{
+				arrow.PrimitiveTypes.Int64,
+				arrow.BinaryTypes.String,
+			},
+			exp: []interface{}{
+				[]int64{1, 2, 0},
+				[]string{"jim", "7", "julie"},
+			},
+			expNAIndices: [][]int{
+				[]int{2},
+				[]int{},
+			},
+		},
+		{
+			scenario:   "integers above max int64",
+			inJSON:     `[{"u": 18446744073709551615, "f": 18446744073709551615}, {"u": 1, "f": -1}]`,
+			expNumRows: 2,
+			expHeaders: []string{"u", "f"},
+			expTypes: []arrow.DataType{
+				arrow.PrimitiveTypes.Uint64,
+				arrow.PrimitiveTypes.Float64,
+			},
+			exp: []interface{}{
+				[]uint64{math.MaxUint64, 1},
+				[]float64{math.MaxUint64, -1},
+			},
+			expNAIndices: [][]int{
+				[]int{},
+				[]int{},
+			},
+		},
+		{
+			scenario: "typed",
+			inJSON:   `[{"id": 1, "at": "2020-01-02T03:04:05Z"}, {"id": 2, "at": null}]`,
+			inTypes: map[string]arrow.DataType{
+				"id": arrow.PrimitiveTypes.Int32,
+				"at": &arrow.TimestampType{Unit: arrow.Second, TimeZone: "UTC"},
+			},
+			expNumRows: 2,
+			expHeaders: []string{"id", "at"},
+			expTypes: []arrow.DataType{
+				arrow.PrimitiveTypes.Int32,
+				&arrow.TimestampType{Unit: arrow.Second, TimeZone: "UTC"},
+			},
+			exp: []interface{}{
+				[]int32{1, 2},
+			},
+			expNAIndices: [][]int{
+				[]int{},
+				[]int{1},
+			},
+		},
+		{
+			scenario: "nested",
+			inJSON: `{"id": 1, "tags": ["a", "b"], "user": {"name": "jim", "age": 30}}
+{"id": 2, "tags": [], "user": {"name": "joe"}}
+{"id": 3, "tags": null, "user": null}
+`,
+			inNDJSON: true,
+			inTypes: map[string]arrow.DataType{
+				"tags": arrow.ListOf(arrow.BinaryTypes.String),
+				"user": arrow.StructOf(
+					arrow.Field{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
+					arrow.Field{Name: "age", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+				),
+			},
+			expNumRows: 3,
+			expHeaders: []string{"id", "tags", "user"},
+			expTypes: []arrow.DataType{
+				arrow.PrimitiveTypes.Int64,
+				arrow.ListOf(arrow.BinaryTypes.String),
+				arrow.StructOf(
+					arrow.Field{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true},
+					arrow.Field{Name: "age", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+				),
+			},
+			exp: []interface{}{
+				[]int64{1, 2, 3},
+				[][]interface{}{{"a", "b"}, {}, nil},
+				[]map[string]interface{}{
+					{"name": "jim", "age": int64(30)},
+					{"name": "joe"},
+					nil,
+				},
+			},
+			expNAIndices: [][]int{
+				[]int{},
+				[]int{2},
+				[]int{2},
+			},
+		},
+		{
+			scenario:   "empty",
+			inJSON:     `[]`,
+			expNumRows: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.scenario, func(t *testing.T) {
+			pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer pool.AssertSize(t, 0)
+
+			var act dataframe.DataFrame
+			if tt.inNDJSON {
+				act = dataframe.NewFromNDJSON(pool, strings.NewReader(tt.inJSON), tt.inTypes)
+			} else {
+				act = dataframe.NewFromJSON(pool, strings.NewReader(tt.inJSON), tt.inTypes)
+			}
+			defer act.Release()
+
+			assert.Equal(t, tt.expNumRows, int(act.NumRows()))
+			assert.Equal(t, len(tt.expHeaders), int(act.NumCols()))
+			if tt.expHeaders != nil {
+				assert.Equal(t, tt.expHeaders, act.Headers())
+			}
+			for i := range tt.expTypes {
+				assert.Equal(t, tt.expTypes[i], act.Series(i).DataType())
+				assert.Equal(t, tt.expNAIndices[i], act.Series(i).NAIndices())
+			}
+			for i := range tt.exp {
+				assert.Equal(t, tt.exp[i], act.Series(i).Values())
+			}
+		})
+	}
+}
+
+func TestNewFromJSONInvalidValue(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	tList := map[string]arrow.DataType{"b": arrow.PrimitiveTypes.Int64}
+	assert.Panics(t, func() {
+		dataframe.NewFromJSON(pool, strings.NewReader(`[{"a": 1, "b": 1}, {"a": 2, "b": "x"}]`), tList)
+	})
+	assert.Panics(t, func() {
+		dataframe.NewFromJSON(pool, strings.NewReader(`[{"a": 1, "b": 1}, {"a": 2, "b": ""}]`), tList)
+	})
+	assert.Panics(t, func() {
+		dataframe.NewFromJSON(pool, strings.NewReader(`[{"a": 1, "b": 1}, {"a": 2, "b": "2"}]`), tList)
+	})
+	assert.Panics(t, func() {
+		dataframe.NewFromNDJSON(pool, strings.NewReader(`{"a": 1`), nil)
+	})
+}
+
+func TestWriteJSON(t *testing.T) {
+	newDataFrame := func(pool memory.Allocator) dataframe.DataFrame {
+		ss := []series.Series{
+			series.FromInt64(pool, arrow.Field{Name: "id", Type: arrow.PrimitiveTypes.Int64}, []int64{1, 2}, nil),
+			series.FromFloat64(pool, arrow.Field{Name: "score", Type: arrow.PrimitiveTypes.Float64}, []float64{1.5, math.NaN()}, nil),
+			series.FromString(pool, arrow.Field{Name: "name", Type: arrow.BinaryTypes.String}, []string{"jim", "\"j\""}, []bool{true, false}),
+		}
+		for _, s := range ss {
+			defer s.Release()
+		}
+
+		return dataframe.NewFromSeries(pool, ss)
+	}
+
+	tests := []struct {
+		scenario string
+
+		write func(dataframe.DataFrame, *bytes.Buffer) error
+
+		exp string
+	}{
+		{
+			scenario: "records",
+			write: func(df dataframe.DataFrame, buf *bytes.Buffer) error {
+				return df.WriteJSON(buf, dataframe.JSONRecords)
+			},
+			exp: `[{"id":1,"score":1.5,"name":"jim"},{"id":2,"score":null,"name":null}]`,
+		},
+		{
+			scenario: "columns",
+			write: func(df dataframe.DataFrame, buf *bytes.Buffer) error {
+				return df.WriteJSON(buf, dataframe.JSONColumns)
+			},
+			exp: `{"id":[1,2],"score":[1.5,null],"name":["jim",null]}`,
+		},
+		{
+			scenario: "ndjson",
+			write: func(df dataframe.DataFrame, buf *bytes.Buffer) error {
+				return df.WriteNDJSON(buf)
+			},
+			exp: "{\"id\":1,\"score\":1.5,\"name\":\"jim\"}\n{\"id\":2,\"score\":null,\"name\":null}\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.scenario, func(t *testing.T) {
+			pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer pool.AssertSize(t, 0)
+
+			df := newDataFrame(pool)
+			defer df.Release()
+
+			var buf bytes.Buffer
+			require.NoError(t, tt.write(df, &buf))
+			assert.Equal(t, tt.exp, buf.String())
+		})
+	}
+}
+
+func TestWriteJSONRoundTrip(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	items := series.FromInt64(pool, arrow.Field{Name: "item", Type: arrow.PrimitiveTypes.Int64, Nullable: true}, []int64{1, 2, 3, 4}, []bool{true, true, false, true})
+	defer items.Release()
+	age := series.FromInt64(pool, arrow.Field{Name: "age", Type: arrow.PrimitiveTypes.Int64, Nullable: true}, []int64{30, 0, 40}, []bool{true, false, true})
+	defer age.Release()
+	city := series.FromString(pool, arrow.Field{Name: "city", Type: arrow.BinaryTypes.String, Nullable: true}, []string{"nyc", "sf", ""}, []bool{true, true, false})
+	defer city.Release()
+
+	ss := []series.Series{
+		series.FromInt64(pool, arrow.Field{Name: "id", Type: arrow.PrimitiveTypes.Int64}, []int64{1, 2, 3}, []bool{true, false, true}),
+		series.FromString(pool, arrow.Field{Name: "name", Type: arrow.BinaryTypes.String}, []string{"jim", "joe", "julie"}, nil),
+		series.FromList(pool, arrow.Field{Name: "scores", Type: arrow.ListOf(arrow.PrimitiveTypes.Int64)}, items, []int32{0, 2, 2, 4}, []bool{true, false, true}),
+		series.FromStruct(pool, arrow.Field{Name: "user", Type: arrow.StructOf(age.Field(), city.Field())}, []series.Series{age, city}, nil),
+	}
+	df := dataframe.NewFromSeries(pool, ss)
+	defer df.Release()
+	for _, s := range ss {
+		s.Release()
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, df.WriteNDJSON(&buf))
+
+	act := dataframe.NewFromNDJSON(pool, &buf, nil)
+	defer act.Release()
+
+	assert.Equal(t, df.Headers(), act.Headers())
+	assert.Equal(t, []int{1}, act.Series(0).NAIndices())
+	assert.Equal(t, df.Series(1).Values(), act.Series(1).Values())
+	for i := 2; i < 4; i++ {
+		assert.True(t, arrow.TypeEqual(df.Series(i).DataType(), act.Series(i).DataType()), "column %d", i)
+		assert.Equal(t, df.Series(i).Values(), act.Series(i).Values())
+		assert.Equal(t, df.Series(i).NAIndices(), act.Series(i).NAIndices())
+	}
+}
+
+type structUser struct {
+	ID      int64      `fastframe:"id"`
+	Age     int32      `fastframe:"age"`
+	Score   *float64   `fastframe:"score"`
+	Name    string     `fastframe:"name"`
+	Active  bool       `fastframe:"active"`
+	Created *time.Time `fastframe:"created"`
+	Notes   string     `fastframe:"-"`
+	Weight  float32
+	secret  string
+}
+
+func TestNewFromStructs(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	score := 1.5
+	created := time.Date(2020, 1, 2, 3, 4, 5, 6, time.UTC)
+	users := []structUser{
+		{ID: 1, Age: 20, Score: &score, Name: "jim", Active: true, Created: &created, Notes: "a", Weight: 70.5, secret: "x"},
+		{ID: 2, Age: 30, Name: "joe", Weight: 80},
+	}
+
+	act := dataframe.NewFromStructs(pool, users)
+	defer act.Release()
+
+	assert.Equal(t, []string{"id", "age", "score", "name", "active", "created", "Weight"}, act.Headers())
+	assert.Equal(t, 2, int(act.NumRows()))
+	assert.Equal(t, []int64{1, 2}, act.Series(0).Values())
+	assert.Equal(t, []int32{20, 30}, act.Series(1).Values())
+	assert.Equal(t, []int{1}, act.Series(2).NAIndices())
+	assert.True(t, act.Series(2).Field().Nullable)
+	assert.False(t, act.Series(0).Field().Nullable)
+	assert.Equal(t, []string{"jim", "joe"}, act.Series(3).Values())
+	assert.Equal(t, []bool{true, false}, act.Series(4).Values())
+	assert.Equal(t, &arrow.TimestampType{Unit: arrow.Nanosecond, TimeZone: "UTC"}, act.Series(5).DataType())
+	assert.Equal(t, []int{1}, act.Series(5).NAIndices())
+	assert.Equal(t, []float32{70.5, 80}, act.Series(6).Values())
+
+	ptrs := dataframe.NewFromStructs(pool, []*structUser{&users[0]})
+	defer ptrs.Release()
+	assert.Equal(t, 1, int(ptrs.NumRows()))
+
+	empty := dataframe.NewFromStructs(pool, []structUser{})
+	defer empty.Release()
+	assert.Equal(t, 7, int(empty.NumCols()))
+	assert.Equal(t, 0, int(empty.NumRows()))
+
+	assert.Panics(t, func() { dataframe.NewFromStructs(pool, users[0]) })
+	assert.Panics(t, func() { dataframe.NewFromStructs(pool, []struct{ C complex64 }{{}}) })
+}
+
+func TestToStructs(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	score := 1.5
+	created := time.Date(2020, 1, 2, 3, 4, 5, 6, time.UTC)
+	users := []structUser{
+		{ID: 1, Age: 20, Score: &score, Name: "jim", Active: true, Created: &created, Weight: 70.5},
+		{ID: 2, Age: 30, Name: "joe", Weight: 80},
+	}
+
+	df := dataframe.NewFromStructs(pool, users)
+	defer df.Release()
+
+	var act []structUser
+	require.NoError(t, df.ToStructs(&act))
+	assert.Equal(t, users, act)
+
+	var ptrs []*structUser
+	require.NoError(t, df.ToStructs(&ptrs))
+	require.Len(t, ptrs, 2)
+	assert.Equal(t, users[1], *ptrs[1])
+
+	type partial struct {
+		ID    float64 `fastframe:"id"`
+		Age   int     `fastframe:"age"`
+		Other string  `fastframe:"other"`
+	}
+	var parts []partial
+	require.NoError(t, df.ToStructs(&parts))
+	assert.Equal(t, []partial{{ID: 1, Age: 20}, {ID: 2, Age: 30}}, parts)
+
+	type mismatch struct {
+		Name int64 `fastframe:"name"`
+	}
+	var mismatches []mismatch
+	assert.Error(t, df.ToStructs(&mismatches))
+	assert.Error(t, df.ToStructs(act))
+
+	type narrow struct {
+		ID  int8  `fastframe:"id"`
+		Age int16 `fastframe:"age"`
+	}
+	var narrows []narrow
+	require.NoError(t, df.ToStructs(&narrows))
+	assert.Equal(t, []narrow{{ID: 1, Age: 20}, {ID: 2, Age: 30}}, narrows)
+
+	big := dataframe.NewFromStructs(pool, []structUser{{ID: 1}, {ID: 300}})
+	defer big.Release()
+	type overflow struct {
+		ID int8 `fastframe:"id"`
+	}
+	var overflows []overflow
+	assert.EqualError(t, big.ToStructs(&overflows), `dataframe: to_structs: field "id": row 1: value 300 out of range of int8`)
+	assert.Nil(t, overflows)
+}
+
+type structSizes struct {
+	I8  int8    `fastframe:"i8"`
+	I16 *int16  `fastframe:"i16"`
+	U8  uint8   `fastframe:"u8"`
+	U16 uint16  `fastframe:"u16"`
+	U32 *uint32 `fastframe:"u32"`
+	U   uint    `fastframe:"u"`
+	U64 uint64  `fastframe:"u64"`
+}
+
+func TestStructsSizedIntegers(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	i16 := int16(-300)
+	u32 := uint32(70000)
+	sizes := []structSizes{
+		{I8: -1, I16: &i16, U8: 255, U16: 65535, U32: &u32, U: 1, U64: math.MaxUint64},
+		{I8: 2, U8: 1, U16: 2, U: 3, U64: 4},
+	}
+
+	df := dataframe.NewFromStructs(pool, sizes)
+	defer df.Release()
+
+	assert.Equal(t, []string{"i8", "i16", "u8", "u16", "u32", "u", "u64"}, df.Headers())
+	assert.Equal(t, []int8{-1, 2}, df.Series(0).Values())
+	assert.Equal(t, arrow.PrimitiveTypes.Int16, df.Series(1).DataType())
+	assert.Equal(t, []int{1}, df.Series(1).NAIndices())
+	assert.Equal(t, []uint8{255, 1}, df.Series(2).Values())
+	assert.Equal(t, []uint16{65535, 2}, df.Series(3).Values())
+	assert.Equal(t, arrow.PrimitiveTypes.Uint32, df.Series(4).DataType())
+	assert.Equal(t, []int{1}, df.Series(4).NAIndices())
+	assert.Equal(t, []uint64{1, 3}, df.Series(5).Values())
+	assert.Equal(t, []uint64{math.MaxUint64, 4}, df.Series(6).Values())
+
+	var act []structSizes
+	require.NoError(t, df.ToStructs(&act))
+	assert.Equal(t, sizes, act)
+
+	type widened struct {
+		I8  int64   `fastframe:"i8"`
+		U8  int16   `fastframe:"u8"`
+		U16 uint32  `fastframe:"u16"`
+		U   int8    `fastframe:"u"`
+		U64 float64 `fastframe:"u64"`
+	}
+	var wides []widened
+	require.NoError(t, df.ToStructs(&wides))
+	assert.Equal(t, []widened{{I8: -1, U8: 255, U16: 65535, U: 1, U64: math.MaxUint64}, {I8: 2, U8: 1, U16: 2, U: 3, U64: 4}}, wides)
+
+	type negative struct {
+		I8 uint8 `fastframe:"i8"`
+	}
+	var negatives []negative
+	assert.EqualError(t, df.ToStructs(&negatives), `dataframe: to_structs: field "i8": row 0: value -1 out of range of uint8`)
+
+	type overflow struct {
+		U8 int8 `fastframe:"u8"`
+	}
+	var overflows []overflow
+	assert.EqualError(t, df.ToStructs(&overflows), `dataframe: to_structs: field "u8": row 0: value 255 out of range of int8`)
+
+	type huge struct {
+		U64 int64 `fastframe:"u64"`
+	}
+	var huges []huge
+	assert.EqualError(t, df.ToStructs(&huges), `dataframe: to_structs: field "u64": row 0: value 18446744073709551615 out of range of int64`)
+}
+
+func TestNewFromMatrix(t *testing.T) {
+	tests := []struct {
+		scenario string
+
+		inMatrix mat.Matrix
+		inNames  []string
+
+		expHeaders []string
+		exp        []interface{}
+	}{
+		{
+			scenario:   "dense with names",
+			inMatrix:   mat.NewDense(2, 3, []float64{1, 2, 3, 4, 5, 6}),
+			inNames:    []string{"a", "b", "c"},
+			expHeaders: []string{"a", "b", "c"},
+			exp: []interface{}{
+				[]float64{1, 4},
+				[]float64{2, 5},
+				[]float64{3, 6},
+			},
+		},
+		{
+			scenario:   "transpose without names",
+			inMatrix:   mat.NewDense(2, 3, []float64{1, 2, 3, 4, 5, 6}).T(),
+			expHeaders: []string{"0", "1"},
+			exp: []interface{}{
+				[]float64{1, 2, 3},
+				[]float64{4, 5, 6},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.scenario, func(t *testing.T) {
+			pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer pool.AssertSize(t, 0)
+
+			act := dataframe.NewFromMatrix(pool, tt.inMatrix, tt.inNames)
+			defer act.Release()
+
+			assert.Equal(t, tt.expHeaders, act.Headers())
+			for i := range tt.exp {
+				assert.Equal(t, arrow.PrimitiveTypes.Float64, act.Series(i).DataType())
+				assert.Equal(t, tt.exp[i], act.Series(i).Values())
+			}
+		})
+	}
+
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+	assert.Panics(t, func() {
+		dataframe.NewFromMatrix(pool, mat.NewDense(1, 2, nil), []string{"a"})
+	})
+}
+
+func TestToDense(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	ss := []series.Series{
+		series.FromInt32(pool, arrow.Field{Name: "f1-i32", Type: arrow.PrimitiveTypes.Int32}, []int32{1, 2, 3}, nil),
+		series.FromInt64(pool, arrow.Field{Name: "f2-i64", Type: arrow.PrimitiveTypes.Int64}, []int64{11, 22, 33}, nil),
+		series.FromFloat32(pool, arrow.Field{Name: "f3-f32", Type: arrow.PrimitiveTypes.Float32}, []float32{1.5, 2.5, 3.5}, nil),
+		series.FromFloat64(pool, arrow.Field{Name: "f4-f64", Type: arrow.PrimitiveTypes.Float64}, []float64{1.25, 2.25, 3.25}, nil),
+		series.FromString(pool, arrow.Field{Name: "f5-str", Type: arrow.BinaryTypes.String}, []string{"1", "", "3.5"}, nil),
+	}
+	df := dataframe.NewFromSeries(pool, ss)
+	defer df.Release()
+	for _, s := range ss {
+		s.Release()
+	}
+
+	act := df.ToDense()
+	assert.True(t, mat.Equal(mat.DenseCopyOf(df), act))
+	assert.Equal(t, []float64{2, 22, 2.5, 2.25, 0}, act.RawRowView(1))
+
+	back := dataframe.NewFromMatrix(pool, act, df.Headers())
+	defer back.Release()
+	assert.Equal(t, df.Headers(), back.Headers())
+	assert.Equal(t, []float64{11, 22, 33}, back.Series(1).Values())
+
+	empty := dataframe.NewFromSeries(pool, nil)
+	r, c := empty.ToDense().Dims()
+	assert.Equal(t, 0, r)
+	assert.Equal(t, 0, c)
+}
+
+// sqlStandIn is an in-process database/sql driver standing in for SQLite. It
+// stores tables in memory, answers "SELECT * FROM <table>" queries and records
+// the INSERT statements it executes.
+type sqlStandIn struct {
+	mu        sync.Mutex
+	tables    map[string]*sqlStandInTable
+	inserts   []string
+	failAfter int
+	commits   int
+	rollbacks int
+}
+
+type sqlStandInTable struct {
+	columns   []string
+	types     []string
+	scanTypes []reflect.Type
+	decimals  map[int][2]int64
+	rows      [][]driver.Value
+}
+
+var (
+	sqlStandInsMu sync.Mutex
+	sqlStandIns   = map[string]*sqlStandIn{}
+)
+
+func init() {
+	sql.Register("fastframe-standin", sqlStandInDriver{})
+}
+
+// openSQLStandIn opens a database backed by a new sqlStandIn.
+func openSQLStandIn(t *testing.T) (*sql.DB, *sqlStandIn) {
+	db := &sqlStandIn{tables: map[string]*sqlStandInTable{}, failAfter: -1}
+	sqlStandInsMu.Lock()
+	sqlStandIns[t.Name()] = db
+	sqlStandInsMu.Unlock()
+
+	conn, err := sql.Open("fastframe-standin", t.Name())
+	require.NoError(t, err)
+	return conn, db
+}
+
+type sqlStandInDriver struct{}
+
+func (sqlStandInDriver) Open(name string) (driver.Conn, error) {
+	sqlStandInsMu.Lock()
+	defer sqlStandInsMu.Unlock()
+	return &sqlStandInConn{db: sqlStandIns[name]}, nil
+}
+
+type sqlStandInConn struct {
+	db      *sqlStandIn
+	pending map[string][][]driver.Value
+}
+
+func (c *sqlStandInConn) Prepare(query string) (driver.Stmt, error) {
+	return &sqlStandInStmt{conn: c, query: query}, nil
+}
+
+func (c *sqlStandInConn) Close() error { return nil }
+
+func (c *sqlStandInConn) Begin() (driver.Tx, error) {
+	c.pending = map[string][][]driver.Value{}
+	return c, nil
+}
+
+func (c *sqlStandInConn) Commit() error {
+	c.db.mu.Lock()
+	defer c.db.mu.Unlock()
+	for name, rows := range c.pending {
+		c.db.tables[name].rows = append(c.db.tables[name].rows, rows...)
+	}
+	c.pending = nil
+	c.db.commits++
+	return nil
+}
+
+func (c *sqlStandInConn) Rollback() error {
+	c.db.mu.Lock()
+	defer c.db.mu.Unlock()
+	c.pending = nil
+	c.db.rollbacks++
+	return nil
+}
+
+type sqlStandInStmt struct {
+	conn  *sqlStandInConn
+	query string
+}
+
+func (s *sqlStandInStmt) Close() error  { return nil }
+func (s *sqlStandInStmt) NumInput() int { return -1 }
+
+// Exec supports statements of the form
+// INSERT INTO "table" ("a", "b") VALUES (?, ?), ...
+func (s *sqlStandInStmt) Exec(args []driver.Value) (driver.Result, error) {
+	db := s.conn.db
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if db.failAfter == len(db.inserts) {
+		return nil, errors.New("standin: insert failed")
+	}
+	db.inserts = append(db.inserts, s.query)
+
+	fields := strings.SplitN(s.query, " ", 4)
+	name := strings.Trim(fields[2], `"`)
+	table, ok := db.tables[name]
+	if !ok {
+		return nil, fmt.Errorf("standin: no such table %q", name)
+	}
+	cols := strings.Count(s.query[:strings.Index(s.query, ")")], ",") + 1
+	if cols != len(table.columns) {
+		return nil, fmt.Errorf("standin: %d columns for %d", cols, len(table.columns))
+	}
+	for i := 0; i < len(args); i += cols {
+		s.conn.pending[name] = append(s.conn.pending[name], args[i:i+cols])
+	}
+
+	return driver.RowsAffected(len(args) / cols), nil
+}
+
+// Query supports statements of the form SELECT * FROM table.
+func (s *sqlStandInStmt) Query(args []driver.Value) (driver.Rows, error) {
+	db := s.conn.db
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	fields := strings.Fields(s.query)
+	table, ok := db.tables[fields[len(fields)-1]]
+	if !ok {
+		return nil, fmt.Errorf("standin: no such table %q", fields[len(fields)-1])
+	}
+
+	return &sqlStandInRows{table: table}, nil
+}
 
-			inSeries := tt.inSeries(pool)
-			for i := range inSeries {
-				defer inSeries[i].Release()
-			}
+type sqlStandInRows struct {
+	table *sqlStandInTable
+	i     int
+}
 
-			act := dataframe.NewFromSeries(pool, inSeries)
-			defer act.Release()
+func (r *sqlStandInRows) Columns() []string { return r.table.columns }
+func (r *sqlStandInRows) Close() error      { return nil }
 
-			numR, numC := act.Dims()
-			require.Equal(t, tt.expNumCols, numC)
-			require.Equal(t, tt.expNumRows, numR)
+func (r *sqlStandInRows) Next(dest []driver.Value) error {
+	if r.i >= len(r.table.rows) {
+		return io.EOF
+	}
+	copy(dest, r.table.rows[r.i])
+	r.i++
+	return nil
+}
 
-			for i := range tt.exp {
-				assert.Equal(t, tt.exp[i], act.Series(i).Values())
-			}
-		})
+// ColumnTypeDatabaseTypeName reports the declared type of the column, which is
+// all SQLite knows about it.
+func (r *sqlStandInRows) ColumnTypeDatabaseTypeName(i int) string {
+	return r.table.types[i]
+}
+
+// ColumnTypeScanType reports the Go type of the column when the table has one,
+// standing in for drivers which know it.
+func (r *sqlStandInRows) ColumnTypeScanType(i int) reflect.Type {
+	if i < len(r.table.scanTypes) && r.table.scanTypes[i] != nil {
+		return r.table.scanTypes[i]
 	}
+	return reflect.TypeOf(new(interface{})).Elem()
 }
 
-func TestNewFromCSV(t *testing.T) {
+// ColumnTypePrecisionScale reports the precision and scale of decimal columns.
+func (r *sqlStandInRows) ColumnTypePrecisionScale(i int) (int64, int64, bool) {
+	d, ok := r.table.decimals[i]
+	return d[0], d[1], ok
+}
+
+func TestNewFromSQLRows(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	conn, db := openSQLStandIn(t)
+	defer conn.Close()
+
+	at := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	db.tables["users"] = &sqlStandInTable{
+		columns: []string{"id", "score", "name", "active", "created", "blob"},
+		types:   []string{"INTEGER", "REAL", "TEXT", "BOOLEAN", "DATETIME", "BLOB"},
+		rows: [][]driver.Value{
+			{int64(1), 1.5, "jim", true, at, []byte("a")},
+			{int64(2), nil, nil, false, nil, []byte("b")},
+			{nil, 3.5, "julie", nil, at, nil},
+		},
+	}
+
+	rows, err := conn.Query("SELECT * FROM users")
+	require.NoError(t, err)
+	act := dataframe.NewFromSQLRows(pool, rows)
+	defer act.Release()
+
+	assert.Equal(t, []string{"id", "score", "name", "active", "created", "blob"}, act.Headers())
+	expTypes := []arrow.DataType{
+		arrow.PrimitiveTypes.Int64,
+		arrow.PrimitiveTypes.Float64,
+		arrow.BinaryTypes.String,
+		arrow.FixedWidthTypes.Boolean,
+		&arrow.TimestampType{Unit: arrow.Nanosecond, TimeZone: "UTC"},
+		arrow.BinaryTypes.String,
+	}
+	expNAIndices := [][]int{{2}, {1}, {1}, {2}, {1}, {2}}
+	for i := range expTypes {
+		assert.Equal(t, expTypes[i], act.Series(i).DataType())
+		assert.Equal(t, expNAIndices[i], act.Series(i).NAIndices())
+	}
+	assert.Equal(t, []int64{1, 2, 0}, act.Series(0).Values())
+	assert.Equal(t, []float64{1.5, 0, 3.5}, act.Series(1).Values())
+	assert.Equal(t, []string{"jim", "", "julie"}, act.Series(2).Values())
+	assert.Equal(t, []string{"a", "b", ""}, act.Series(5).Values())
+
+	_, err = conn.Query("SELECT * FROM missing")
+	assert.Error(t, err)
+
+	t.Run("integer, date and decimal types", func(t *testing.T) {
+		pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+		defer pool.AssertSize(t, 0)
+
+		conn, db := openSQLStandIn(t)
+		defer conn.Close()
+
+		db.tables["typed"] = &sqlStandInTable{
+			columns: []string{"i8", "i16", "u8", "u16", "u32", "u64", "big", "day", "amount"},
+			types:   []string{"TINYINT", "SMALLINT", "TINYINT", "SMALLINT", "INT", "BIGINT", "BIGINT UNSIGNED", "DATE", "DECIMAL"},
+			scanTypes: []reflect.Type{
+				reflect.TypeOf(int8(0)),
+				reflect.TypeOf(int16(0)),
+				reflect.TypeOf(uint8(0)),
+				reflect.TypeOf(uint16(0)),
+				reflect.TypeOf(uint32(0)),
+				reflect.TypeOf(uint64(0)),
+			},
+			decimals: map[int][2]int64{8: {10, 2}},
+			rows: [][]driver.Value{
+				{int64(-5), int64(300), int64(200), int64(60000), int64(4000000000), uint64(1<<63 + 1), []byte("18446744073709551615"), at, []byte("12.50")},
+				{nil, nil, nil, nil, nil, nil, nil, nil, nil},
+			},
+		}
+
+		rows, err := conn.Query("SELECT * FROM typed")
+		require.NoError(t, err)
+		act := dataframe.NewFromSQLRows(pool, rows)
+		defer act.Release()
+
+		dt := &arrow.Decimal128Type{Precision: 10, Scale: 2}
+		expTypes := []arrow.DataType{
+			arrow.PrimitiveTypes.Int8,
+			arrow.PrimitiveTypes.Int16,
+			arrow.PrimitiveTypes.Uint8,
+			arrow.PrimitiveTypes.Uint16,
+			arrow.PrimitiveTypes.Uint32,
+			arrow.PrimitiveTypes.Uint64,
+			arrow.PrimitiveTypes.Uint64,
+			arrow.FixedWidthTypes.Date32,
+			dt,
+		}
+		for i := range expTypes {
+			assert.Equal(t, expTypes[i], act.Series(i).DataType())
+			assert.Equal(t, []int{1}, act.Series(i).NAIndices())
+		}
+		assert.Equal(t, []int8{-5, 0}, act.Series(0).Values())
+		assert.Equal(t, []int16{300, 0}, act.Series(1).Values())
+		assert.Equal(t, []uint8{200, 0}, act.Series(2).Values())
+		assert.Equal(t, []uint16{60000, 0}, act.Series(3).Values())
+		assert.Equal(t, []uint32{4000000000, 0}, act.Series(4).Values())
+		assert.Equal(t, []uint64{1<<63 + 1, 0}, act.Series(5).Values())
+		assert.Equal(t, []uint64{math.MaxUint64, 0}, act.Series(6).Values())
+		assert.Equal(t, []arrow.Date32{18263, 0}, act.Series(7).Values())
+		assert.Equal(t, "12.50", series.FormatDecimal(act.Series(8).Interface.(*array.Decimal128).Value(0), dt.Scale))
+
+		db.tables["overflow"] = &sqlStandInTable{
+			columns:   []string{"u8"},
+			types:     []string{"TINYINT"},
+			scanTypes: []reflect.Type{reflect.TypeOf(uint8(0))},
+			rows:      [][]driver.Value{{int64(256)}},
+		}
+		rows, err = conn.Query("SELECT * FROM overflow")
+		require.NoError(t, err)
+		assert.Panics(t, func() {
+			bad := dataframe.NewFromSQLRows(memory.NewGoAllocator(), rows)
+			bad.Release()
+		})
+	})
+}
+
+func TestWriteSQL(t *testing.T) {
+	newDataFrame := func(pool memory.Allocator) dataframe.DataFrame {
+		ss := []series.Series{
+			series.FromInt64(pool, arrow.Field{Name: "id", Type: arrow.PrimitiveTypes.Int64}, []int64{1, 2, 3}, nil),
+			series.FromFloat32(pool, arrow.Field{Name: "score", Type: arrow.PrimitiveTypes.Float32}, []float32{1.5, 0, 3.5}, []bool{true, false, true}),
+			series.FromString(pool, arrow.Field{Name: "name", Type: arrow.BinaryTypes.String}, []string{"jim", "joe", "julie"}, nil),
+		}
+		for _, s := range ss {
+			defer s.Release()
+		}
+
+		return dataframe.NewFromSeries(pool, ss)
+	}
+
 	tests := []struct {
 		scenario string
 
-		inCSV string
+		inOptions   []dataframe.SQLOption
+		inFailAfter int
 
-		expNumCols int
-		expNumRows int
-		exp        []interface{}
+		expErr       bool
+		expInserts   []string
+		expRows      [][]driver.Value
+		expCommits   int
+		expRollbacks int
 	}{
 		{
-			// TODO: CHANGE THE FIELDS TO BE ALL TYPES
-			scenario: "valid",
-			inCSV: `"f1-f64","f2-f64","f3-f64","f4-f64","f5-str"
-1,11,111,1111,john
-2,22,222,2222,jim
-3,33,333,3333,julie
-4,44,444,4444,alex
-5,55,555,5555,shaydul`,
-			expNumCols: 5,
-			expNumRows: 5,
-			exp: []interface{}{
-				[]string{"1", "2", "3", "4", "5"},
-				[]string{"11", "22", "33", "44", "55"},
-				[]string{"111", "222", "333", "444", "555"},
-				[]string{"1111", "2222", "3333", "4444", "5555"},
-				[]string{"john", "jim", "julie", "alex", "shaydul"},
+			scenario:    "single batch",
+			inFailAfter: -1,
+			expInserts: []string{
+				`INSERT INTO "users" ("id", "score", "name") VALUES (?, ?, ?), (?, ?, ?), (?, ?, ?)`,
+			},
+			expRows: [][]driver.Value{
+				{int64(1), 1.5, "jim"},
+				{int64(2), nil, "joe"},
+				{int64(3), 3.5, "julie"},
+			},
+			expCommits: 1,
+		},
+		{
+			scenario: "batches with dollar placeholders",
+			inOptions: []dataframe.SQLOption{
+				dataframe.WithSQLBatchSize(2),
+				dataframe.WithSQLPlaceholder(dataframe.SQLDollar),
+			},
+			inFailAfter: -1,
+			expInserts: []string{
+				`INSERT INTO "users" ("id", "score", "name") VALUES ($1, $2, $3), ($4, $5, $6)`,
+				`INSERT INTO "users" ("id", "score", "name") VALUES ($1, $2, $3)`,
+			},
+			expRows: [][]driver.Value{
+				{int64(1), 1.5, "jim"},
+				{int64(2), nil, "joe"},
+				{int64(3), 3.5, "julie"},
 			},
+			expCommits: 1,
+		},
+		{
+			scenario: "failed batch rolls back",
+			inOptions: []dataframe.SQLOption{
+				dataframe.WithSQLBatchSize(2),
+			},
+			inFailAfter: 1,
+			expErr:      true,
+			expInserts: []string{
+				`INSERT INTO "users" ("id", "score", "name") VALUES (?, ?, ?), (?, ?, ?)`,
+			},
+			expRollbacks: 1,
 		},
 	}
 
@@ -236,22 +2322,82 @@ func TestNewFromCSV(t *testing.T) {
 			pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
 			defer pool.AssertSize(t, 0)
 
-			inCSVReader := csv.NewReader(strings.NewReader(tt.inCSV))
+			conn, db := openSQLStandIn(t)
+			defer conn.Close()
+			db.failAfter = tt.inFailAfter
+			db.tables["users"] = &sqlStandInTable{
+				columns: []string{"id", "score", "name"},
+				types:   []string{"INTEGER", "REAL", "TEXT"},
+			}
 
-			act := dataframe.NewFromCSV(pool, inCSVReader, -1, nil)
-			defer act.Release()
+			df := newDataFrame(pool)
+			defer df.Release()
 
-			numR, numC := act.Dims()
-			require.Equal(t, tt.expNumCols, numC)
-			require.Equal(t, tt.expNumRows, numR)
+			err := df.WriteSQL(conn, "users", tt.inOptions...)
+			if tt.expErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expInserts, db.inserts)
+			assert.Equal(t, tt.expRows, db.tables["users"].rows)
+			assert.Equal(t, tt.expCommits, db.commits)
+			assert.Equal(t, tt.expRollbacks, db.rollbacks)
 
-			for i := range tt.exp {
-				assert.Equal(t, tt.exp[i], act.Series(i).Values())
+			if tt.expErr {
+				return
 			}
+			rows, err := conn.Query("SELECT * FROM users")
+			require.NoError(t, err)
+			act := dataframe.NewFromSQLRows(pool, rows)
+			defer act.Release()
+			assert.Equal(t, df.Headers(), act.Headers())
+			assert.Equal(t, []int{1}, act.Series(1).NAIndices())
+			assert.Equal(t, df.Series(2).Values(), act.Series(2).Values())
 		})
 	}
 }
 
+func TestWriteSQLTypes(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	dt := &arrow.Decimal128Type{Precision: 10, Scale: 2}
+	amount, err := series.ParseDecimal("12.5", dt)
+	require.NoError(t, err)
+
+	ss := []series.Series{
+		series.FromInt8(pool, arrow.Field{Name: "i8", Type: arrow.PrimitiveTypes.Int8}, []int8{-5}, nil),
+		series.FromInt16(pool, arrow.Field{Name: "i16", Type: arrow.PrimitiveTypes.Int16}, []int16{300}, nil),
+		series.FromUint8(pool, arrow.Field{Name: "u8", Type: arrow.PrimitiveTypes.Uint8}, []uint8{200}, nil),
+		series.FromUint16(pool, arrow.Field{Name: "u16", Type: arrow.PrimitiveTypes.Uint16}, []uint16{60000}, nil),
+		series.FromUint32(pool, arrow.Field{Name: "u32", Type: arrow.PrimitiveTypes.Uint32}, []uint32{4000000000}, nil),
+		series.FromUint64(pool, arrow.Field{Name: "u64", Type: arrow.PrimitiveTypes.Uint64}, []uint64{1 << 40}, nil),
+		series.FromDate32(pool, arrow.Field{Name: "d32", Type: arrow.FixedWidthTypes.Date32}, []arrow.Date32{18263}, nil),
+		series.FromDate64(pool, arrow.Field{Name: "d64", Type: arrow.FixedWidthTypes.Date64}, []arrow.Date64{18263 * 24 * 60 * 60 * 1000}, nil),
+		series.FromDecimal128(pool, arrow.Field{Name: "amount", Type: dt}, []decimal128.Num{amount}, nil),
+	}
+	for _, s := range ss {
+		defer s.Release()
+	}
+	df := dataframe.NewFromSeries(pool, ss)
+	defer df.Release()
+
+	conn, db := openSQLStandIn(t)
+	defer conn.Close()
+	db.tables["typed"] = &sqlStandInTable{
+		columns: df.Headers(),
+		types:   []string{"TINYINT", "SMALLINT", "TINYINT", "SMALLINT", "INT", "BIGINT", "DATE", "DATE", "DECIMAL"},
+	}
+
+	require.NoError(t, df.WriteSQL(conn, "typed"))
+
+	day := time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, [][]driver.Value{
+		{int64(-5), int64(300), int64(200), int64(60000), int64(4000000000), int64(1 << 40), day, day, "12.50"},
+	}, db.tables["typed"].rows)
+}
+
 func TestSubtract(t *testing.T) {
 	tests := []struct {
 		scenario string
@@ -438,6 +2584,162 @@ func TestAdd(t *testing.T) {
 	}
 }
 
+func TestArith(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	newDataFrame := func(a []int32, b []float64) dataframe.DataFrame {
+		ss := []series.Series{
+			series.FromInt32(pool, arrow.Field{Name: "a", Type: arrow.PrimitiveTypes.Int32}, a, nil),
+			series.FromFloat64(pool, arrow.Field{Name: "b", Type: arrow.PrimitiveTypes.Float64}, b, nil),
+		}
+		for _, s := range ss {
+			defer s.Release()
+		}
+		return dataframe.NewFromSeries(pool, ss)
+	}
+	df := newDataFrame([]int32{6, 7, 8}, []float64{1, 2, 3})
+	defer df.Release()
+	df2 := newDataFrame([]int32{2, 0, 3}, []float64{2, 0, 0.5})
+	defer df2.Release()
+
+	mul := df.Multiply(df2)
+	defer mul.Release()
+	assert.Equal(t, []int64{12, 0, 24}, mul.Series(0).Values())
+	assert.Equal(t, []float64{2, 0, 1.5}, mul.Series(1).Values())
+
+	div := df.Divide(df2, series.WithDivideByZero(series.DivideByZeroNull))
+	defer div.Release()
+	assert.Equal(t, []float64{3, 0, 8.0 / 3}, div.Series(0).Values())
+	assert.Equal(t, []int{1}, div.Series(0).NAIndices())
+	assert.Equal(t, []float64{0.5, 0, 6}, div.Series(1).Values())
+	assert.Equal(t, []int{1}, div.Series(1).NAIndices())
+
+	mod := df.Mod(df2)
+	defer mod.Release()
+	assert.Equal(t, []int64{0, 0, 2}, mod.Series(0).Values())
+	assert.Equal(t, []int{1}, mod.Series(0).NAIndices())
+
+	pow := df.Pow(df2)
+	defer pow.Release()
+	assert.Equal(t, []int64{36, 1, 512}, pow.Series(0).Values())
+	assert.Equal(t, []float64{1, 1, math.Sqrt(3)}, pow.Series(1).Values())
+
+	short := newDataFrame([]int32{1}, []float64{1})
+	defer short.Release()
+	assert.Panics(t, func() { df.Multiply(short) })
+}
+
+func TestArithScalar(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	ss := []series.Series{
+		series.FromInt32(pool, arrow.Field{Name: "a", Type: arrow.PrimitiveTypes.Int32}, []int32{2, 4, 6}, nil),
+		series.FromFloat64(pool, arrow.Field{Name: "b", Type: arrow.PrimitiveTypes.Float64}, []float64{1, 2, 3}, nil),
+	}
+	for _, s := range ss {
+		defer s.Release()
+	}
+	df := dataframe.NewFromSeries(pool, ss)
+	defer df.Release()
+
+	add := df.AddScalar(1)
+	defer add.Release()
+	assert.Equal(t, []int64{3, 5, 7}, add.Series(0).Values())
+	assert.Equal(t, []float64{2, 3, 4}, add.Series(1).Values())
+
+	div := df.DivScalar(2)
+	defer div.Release()
+	assert.Equal(t, []float64{1, 2, 3}, div.Series(0).Values())
+	assert.Equal(t, []float64{0.5, 1, 1.5}, div.Series(1).Values())
+
+	means := series.FromFloat64(pool, arrow.Field{Name: "mean", Type: arrow.PrimitiveTypes.Float64}, []float64{4, 2}, nil)
+	defer means.Release()
+	centered := df.Broadcast(series.OpSubtract, means, dataframe.AxisRows)
+	defer centered.Release()
+	assert.Equal(t, []float64{-2, 0, 2}, centered.Series(0).Values())
+	assert.Equal(t, []float64{-1, 0, 1}, centered.Series(1).Values())
+
+	weights := series.FromInt64(pool, arrow.Field{Name: "w", Type: arrow.PrimitiveTypes.Int64}, []int64{1, 0, 2}, []bool{true, true, false})
+	defer weights.Release()
+	weighted := df.Broadcast(series.OpMultiply, weights, dataframe.AxisColumns)
+	defer weighted.Release()
+	assert.Equal(t, []int64{2, 0, 0}, weighted.Series(0).Values())
+	assert.Equal(t, []int{2}, weighted.Series(0).NAIndices())
+	assert.Equal(t, []float64{1, 0, 0}, weighted.Series(1).Values())
+	assert.Equal(t, []int{2}, weighted.Series(1).NAIndices())
+
+	assert.Panics(t, func() { df.Broadcast(series.OpAdd, means, dataframe.AxisColumns) })
+}
+
+func TestCumulative(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	ss := []series.Series{
+		series.FromString(pool, arrow.Field{Name: "k", Type: arrow.BinaryTypes.String}, []string{"a", "b", "a", "b"}, nil),
+		series.FromInt32(pool, arrow.Field{Name: "v", Type: arrow.PrimitiveTypes.Int32}, []int32{1, 2, 3, 4}, []bool{true, true, false, true}),
+		series.FromFloat64(pool, arrow.Field{Name: "w", Type: arrow.PrimitiveTypes.Float64}, []float64{4, 1, 2, 3}, nil),
+	}
+	for _, s := range ss {
+		defer s.Release()
+	}
+	df := dataframe.NewFromSeries(pool, ss)
+	defer df.Release()
+
+	values := df.SelectColumnsByNames([]string{"v", "w"})
+	defer values.Release()
+	sum := values.CumSum()
+	defer sum.Release()
+	assert.Equal(t, []int64{1, 3, 0, 7}, sum.Series(0).Values())
+	assert.Equal(t, []int{2}, sum.Series(0).NAIndices())
+	assert.Equal(t, []float64{4, 5, 7, 10}, sum.Series(1).Values())
+
+	max := df.GroupCumulative("k", series.OpCumMax)
+	defer max.Release()
+	assert.Equal(t, []string{"a", "b", "a", "b"}, max.Series(0).Values())
+	assert.Equal(t, []int64{1, 2, 0, 4}, max.Series(1).Values())
+	assert.Equal(t, []int{2}, max.Series(1).NAIndices())
+	assert.Equal(t, []float64{4, 1, 4, 3}, max.Series(2).Values())
+
+	count := df.GroupCumulative("k", series.OpCumCount)
+	defer count.Release()
+	assert.Equal(t, []int64{1, 1, 1, 2}, count.Series(1).Values())
+}
+
+func TestRolling(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	ss := []series.Series{
+		series.FromTime(pool, arrow.Field{Name: "t", Type: &arrow.TimestampType{Unit: arrow.Second}}, []time.Time{
+			time.Unix(0, 0), time.Unix(30, 0), time.Unix(60, 0), time.Unix(150, 0),
+		}, nil),
+		series.FromInt32(pool, arrow.Field{Name: "v", Type: arrow.PrimitiveTypes.Int32}, []int32{1, 2, 3, 4}, nil),
+		series.FromFloat64(pool, arrow.Field{Name: "w", Type: arrow.PrimitiveTypes.Float64}, []float64{8, 2, 6, 4}, []bool{true, false, true, true}),
+	}
+	for _, s := range ss {
+		defer s.Release()
+	}
+	df := dataframe.NewFromSeries(pool, ss)
+	defer df.Release()
+
+	sum := df.Rolling(2, 1).Sum()
+	defer sum.Release()
+	assert.Equal(t, arrow.TIMESTAMP, sum.Series(0).DataType().ID())
+	assert.Equal(t, []float64{1, 3, 5, 7}, sum.Series(1).Values())
+	assert.Equal(t, []float64{8, 8, 6, 10}, sum.Series(2).Values())
+
+	max := df.RollingTime("t", time.Minute, 2).Max()
+	defer max.Release()
+	assert.Equal(t, []float64{0, 2, 3, 0}, max.Series(1).Values())
+	assert.Equal(t, []int{0, 3}, max.Series(1).NAIndices())
+	assert.Equal(t, []int{0, 1, 2, 3}, max.Series(2).NAIndices())
+
+	assert.Panics(t, func() { df.RollingTime("missing", time.Minute, 1) })
+}
+
 func TestAppendRecords(t *testing.T) {
 	// tests := []struct {
 	// 	scenario string
@@ -882,9 +3184,10 @@ func TestDropRowsByIndices(t *testing.T) {
 		inSeries  func(memory.Allocator) []series.Series
 		inIndices []int
 
-		expNumCols int
-		expNumRows int
-		exp        []interface{}
+		expNumCols   int
+		expNumRows   int
+		exp          []interface{}
+		expNAIndices [][]int
 	}{
 		{
 			scenario: "drop rows",
@@ -916,6 +3219,46 @@ func TestDropRowsByIndices(t *testing.T) {
 				[]float64{1, 0, 0.5},
 			},
 		},
+		{
+			scenario: "drop rows with boolean and timestamp columns",
+			inSeries: func(pool memory.Allocator) []series.Series {
+				f1 := arrow.Field{Name: "f1-bool", Type: arrow.FixedWidthTypes.Boolean}
+				f2 := arrow.Field{Name: "f2-ts", Type: arrow.FixedWidthTypes.Timestamp_s}
+				vals2 := []time.Time{time.Unix(10, 0), time.Unix(20, 0), time.Unix(30, 0)}
+
+				return []series.Series{
+					series.FromBool(pool, f1, []bool{true, false, true}, nil),
+					series.FromTime(pool, f2, vals2, nil),
+				}
+			},
+			inIndices:  []int{1},
+			expNumCols: 2,
+			expNumRows: 2,
+			exp: []interface{}{
+				[]bool{true, true},
+				[]arrow.Timestamp{10, 30},
+			},
+		},
+		{
+			scenario: "drop rows with nulls",
+			inSeries: func(pool memory.Allocator) []series.Series {
+				f1 := arrow.Field{Name: "f1-i64", Type: arrow.PrimitiveTypes.Int64}
+				f2 := arrow.Field{Name: "f2-f64", Type: arrow.PrimitiveTypes.Float64}
+
+				return []series.Series{
+					series.FromInt64(pool, f1, []int64{1, 2, 3}, []bool{true, false, true}),
+					series.FromFloat64(pool, f2, []float64{1, 2, 3}, []bool{true, true, false}),
+				}
+			},
+			inIndices:  []int{0},
+			expNumCols: 2,
+			expNumRows: 2,
+			exp: []interface{}{
+				[]int64{0, 3},
+				[]float64{2, 0},
+			},
+			expNAIndices: [][]int{{0}, {1}},
+		},
 	}
 
 	for _, tt := range tests {
@@ -939,6 +3282,9 @@ func TestDropRowsByIndices(t *testing.T) {
 			for i := range tt.exp {
 				assert.Equal(t, tt.exp[i], actDrop.Series(i).Values())
 			}
+			for i := range tt.expNAIndices {
+				assert.Equal(t, tt.expNAIndices[i], actDrop.Series(i).NAIndices())
+			}
 		})
 	}
 }
@@ -950,9 +3296,10 @@ func TestSelectRowsByIndices(t *testing.T) {
 		inSeries  func(memory.Allocator) []series.Series
 		inIndices []int
 
-		expNumCols int
-		expNumRows int
-		exp        []interface{}
+		expNumCols   int
+		expNumRows   int
+		exp          []interface{}
+		expNAIndices [][]int
 	}{
 		{
 			scenario: "select rows",
@@ -974,15 +3321,242 @@ func TestSelectRowsByIndices(t *testing.T) {
 
 				return result
 			},
-			inIndices:  []int{2, 4},
-			expNumCols: 4,
-			expNumRows: 2,
+			inIndices:  []int{2, 4},
+			expNumCols: 4,
+			expNumRows: 2,
+			exp: []interface{}{
+				[]int32{3, 5},
+				[]int64{2, 4},
+				[]float32{0.5, 0.7},
+				[]float64{1.1, 1.2},
+			},
+		},
+		{
+			scenario: "select rows with nulls",
+			inSeries: func(pool memory.Allocator) []series.Series {
+				f1 := arrow.Field{Name: "f1-i64", Type: arrow.PrimitiveTypes.Int64}
+				f2 := arrow.Field{Name: "f2-f64", Type: arrow.PrimitiveTypes.Float64}
+
+				return []series.Series{
+					series.FromInt64(pool, f1, []int64{1, 2, 3}, []bool{true, false, true}),
+					series.FromFloat64(pool, f2, []float64{1, 2, 3}, []bool{true, true, false}),
+				}
+			},
+			inIndices:  []int{1, 2},
+			expNumCols: 2,
+			expNumRows: 2,
+			exp: []interface{}{
+				[]int64{0, 3},
+				[]float64{2, 0},
+			},
+			expNAIndices: [][]int{{0}, {1}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.scenario, func(t *testing.T) {
+			pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer pool.AssertSize(t, 0)
+
+			inCols := tt.inSeries(pool)
+			for i := range inCols {
+				defer inCols[i].Release()
+			}
+			df := dataframe.NewFromSeries(pool, inCols)
+			defer df.Release()
+			act := df.SelectRowsByIndices(tt.inIndices)
+			defer act.Release()
+
+			numR, numC := act.Dims()
+			require.Equal(t, tt.expNumCols, numC)
+			require.Equal(t, tt.expNumRows, numR)
+
+			for i := range tt.exp {
+				assert.Equal(t, tt.exp[i], act.Series(i).Values())
+			}
+			for i := range tt.expNAIndices {
+				assert.Equal(t, tt.expNAIndices[i], act.Series(i).NAIndices())
+			}
+		})
+	}
+}
+
+func TestFilter(t *testing.T) {
+	tests := []struct {
+		scenario string
+
+		inSeries func(memory.Allocator) []series.Series
+		inMask   func(memory.Allocator) series.Series
+
+		expNumRows   int
+		exp          []interface{}
+		expNAIndices [][]int
+	}{
+		{
+			scenario: "filter rows",
+			inSeries: func(pool memory.Allocator) []series.Series {
+				var result []series.Series
+				f1 := arrow.Field{Name: "f1-i32", Type: arrow.PrimitiveTypes.Int32}
+				vals1 := []int32{1, 2, 3, 4, 5}
+				f2 := arrow.Field{Name: "f2-str", Type: arrow.BinaryTypes.String}
+				vals2 := []string{"a", "b", "c", "d", "e"}
+
+				result = append(result, series.FromInt32(pool, f1, vals1, []bool{true, true, true, false, true}))
+				result = append(result, series.FromString(pool, f2, vals2, nil))
+
+				return result
+			},
+			inMask: func(pool memory.Allocator) series.Series {
+				f := arrow.Field{Name: "mask", Type: arrow.FixedWidthTypes.Boolean}
+				return series.FromBool(pool, f,
+					[]bool{true, false, true, true, true},
+					[]bool{true, true, false, true, true})
+			},
+			expNumRows: 3,
+			exp: []interface{}{
+				[]int32{1, 0, 5},
+				[]string{"a", "d", "e"},
+			},
+			expNAIndices: [][]int{{1}, {}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.scenario, func(t *testing.T) {
+			pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer pool.AssertSize(t, 0)
+
+			inCols := tt.inSeries(pool)
+			for i := range inCols {
+				defer inCols[i].Release()
+			}
+			df := dataframe.NewFromSeries(pool, inCols)
+			defer df.Release()
+			mask := tt.inMask(pool)
+			defer mask.Release()
+
+			act := df.Filter(mask)
+			defer act.Release()
+
+			require.Equal(t, int64(tt.expNumRows), act.NumRows())
+			for i := range tt.exp {
+				assert.Equal(t, tt.exp[i], act.Series(i).Values())
+				assert.Equal(t, tt.expNAIndices[i], act.Series(i).NAIndices())
+			}
+		})
+	}
+}
+
+func TestExplode(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	tList := map[string]arrow.DataType{
+		"items": arrow.ListOf(arrow.StructOf(
+			arrow.Field{Name: "sku", Type: arrow.BinaryTypes.String, Nullable: true},
+			arrow.Field{Name: "qty", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+		)),
+	}
+	in := `{"order":1,"items":[{"sku":"a","qty":2},{"sku":"b","qty":null}]}
+{"order":2,"items":[]}
+{"order":3,"items":null}
+{"order":4,"items":[{"sku":"c","qty":1}]}
+`
+	df := dataframe.NewFromNDJSON(pool, strings.NewReader(in), tList)
+	defer df.Release()
+
+	var buf bytes.Buffer
+	require.NoError(t, df.WriteNDJSON(&buf))
+	assert.Equal(t, in, buf.String())
+
+	act := df.Explode("items")
+	defer act.Release()
+
+	require.Equal(t, int64(5), act.NumRows())
+	assert.Equal(t, []string{"order", "items"}, act.Headers())
+	assert.Equal(t, []int64{1, 1, 2, 3, 4}, act.Series(0).Values())
+	assert.Equal(t, tList["items"].(*arrow.ListType).Elem(), act.Series(1).DataType())
+	assert.Equal(t, []int{2, 3}, act.Series(1).NAIndices())
+
+	sku := act.Series(1).StructField("sku")
+	defer sku.Release()
+	assert.Equal(t, []string{"a", "b", "", "", "c"}, sku.Values())
+	qty := act.Series(1).StructField("qty")
+	defer qty.Release()
+	assert.Equal(t, []int{1, 2, 3}, qty.NAIndices())
+
+	assert.Panics(t, func() { df.Explode("order") })
+}
+
+func TestResample(t *testing.T) {
+	newDataFrame := func(pool memory.Allocator) dataframe.DataFrame {
+		ss := []series.Series{
+			series.FromTimestamp(pool, arrow.Field{Name: "ts", Type: arrow.FixedWidthTypes.Timestamp_s}, []arrow.Timestamp{0, 300, 600, 1800, 900}, []bool{true, true, true, true, false}),
+			series.FromFloat64(pool, arrow.Field{Name: "val", Type: arrow.PrimitiveTypes.Float64}, []float64{1, 2, 3, 4, 5}, nil),
+			series.FromString(pool, arrow.Field{Name: "name", Type: arrow.BinaryTypes.String}, []string{"a", "b", "c", "d", "e"}, nil),
+			series.FromInt64(pool, arrow.Field{Name: "dropped", Type: arrow.PrimitiveTypes.Int64}, []int64{1, 2, 3, 4, 5}, nil),
+		}
+		for _, s := range ss {
+			defer s.Release()
+		}
+
+		return dataframe.NewFromSeries(pool, ss)
+	}
+
+	tests := []struct {
+		scenario string
+
+		inAggs    map[string]dataframe.Aggregation
+		inOptions []dataframe.ResampleOption
+
+		exp          []interface{}
+		expNAIndices [][]int
+	}{
+		{
+			scenario: "default options",
+			inAggs: map[string]dataframe.Aggregation{
+				"val":  dataframe.AggSum,
+				"name": dataframe.AggFirst,
+			},
+			exp: []interface{}{
+				[]arrow.Timestamp{0, 600, 1200, 1800},
+				[]float64{3, 3, 0, 4},
+				[]string{"a", "c", "", "d"},
+			},
+			expNAIndices: [][]int{{}, {2}, {2}},
+		},
+		{
+			scenario: "closed and labeled on the right",
+			inAggs: map[string]dataframe.Aggregation{
+				"val":  dataframe.AggMean,
+				"name": dataframe.AggCount,
+			},
+			inOptions: []dataframe.ResampleOption{
+				dataframe.WithResampleClosed(dataframe.ResampleRight),
+				dataframe.WithResampleLabel(dataframe.ResampleRight),
+			},
+			exp: []interface{}{
+				[]arrow.Timestamp{0, 600, 1200, 1800},
+				[]float64{1, 2.5, 0, 4},
+				[]int64{1, 2, 0, 1},
+			},
+			expNAIndices: [][]int{{}, {2}, {}},
+		},
+		{
+			scenario: "forward fill",
+			inAggs: map[string]dataframe.Aggregation{
+				"val":  dataframe.AggMax,
+				"name": dataframe.AggLast,
+			},
+			inOptions: []dataframe.ResampleOption{
+				dataframe.WithResampleFill(dataframe.ResampleFillForward),
+			},
 			exp: []interface{}{
-				[]int32{3, 5},
-				[]int64{2, 4},
-				[]float32{0.5, 0.7},
-				[]float64{1.1, 1.2},
+				[]arrow.Timestamp{0, 600, 1200, 1800},
+				[]float64{2, 3, 3, 4},
+				[]string{"b", "c", "c", "d"},
 			},
+			expNAIndices: [][]int{{}, {}, {}},
 		},
 	}
 
@@ -991,24 +3565,31 @@ func TestSelectRowsByIndices(t *testing.T) {
 			pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
 			defer pool.AssertSize(t, 0)
 
-			inCols := tt.inSeries(pool)
-			for i := range inCols {
-				defer inCols[i].Release()
-			}
-			df := dataframe.NewFromSeries(pool, inCols)
+			df := newDataFrame(pool)
 			defer df.Release()
-			act := df.SelectRowsByIndices(tt.inIndices)
-			defer act.Release()
 
-			numR, numC := act.Dims()
-			require.Equal(t, tt.expNumCols, numC)
-			require.Equal(t, tt.expNumRows, numR)
+			act := df.Resample("ts", 10*time.Minute, tt.inAggs, tt.inOptions...)
+			defer act.Release()
 
+			require.Equal(t, []string{"ts", "val", "name"}, act.Headers())
+			assert.Equal(t, arrow.FixedWidthTypes.Timestamp_s, act.Series(0).DataType())
 			for i := range tt.exp {
 				assert.Equal(t, tt.exp[i], act.Series(i).Values())
+				assert.Equal(t, tt.expNAIndices[i], act.Series(i).NAIndices())
 			}
 		})
 	}
+
+	t.Run("too many bins", func(t *testing.T) {
+		pool := memory.NewGoAllocator()
+		df := newDataFrame(pool)
+		defer df.Release()
+
+		assert.Panics(t, func() {
+			act := df.Resample("ts", time.Nanosecond, map[string]dataframe.Aggregation{"val": dataframe.AggSum})
+			act.Release()
+		})
+	})
 }
 
 func TestLeftJoinEM(t *testing.T) {
@@ -1184,6 +3765,171 @@ func TestLeftJoin(t *testing.T) {
 				[]int{3, 4, 6},
 			},
 		},
+		{
+			scenario: "left join dataframes on categorical keys",
+			inDataFrame: func(pool memory.Allocator) dataframe.DataFrame {
+				f := arrow.Field{Name: "user", Type: arrow.BinaryTypes.String}
+				str := series.FromString(pool, f, []string{"amy", "bob", "", "cat"}, []bool{true, true, false, true})
+				defer str.Release()
+				ss := []series.Series{
+					str.AsCategorical(),
+					series.FromInt32(
+						pool,
+						arrow.Field{Name: "f2-i32", Type: arrow.PrimitiveTypes.Int32},
+						[]int32{1, 2, 3, 4},
+						nil,
+					),
+				}
+				for _, s := range ss {
+					defer s.Release()
+				}
+
+				return dataframe.NewFromSeries(pool, ss)
+			},
+			inSeriesName: "user",
+			inDataFrame2: func(pool memory.Allocator) dataframe.DataFrame {
+				f := arrow.Field{Name: "user", Type: arrow.BinaryTypes.String}
+				str := series.FromString(pool, f, []string{"cat", "dan", "amy", "cat"}, nil)
+				defer str.Release()
+				ss := []series.Series{
+					str.AsCategorical(),
+					series.FromInt64(
+						pool,
+						arrow.Field{Name: "f3-i64", Type: arrow.PrimitiveTypes.Int64},
+						[]int64{10, 20, 30, 40},
+						nil,
+					),
+				}
+				for _, s := range ss {
+					defer s.Release()
+				}
+
+				return dataframe.NewFromSeries(pool, ss)
+			},
+			inSeriesName2: "user",
+			expNumCols:    3,
+			expNumRows:    5,
+			exp: []interface{}{
+				[]string{"amy", "bob", "", "cat", "cat"},
+				[]int32{1, 2, 3, 4, 4},
+				[]int64{30, 0, 0, 10, 40},
+			},
+			expNAIndices: [][]int{
+				[]int{2},
+				[]int{},
+				[]int{1, 2},
+			},
+		},
+		{
+			scenario: "left join dataframes on fixed size binary keys",
+			inDataFrame: func(pool memory.Allocator) dataframe.DataFrame {
+				f := arrow.Field{Name: "hash", Type: &arrow.FixedSizeBinaryType{ByteWidth: 2}}
+				ss := []series.Series{
+					series.FromFixedSizeBinary(pool, f, [][]byte{{0xca, 0xfe}, {0xbe, 0xef}, nil}, []bool{true, true, false}),
+					series.FromInt32(
+						pool,
+						arrow.Field{Name: "f2-i32", Type: arrow.PrimitiveTypes.Int32},
+						[]int32{1, 2, 3},
+						nil,
+					),
+				}
+				for _, s := range ss {
+					defer s.Release()
+				}
+
+				return dataframe.NewFromSeries(pool, ss)
+			},
+			inSeriesName: "hash",
+			inDataFrame2: func(pool memory.Allocator) dataframe.DataFrame {
+				f := arrow.Field{Name: "hash", Type: &arrow.FixedSizeBinaryType{ByteWidth: 2}}
+				ss := []series.Series{
+					series.FromFixedSizeBinary(pool, f, [][]byte{{0xbe, 0xef}, {0x00, 0x00}}, nil),
+					series.FromInt64(
+						pool,
+						arrow.Field{Name: "f3-i64", Type: arrow.PrimitiveTypes.Int64},
+						[]int64{10, 20},
+						nil,
+					),
+				}
+				for _, s := range ss {
+					defer s.Release()
+				}
+
+				return dataframe.NewFromSeries(pool, ss)
+			},
+			inSeriesName2: "hash",
+			expNumCols:    3,
+			expNumRows:    3,
+			exp: []interface{}{
+				[][]byte{{0xca, 0xfe}, {0xbe, 0xef}, nil},
+				[]int32{1, 2, 3},
+				[]int64{0, 10, 0},
+			},
+			expNAIndices: [][]int{
+				[]int{2},
+				[]int{},
+				[]int{0, 2},
+			},
+		},
+		{
+			scenario: "left join dataframes on uint64 keys",
+			inDataFrame: func(pool memory.Allocator) dataframe.DataFrame {
+				ss := []series.Series{
+					series.FromUint64(
+						pool,
+						arrow.Field{Name: "id", Type: arrow.PrimitiveTypes.Uint64},
+						[]uint64{1 << 63, 2, 3},
+						nil,
+					),
+					series.FromInt32(
+						pool,
+						arrow.Field{Name: "f2-i32", Type: arrow.PrimitiveTypes.Int32},
+						[]int32{1, 2, 3},
+						nil,
+					),
+				}
+				for _, s := range ss {
+					defer s.Release()
+				}
+
+				return dataframe.NewFromSeries(pool, ss)
+			},
+			inSeriesName: "id",
+			inDataFrame2: func(pool memory.Allocator) dataframe.DataFrame {
+				ss := []series.Series{
+					series.FromUint64(
+						pool,
+						arrow.Field{Name: "id", Type: arrow.PrimitiveTypes.Uint64},
+						[]uint64{3, 1 << 63},
+						nil,
+					),
+					series.FromInt64(
+						pool,
+						arrow.Field{Name: "f3-i64", Type: arrow.PrimitiveTypes.Int64},
+						[]int64{30, 10},
+						nil,
+					),
+				}
+				for _, s := range ss {
+					defer s.Release()
+				}
+
+				return dataframe.NewFromSeries(pool, ss)
+			},
+			inSeriesName2: "id",
+			expNumCols:    3,
+			expNumRows:    3,
+			exp: []interface{}{
+				[]uint64{1 << 63, 2, 3},
+				[]int32{1, 2, 3},
+				[]int64{10, 0, 30},
+			},
+			expNAIndices: [][]int{
+				[]int{},
+				[]int{},
+				[]int{1},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -1224,6 +3970,7 @@ func TestRightJoin(t *testing.T) {
 		expNumRows   int
 		exp          []interface{}
 		expNAIndices [][]int
+		expHeaders   []string
 	}{
 		{
 			scenario: "right join dataframes",
@@ -1283,6 +4030,67 @@ func TestRightJoin(t *testing.T) {
 				[]int{},
 				[]int{3, 4, 6},
 			},
+			expHeaders: []string{"f1-i64", "f2-i32", "f3-i32"},
+		},
+		{
+			scenario: "right join dataframes with different columns",
+			inDataFrame: func(pool memory.Allocator) dataframe.DataFrame {
+				ss := []series.Series{
+					series.FromInt64(
+						pool,
+						arrow.Field{Name: "id", Type: arrow.PrimitiveTypes.Int64},
+						[]int64{1, 2, 3},
+						nil,
+					),
+					series.FromFloat64(
+						pool,
+						arrow.Field{Name: "price", Type: arrow.PrimitiveTypes.Float64},
+						[]float64{1.5, 2.5, 3.5},
+						nil,
+					),
+				}
+				for _, s := range ss {
+					defer s.Release()
+				}
+
+				return dataframe.NewFromSeries(pool, ss)
+			},
+			inSeriesName: "id",
+			inDataFrame2: func(pool memory.Allocator) dataframe.DataFrame {
+				ss := []series.Series{
+					series.FromInt64(
+						pool,
+						arrow.Field{Name: "id", Type: arrow.PrimitiveTypes.Int64},
+						[]int64{2, 3, 3, 4},
+						nil,
+					),
+					series.FromInt32(
+						pool,
+						arrow.Field{Name: "qty", Type: arrow.PrimitiveTypes.Int32},
+						[]int32{20, 30, 31, 40},
+						nil,
+					),
+				}
+				for _, s := range ss {
+					defer s.Release()
+				}
+
+				return dataframe.NewFromSeries(pool, ss)
+			},
+			inSeriesName2: "id",
+			expNumCols:    3,
+			expNumRows:    4,
+			exp: []interface{}{
+				[]int64{1, 2, 3, 3},
+				[]float64{1.5, 2.5, 3.5, 3.5},
+				[]int32{0, 20, 30, 31},
+			},
+			expNAIndices: [][]int{
+				[]int{},
+				[]int{},
+				[]int{0},
+			},
+			expHeaders: []string{"id", "price", "qty"},
 		},
 	}
 
@@ -1303,6 +4111,7 @@ func TestRightJoin(t *testing.T) {
 			require.Equal(t, tt.expNumCols, numC)
 			require.Equal(t, tt.expNumRows, numR)
 
+			assert.Equal(t, tt.expHeaders, actJoin.Headers())
 			for i := range tt.exp {
 				assert.Equal(t, tt.exp[i], actJoin.Series(i).Values())
 				assert.Equal(t, tt.expNAIndices[i], actJoin.Series(i).NAIndices())
@@ -1663,6 +4472,89 @@ func TestSum(t *testing.T) {
 	}
 }
 
+func TestNullAggregations(t *testing.T) {
+	inSeries := func(pool memory.Allocator) []series.Series {
+		return []series.Series{
+			series.FromFloat64(pool, arrow.Field{Name: "f1-f64", Type: arrow.PrimitiveTypes.Float64}, []float64{1, 5, 3}, []bool{true, true, false}),
+			series.FromInt32(pool, arrow.Field{Name: "f2-i32", Type: arrow.PrimitiveTypes.Int32}, []int32{-2, 7, 0}, nil),
+			series.FromFloat64(pool, arrow.Field{Name: "f3-f64", Type: arrow.PrimitiveTypes.Float64}, []float64{9, 9, 9}, []bool{false, false, false}),
+		}
+	}
+
+	tests := []struct {
+		scenario string
+
+		inSeries func(memory.Allocator) []series.Series
+		agg      func(df dataframe.DataFrame) float64
+
+		exp float64
+	}{
+		{
+			scenario: "max skips columns without values",
+			inSeries: inSeries,
+			agg:      func(df dataframe.DataFrame) float64 { return df.Max() },
+			exp:      7,
+		},
+		{
+			scenario: "min skips columns without values",
+			inSeries: inSeries,
+			agg:      func(df dataframe.DataFrame) float64 { return df.Min() },
+			exp:      -2,
+		},
+		{
+			scenario: "mean skips columns without values",
+			inSeries: inSeries,
+			agg:      func(df dataframe.DataFrame) float64 { return df.Mean() },
+			exp:      (3 + 5.0/3) / 2,
+		},
+		{
+			scenario: "sum skips nulls",
+			inSeries: inSeries,
+			agg:      func(df dataframe.DataFrame) float64 { return df.Sum() },
+			exp:      11,
+		},
+		{
+			scenario: "max propagates nulls",
+			inSeries: inSeries,
+			agg:      func(df dataframe.DataFrame) float64 { return df.Max(series.WithSkipNA(false)) },
+			exp:      math.NaN(),
+		},
+		{
+			scenario: "min propagates nulls",
+			inSeries: inSeries,
+			agg:      func(df dataframe.DataFrame) float64 { return df.Min(series.WithSkipNA(false)) },
+			exp:      math.NaN(),
+		},
+		{
+			scenario: "mean propagates nulls",
+			inSeries: inSeries,
+			agg:      func(df dataframe.DataFrame) float64 { return df.Mean(series.WithSkipNA(false)) },
+			exp:      math.NaN(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.scenario, func(t *testing.T) {
+			pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer pool.AssertSize(t, 0)
+
+			inCols := tt.inSeries(pool)
+			for i := range inCols {
+				defer inCols[i].Release()
+			}
+			df := dataframe.NewFromSeries(pool, inCols)
+			defer df.Release()
+
+			act := tt.agg(df)
+			if math.IsNaN(tt.exp) {
+				assert.True(t, math.IsNaN(act))
+				return
+			}
+			assert.InDelta(t, tt.exp, act, 1e-12)
+		})
+	}
+}
+
 func TestDot(t *testing.T) {
 	tests := []struct {
 		scenario string
@@ -1821,7 +4713,12 @@ func TestPivot(t *testing.T) {
 6,600,1111`)
 
 				r := csv.NewReader(f)
-				return dataframe.NewFromCSV(pool, r, -1, nil)
+				tList := map[string]arrow.DataType{
+					"idx":  arrow.BinaryTypes.String,
+					"cols": arrow.BinaryTypes.String,
+					"vals": arrow.BinaryTypes.String,
+				}
+				return dataframe.NewFromCSV(pool, r, -1, tList)
 			},
 			inIdxName:  "idx",
 			inColsName: "cols",
@@ -1848,6 +4745,54 @@ func TestPivot(t *testing.T) {
 			},
 			expHeaders: []string{"idx", "100", "200", "300", "400", "500", "600"},
 		},
+		{
+			scenario: "categorical pivot dataframe",
+			inDataFrame: func(pool memory.Allocator) dataframe.DataFrame {
+				ss := []series.Series{
+					series.FromString(
+						pool,
+						arrow.Field{Name: "idx", Type: arrow.BinaryTypes.String},
+						[]string{"amy", "bob", "amy"},
+						nil,
+					),
+					series.FromString(
+						pool,
+						arrow.Field{Name: "cols", Type: arrow.BinaryTypes.String},
+						[]string{"x", "x", "y"},
+						nil,
+					),
+					series.FromString(
+						pool,
+						arrow.Field{Name: "vals", Type: arrow.BinaryTypes.String},
+						[]string{"a", "b", "c"},
+						nil,
+					),
+				}
+				for i, s := range ss {
+					defer s.Release()
+					ss[i] = s.AsCategorical()
+					defer ss[i].Release()
+				}
+
+				return dataframe.NewFromSeries(pool, ss)
+			},
+			inIdxName:  "idx",
+			inColsName: "cols",
+			inValsName: "vals",
+			expNumCols: 3,
+			expNumRows: 2,
+			exp: []interface{}{
+				[]string{"amy", "bob"},
+				[]string{"a", "b"},
+				[]string{"c", ""},
+			},
+			expNAIndices: [][]int{
+				[]int{},
+				[]int{},
+				[]int{1},
+			},
+			expHeaders: []string{"idx", "x", "y"},
+		},
 	}
 
 	for _, tt := range tests {
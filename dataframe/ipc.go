@@ -0,0 +1,165 @@
+package dataframe
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/ipc"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/poopoothegorilla/fastframe/series"
+)
+
+// NewFromIPCStream creates a DataFrame from an Arrow IPC stream. A stream with
+// a single record batch is loaded without copying its columns, and a stream
+// without record batches gives a DataFrame with no rows.
+func NewFromIPCStream(pool memory.Allocator, r io.Reader) DataFrame {
+	rdr, err := ipc.NewReader(r, ipc.WithAllocator(pool))
+	if err != nil {
+		panic(fmt.Sprintf("dataframe: new_from_ipc_stream: %s", err))
+	}
+	defer rdr.Release()
+
+	var records []array.Record
+	for rdr.Next() {
+		record := rdr.Record()
+		record.Retain()
+		defer record.Release()
+		records = append(records, record)
+	}
+	if err := rdr.Err(); err != nil {
+		panic(fmt.Sprintf("dataframe: new_from_ipc_stream: %s", err))
+	}
+	if len(records) == 0 {
+		record := newEmptyRecord(pool, rdr.Schema())
+		defer record.Release()
+		records = append(records, record)
+	}
+
+	return newFromRecordBatches(pool, records)
+}
+
+// NewFromIPCFile creates a DataFrame from an Arrow IPC file. A file with a
+// single record batch is loaded without copying its columns, and a file
+// without record batches gives a DataFrame with no rows.
+func NewFromIPCFile(pool memory.Allocator, r ipc.ReadAtSeeker) DataFrame {
+	rdr, err := ipc.NewFileReader(r, ipc.WithAllocator(pool))
+	if err != nil {
+		panic(fmt.Sprintf("dataframe: new_from_ipc_file: %s", err))
+	}
+	defer rdr.Close()
+
+	if rdr.NumRecords() == 0 {
+		record := newEmptyRecord(pool, rdr.Schema())
+		defer record.Release()
+		return newFromRecord(pool, record)
+	}
+
+	records := make([]array.Record, rdr.NumRecords())
+	for i := range records {
+		record, err := rdr.Record(i)
+		if err != nil {
+			panic(fmt.Sprintf("dataframe: new_from_ipc_file: %s", err))
+		}
+		record.Retain()
+		defer record.Release()
+		records[i] = record
+	}
+
+	return newFromRecordBatches(pool, records)
+}
+
+// newEmptyRecord returns an Arrow record of the schema without rows.
+func newEmptyRecord(pool memory.Allocator, schema *arrow.Schema) array.Record {
+	b := array.NewRecordBuilder(pool, schema)
+	defer b.Release()
+
+	return b.NewRecord()
+}
+
+// WriteIPCStream writes the DataFrame to w in the Arrow IPC stream format.
+// Categorical Series are written as String columns.
+func (df DataFrame) WriteIPCStream(w io.Writer) error {
+	df = df.decodeCategoricals()
+	defer df.Release()
+
+	iw := ipc.NewWriter(w, ipc.WithSchema(df.Schema()), ipc.WithAllocator(df.pool))
+	if err := df.writeRecords(iw.Write); err != nil {
+		iw.Close()
+		return fmt.Errorf("dataframe: write_ipc_stream: %w", err)
+	}
+	if err := iw.Close(); err != nil {
+		return fmt.Errorf("dataframe: write_ipc_stream: %w", err)
+	}
+
+	return nil
+}
+
+// WriteIPCFile writes the DataFrame to w in the Arrow IPC file format.
+// Categorical Series are written as String columns.
+func (df DataFrame) WriteIPCFile(w io.WriteSeeker) error {
+	df = df.decodeCategoricals()
+	defer df.Release()
+
+	fw, err := ipc.NewFileWriter(w, ipc.WithSchema(df.Schema()), ipc.WithAllocator(df.pool))
+	if err != nil {
+		return fmt.Errorf("dataframe: write_ipc_file: %w", err)
+	}
+	if err := df.writeRecords(fw.Write); err != nil {
+		fw.Close()
+		return fmt.Errorf("dataframe: write_ipc_file: %w", err)
+	}
+	if err := fw.Close(); err != nil {
+		return fmt.Errorf("dataframe: write_ipc_file: %w", err)
+	}
+
+	return nil
+}
+
+// writeRecords passes the DataFrame as a single Arrow record to the write
+// function.
+//
+// NOTE: the record is built from the underlying Arrow arrays because the IPC
+// encoder does not accept Series as columns.
+func (df DataFrame) writeRecords(write func(array.Record) error) error {
+	cols := make([]array.Interface, len(df.series))
+	for i, s := range df.series {
+		cols[i] = array.MakeFromData(s.Data())
+		defer cols[i].Release()
+	}
+	record := array.NewRecord(df.Schema(), cols, df.NumRows())
+	defer record.Release()
+
+	return write(record)
+}
+
+// decodeCategoricals returns the DataFrame with its categorical Series decoded
+// to String Series, for formats which have no categorical type.
+func (df DataFrame) decodeCategoricals() DataFrame {
+	ss := make([]series.Series, len(df.series))
+	for i, s := range df.series {
+		if s.DataType() != series.Categorical {
+			s.Retain()
+			ss[i] = s
+			continue
+		}
+
+		vals := make([]string, s.Len())
+		valid := make([]bool, s.Len())
+		for j := range vals {
+			if s.IsValid(j) {
+				vals[j] = s.Value(j).(string)
+				valid[j] = true
+			}
+		}
+		f := s.Field()
+		f.Type = arrow.BinaryTypes.String
+		ss[i] = series.FromString(df.pool, f, vals, valid)
+	}
+
+	return DataFrame{
+		pool:   df.pool,
+		series: ss,
+	}
+}
@@ -0,0 +1,738 @@
+package dataframe
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/poopoothegorilla/fastframe/series"
+)
+
+// JSONOrient is the layout of a DataFrame written as JSON.
+type JSONOrient int
+
+const (
+	// JSONRecords writes an array with an object per row:
+	//  [{"a":1,"b":"x"},{"a":2,"b":"y"}]
+	JSONRecords JSONOrient = iota
+	// JSONColumns writes an object with an array per column:
+	//  {"a":[1,2],"b":["x","y"]}
+	JSONColumns
+)
+
+// NewFromJSON creates a DataFrame from a JSON array of objects. Each object is
+// a row and each key is a column, ordered by its first appearance.
+//
+// Columns with a type in tList are parsed into that type. The type of every
+// other column is inferred from all of its values: Int64 if every number is
+// an integer, Uint64 if an integer is above math.MaxInt64 and none is
+// negative, Float64 otherwise, Boolean for booleans, a List for arrays, a
+// Struct for objects and String otherwise. The element type of a List is
+// inferred from the elements of all its arrays, and a Struct has a field per
+// key, ordered by its first appearance, inferred from the values of that key.
+// Mixed columns are read as String, with nested arrays and objects kept as
+// their JSON text. Nulls and missing keys are stored as nulls. A panic is
+// triggered if the JSON is malformed or a value can not be parsed into the
+// type of its column, such as a string in an integer or float column.
+func NewFromJSON(pool memory.Allocator, r io.Reader, tList map[string]arrow.DataType) DataFrame {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+
+	if err := expectJSONDelim(dec, '['); err != nil {
+		panic(fmt.Sprintf("dataframe: new_from_json: %s", err))
+	}
+	var rows jsonRows
+	for dec.More() {
+		if err := rows.read(dec); err != nil {
+			panic(fmt.Sprintf("dataframe: new_from_json: row %d: %s", rows.n, err))
+		}
+	}
+	if err := expectJSONDelim(dec, ']'); err != nil {
+		panic(fmt.Sprintf("dataframe: new_from_json: %s", err))
+	}
+
+	ss, err := rows.series(pool, tList)
+	if err != nil {
+		panic(fmt.Sprintf("dataframe: new_from_json: %s", err))
+	}
+	defer func() {
+		for _, s := range ss {
+			s.Release()
+		}
+	}()
+
+	return NewFromSeries(pool, ss)
+}
+
+// NewFromNDJSON creates a DataFrame from newline delimited JSON, where each
+// line is an object holding a row. Columns are resolved as in NewFromJSON.
+func NewFromNDJSON(pool memory.Allocator, r io.Reader, tList map[string]arrow.DataType) DataFrame {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+
+	var rows jsonRows
+	for dec.More() {
+		if err := rows.read(dec); err != nil {
+			panic(fmt.Sprintf("dataframe: new_from_ndjson: line %d: %s", rows.n+1, err))
+		}
+	}
+
+	ss, err := rows.series(pool, tList)
+	if err != nil {
+		panic(fmt.Sprintf("dataframe: new_from_ndjson: %s", err))
+	}
+	defer func() {
+		for _, s := range ss {
+			s.Release()
+		}
+	}()
+
+	return NewFromSeries(pool, ss)
+}
+
+// expectJSONDelim reads the next token of dec and checks that it is delim.
+func expectJSONDelim(dec *json.Decoder, delim json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if d, ok := tok.(json.Delim); !ok || d != delim {
+		return fmt.Errorf("expected %q, got %v", delim, tok)
+	}
+	return nil
+}
+
+// jsonRows holds decoded JSON objects by column.
+type jsonRows struct {
+	n     int
+	names []string
+	// cols holds the values of each column, with nil for nulls and missing
+	// keys.
+	cols map[string][]interface{}
+}
+
+// read decodes the next object from dec and appends it as a row.
+func (rows *jsonRows) read(dec *json.Decoder) error {
+	if rows.cols == nil {
+		rows.cols = make(map[string][]interface{})
+	}
+
+	if err := expectJSONDelim(dec, '{'); err != nil {
+		return err
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		name := tok.(string)
+		v, err := decodeJSONValue(dec)
+		if err != nil {
+			return fmt.Errorf("key %q: %w", name, err)
+		}
+
+		col, ok := rows.cols[name]
+		if !ok {
+			rows.names = append(rows.names, name)
+			col = make([]interface{}, rows.n, rows.n+1)
+		}
+		if len(col) > rows.n {
+			return fmt.Errorf("duplicate key %q", name)
+		}
+		rows.cols[name] = append(col, v)
+	}
+	if err := expectJSONDelim(dec, '}'); err != nil {
+		return err
+	}
+
+	rows.n++
+	for _, name := range rows.names {
+		if col := rows.cols[name]; len(col) < rows.n {
+			rows.cols[name] = append(col, nil)
+		}
+	}
+
+	return nil
+}
+
+// series builds a Series for each column.
+func (rows *jsonRows) series(pool memory.Allocator, tList map[string]arrow.DataType) ([]series.Series, error) {
+	ss := make([]series.Series, 0, len(rows.names))
+	for _, name := range rows.names {
+		vals := rows.cols[name]
+		typ, ok := tList[name]
+		if !ok {
+			typ = inferJSONType(vals)
+		}
+
+		field := arrow.Field{Name: name, Type: typ, Nullable: true}
+		s, i, err := newJSONSeries(pool, field, vals)
+		if err != nil {
+			for _, s := range ss {
+				s.Release()
+			}
+			return nil, fmt.Errorf("row %d: column %q: %w", i, name, err)
+		}
+		ss = append(ss, s)
+	}
+
+	return ss, nil
+}
+
+// newJSONSeries builds a Series of the field from decoded values, with nil for
+// nulls. List types are built from arrays and Struct types from objects, with
+// their elements and fields built recursively. On error, the position of the
+// value which failed is returned.
+func newJSONSeries(pool memory.Allocator, field arrow.Field, vals []interface{}) (series.Series, int, error) {
+	switch t := field.Type.(type) {
+	case *arrow.ListType:
+		var elems []interface{}
+		var parents []int
+		offsets := make([]int32, 1, len(vals)+1)
+		valid := make([]bool, len(vals))
+		for i, v := range vals {
+			switch v := v.(type) {
+			case nil:
+			case []interface{}:
+				valid[i] = true
+				elems = append(elems, v...)
+				for range v {
+					parents = append(parents, i)
+				}
+			default:
+				return series.Series{}, i, fmt.Errorf("expected array, got %T", v)
+			}
+			offsets = append(offsets, int32(len(elems)))
+		}
+
+		elemField := arrow.Field{Name: "item", Type: t.Elem(), Nullable: true}
+		values, j, err := newJSONSeries(pool, elemField, elems)
+		if err != nil {
+			return series.Series{}, parents[j], err
+		}
+		defer values.Release()
+		return series.FromList(pool, field, values, offsets, valid), 0, nil
+	case *arrow.StructType:
+		valid := make([]bool, len(vals))
+		for i, v := range vals {
+			switch v.(type) {
+			case nil:
+			case jsonObject:
+				valid[i] = true
+			default:
+				return series.Series{}, i, fmt.Errorf("expected object, got %T", v)
+			}
+		}
+
+		children := make([]series.Series, 0, len(t.Fields()))
+		defer func() {
+			for _, c := range children {
+				c.Release()
+			}
+		}()
+		for _, f := range t.Fields() {
+			fieldVals := make([]interface{}, len(vals))
+			for i, v := range vals {
+				if obj, ok := v.(jsonObject); ok {
+					fieldVals[i] = obj.values[f.Name]
+				}
+			}
+			c, i, err := newJSONSeries(pool, f, fieldVals)
+			if err != nil {
+				return series.Series{}, i, fmt.Errorf("key %q: %w", f.Name, err)
+			}
+			children = append(children, c)
+		}
+		return series.FromStruct(pool, field, children, valid), 0, nil
+	}
+
+	c := newCSVColumn(pool, field.Type)
+	defer c.b.Release()
+	for i, v := range vals {
+		if v == nil {
+			c.b.AppendNull()
+			continue
+		}
+		if _, ok := v.(string); ok && isNumeric(field.Type) {
+			return series.Series{}, i, fmt.Errorf("expected number, got string %q", v)
+		}
+		val, err := jsonString(v)
+		if err == nil {
+			err = c.append(val)
+		}
+		if err != nil {
+			return series.Series{}, i, err
+		}
+	}
+
+	return series.FromArrow(pool, field, c.b.NewArray()), 0, nil
+}
+
+// inferJSONType returns the type of a column from its decoded values.
+func inferJSONType(vals []interface{}) arrow.DataType {
+	var numbers, negatives, uints, floats, bools, arrays, objects, others int
+	for _, v := range vals {
+		switch v := v.(type) {
+		case nil:
+		case json.Number:
+			numbers++
+			if i, err := v.Int64(); err == nil {
+				if i < 0 {
+					negatives++
+				}
+			} else if _, err := strconv.ParseUint(v.String(), 10, 64); err == nil {
+				uints++
+			} else {
+				floats++
+			}
+		case bool:
+			bools++
+		case []interface{}:
+			arrays++
+		case jsonObject:
+			objects++
+		default:
+			others++
+		}
+	}
+
+	n := numbers + bools + arrays + objects + others
+	switch {
+	case n == 0:
+	case numbers == n:
+		if floats > 0 || (uints > 0 && negatives > 0) {
+			return arrow.PrimitiveTypes.Float64
+		}
+		if uints > 0 {
+			return arrow.PrimitiveTypes.Uint64
+		}
+		return arrow.PrimitiveTypes.Int64
+	case bools == n:
+		return arrow.FixedWidthTypes.Boolean
+	case arrays == n:
+		var elems []interface{}
+		for _, v := range vals {
+			if v, ok := v.([]interface{}); ok {
+				elems = append(elems, v...)
+			}
+		}
+		return arrow.ListOf(inferJSONType(elems))
+	case objects == n:
+		var keys []string
+		seen := make(map[string]bool)
+		for _, v := range vals {
+			if obj, ok := v.(jsonObject); ok {
+				for _, k := range obj.keys {
+					if !seen[k] {
+						seen[k] = true
+						keys = append(keys, k)
+					}
+				}
+			}
+		}
+		fields := make([]arrow.Field, len(keys))
+		for j, k := range keys {
+			fieldVals := make([]interface{}, len(vals))
+			for i, v := range vals {
+				if obj, ok := v.(jsonObject); ok {
+					fieldVals[i] = obj.values[k]
+				}
+			}
+			fields[j] = arrow.Field{Name: k, Type: inferJSONType(fieldVals), Nullable: true}
+		}
+		return arrow.StructOf(fields...)
+	}
+
+	return arrow.BinaryTypes.String
+}
+
+// jsonObject is a decoded JSON object which keeps the order of its keys.
+type jsonObject struct {
+	keys   []string
+	values map[string]interface{}
+}
+
+// MarshalJSON encodes the object with its keys in their decoded order.
+func (obj jsonObject) MarshalJSON() ([]byte, error) {
+	buf := []byte{'{'}
+	for i, k := range obj.keys {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		key, err := json.Marshal(k)
+		if err != nil {
+			return nil, err
+		}
+		v, err := json.Marshal(obj.values[k])
+		if err != nil {
+			return nil, err
+		}
+		buf = append(buf, key...)
+		buf = append(buf, ':')
+		buf = append(buf, v...)
+	}
+	return append(buf, '}'), nil
+}
+
+// decodeJSONValue decodes the next value of dec. Numbers are decoded as
+// json.Number, arrays as []interface{} and objects as jsonObject.
+func decodeJSONValue(dec *json.Decoder) (interface{}, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch tok {
+	case json.Delim('['):
+		vals := []interface{}{}
+		for dec.More() {
+			v, err := decodeJSONValue(dec)
+			if err != nil {
+				return nil, err
+			}
+			vals = append(vals, v)
+		}
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		return vals, nil
+	case json.Delim('{'):
+		obj := jsonObject{values: make(map[string]interface{})}
+		for dec.More() {
+			tok, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			k := tok.(string)
+			v, err := decodeJSONValue(dec)
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := obj.values[k]; !ok {
+				obj.keys = append(obj.keys, k)
+			}
+			obj.values[k] = v
+		}
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		return obj, nil
+	}
+
+	return tok, nil
+}
+
+// jsonString returns a decoded JSON value as a string to be parsed by a
+// csvColumn. Arrays and objects are returned as JSON text.
+func jsonString(v interface{}) (string, error) {
+	switch v := v.(type) {
+	case string:
+		return v, nil
+	case json.Number:
+		return v.String(), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	default:
+		b, err := json.Marshal(v)
+		return string(b), err
+	}
+}
+
+// WriteJSON writes the DataFrame to w as JSON laid out by orient.
+//
+// Nulls and non finite floats are written as null and timestamps are written
+// as RFC 3339 strings in the time zone of their type. Dates, durations and
+// binary values are written as strings, as in WriteCSV. Decimals are written
+// as numbers with all the fractional digits of their scale. Lists are written
+// as arrays and structs as objects, with their values written recursively.
+func (df DataFrame) WriteJSON(w io.Writer, orient JSONOrient) error {
+	df.Retain()
+	defer df.Release()
+
+	var err error
+	switch orient {
+	case JSONRecords:
+		err = df.writeJSONRecords(w, false)
+	case JSONColumns:
+		err = df.writeJSONColumns(w)
+	default:
+		err = fmt.Errorf("unsupported orient %d", orient)
+	}
+	if err != nil {
+		return fmt.Errorf("dataframe: write_json: %w", err)
+	}
+
+	return nil
+}
+
+// WriteNDJSON writes the DataFrame to w as newline delimited JSON, with an
+// object per row. Values are written as in WriteJSON.
+func (df DataFrame) WriteNDJSON(w io.Writer) error {
+	df.Retain()
+	defer df.Release()
+
+	if err := df.writeJSONRecords(w, true); err != nil {
+		return fmt.Errorf("dataframe: write_ndjson: %w", err)
+	}
+
+	return nil
+}
+
+func (df DataFrame) writeJSONRecords(w io.Writer, ndjson bool) error {
+	keys, encoders, err := df.jsonEncoders()
+	if err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriter(w)
+	if !ndjson {
+		bw.WriteByte('[')
+	}
+	var buf []byte
+	numRows := int(df.NumRows())
+	for i := 0; i < numRows; i++ {
+		buf = buf[:0]
+		if !ndjson && i > 0 {
+			buf = append(buf, ',')
+		}
+		buf = append(buf, '{')
+		for j, s := range df.series {
+			if j > 0 {
+				buf = append(buf, ',')
+			}
+			buf = append(buf, keys[j]...)
+			buf = append(buf, ':')
+			buf = appendJSONValue(buf, s, encoders[j], i)
+		}
+		buf = append(buf, '}')
+		if ndjson {
+			buf = append(buf, '\n')
+		}
+		bw.Write(buf)
+	}
+	if !ndjson {
+		bw.WriteByte(']')
+	}
+
+	return bw.Flush()
+}
+
+func (df DataFrame) writeJSONColumns(w io.Writer) error {
+	keys, encoders, err := df.jsonEncoders()
+	if err != nil {
+		return err
+	}
+
+	bw := bufio.NewWriter(w)
+	bw.WriteByte('{')
+	var buf []byte
+	for j, s := range df.series {
+		buf = buf[:0]
+		if j > 0 {
+			buf = append(buf, ',')
+		}
+		buf = append(buf, keys[j]...)
+		buf = append(buf, ':', '[')
+		for i := 0; i < s.Len(); i++ {
+			if i > 0 {
+				buf = append(buf, ',')
+			}
+			buf = appendJSONValue(buf, s, encoders[j], i)
+		}
+		buf = append(buf, ']')
+		bw.Write(buf)
+	}
+	bw.WriteByte('}')
+
+	return bw.Flush()
+}
+
+// jsonEncoders returns the encoded JSON key and a value encoder of each
+// Series.
+func (df DataFrame) jsonEncoders() ([][]byte, []func([]byte, int) []byte, error) {
+	keys := make([][]byte, len(df.series))
+	encoders := make([]func([]byte, int) []byte, len(df.series))
+	for i, s := range df.series {
+		key, err := json.Marshal(s.Name())
+		if err != nil {
+			return nil, nil, err
+		}
+		keys[i] = key
+
+		enc, err := newJSONEncoder(s)
+		if err != nil {
+			return nil, nil, fmt.Errorf("column %q: %w", s.Name(), err)
+		}
+		encoders[i] = enc
+	}
+
+	return keys, encoders, nil
+}
+
+// appendJSONValue appends the value at position i of the s Series, or null,
+// to buf.
+func appendJSONValue(buf []byte, s series.Series, enc func([]byte, int) []byte, i int) []byte {
+	if s.IsNull(i) {
+		return append(buf, "null"...)
+	}
+	return enc(buf, i)
+}
+
+// newJSONEncoder returns a function which appends the value at position i of
+// the s Series to a buffer as JSON.
+func newJSONEncoder(s series.Series) (func([]byte, int) []byte, error) {
+	// NOTE: json.Marshal only fails on non finite floats, which are written
+	// as null.
+	appendMarshaled := func(buf []byte, v interface{}) []byte {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return append(buf, "null"...)
+		}
+		return append(buf, b...)
+	}
+
+	switch a := s.Interface.(type) {
+	case *array.Int32:
+		return func(buf []byte, i int) []byte {
+			return strconv.AppendInt(buf, int64(a.Value(i)), 10)
+		}, nil
+	case *array.Int64:
+		return func(buf []byte, i int) []byte {
+			return strconv.AppendInt(buf, a.Value(i), 10)
+		}, nil
+	case *array.Int8:
+		return func(buf []byte, i int) []byte {
+			return strconv.AppendInt(buf, int64(a.Value(i)), 10)
+		}, nil
+	case *array.Int16:
+		return func(buf []byte, i int) []byte {
+			return strconv.AppendInt(buf, int64(a.Value(i)), 10)
+		}, nil
+	case *array.Uint8:
+		return func(buf []byte, i int) []byte {
+			return strconv.AppendUint(buf, uint64(a.Value(i)), 10)
+		}, nil
+	case *array.Uint16:
+		return func(buf []byte, i int) []byte {
+			return strconv.AppendUint(buf, uint64(a.Value(i)), 10)
+		}, nil
+	case *array.Uint32:
+		return func(buf []byte, i int) []byte {
+			return strconv.AppendUint(buf, uint64(a.Value(i)), 10)
+		}, nil
+	case *array.Uint64:
+		return func(buf []byte, i int) []byte {
+			return strconv.AppendUint(buf, uint64(a.Value(i)), 10)
+		}, nil
+	case *array.Float32:
+		return func(buf []byte, i int) []byte {
+			return appendMarshaled(buf, a.Value(i))
+		}, nil
+	case *array.Float64:
+		return func(buf []byte, i int) []byte {
+			return appendMarshaled(buf, a.Value(i))
+		}, nil
+	case *array.Boolean:
+		return func(buf []byte, i int) []byte {
+			return strconv.AppendBool(buf, a.Value(i))
+		}, nil
+	case *array.Timestamp:
+		t := s.DataType().(*arrow.TimestampType)
+		loc, err := series.TimeLocation(t.TimeZone)
+		if err != nil {
+			return nil, err
+		}
+		return func(buf []byte, i int) []byte {
+			return appendMarshaled(buf, series.TimestampToTime(a.Value(i), t.Unit).In(loc).Format(time.RFC3339Nano))
+		}, nil
+	case *array.Date32, *array.Date64, *array.Duration, *array.Binary, *array.FixedSizeBinary:
+		format, err := newCSVFormatter(s, newCSVConfig())
+		if err != nil {
+			return nil, err
+		}
+		return func(buf []byte, i int) []byte {
+			return appendMarshaled(buf, format(i))
+		}, nil
+	case *array.Decimal128:
+		// NOTE: decimals are written as JSON numbers with all their digits.
+		scale := s.DataType().(*arrow.Decimal128Type).Scale
+		return func(buf []byte, i int) []byte {
+			return append(buf, series.FormatDecimal(a.Value(i), scale)...)
+		}, nil
+	case *array.String:
+		return func(buf []byte, i int) []byte {
+			return appendMarshaled(buf, a.Value(i))
+		}, nil
+	case *array.List:
+		// NOTE: elements are encoded per list, so that no Series outlives
+		// the encoder. The elements of all lists are only checked here.
+		elems := s.ListValues()
+		_, err := newJSONEncoder(elems)
+		elems.Release()
+		if err != nil {
+			return nil, err
+		}
+		return func(buf []byte, i int) []byte {
+			elems := s.ListValue(i)
+			defer elems.Release()
+			enc, _ := newJSONEncoder(elems)
+
+			buf = append(buf, '[')
+			for j := 0; j < elems.Len(); j++ {
+				if j > 0 {
+					buf = append(buf, ',')
+				}
+				buf = appendJSONValue(buf, elems, enc, j)
+			}
+			return append(buf, ']')
+		}, nil
+	case *array.Struct:
+		fields := s.DataType().(*arrow.StructType).Fields()
+		keys := make([][]byte, len(fields))
+		for k, f := range fields {
+			key, err := json.Marshal(f.Name)
+			if err != nil {
+				return nil, err
+			}
+			keys[k] = key
+
+			c := s.StructField(f.Name)
+			_, err = newJSONEncoder(c)
+			c.Release()
+			if err != nil {
+				return nil, fmt.Errorf("field %q: %w", f.Name, err)
+			}
+		}
+		return func(buf []byte, i int) []byte {
+			row := s.Truncate(int64(i), int64(i+1))
+			defer row.Release()
+
+			buf = append(buf, '{')
+			for k, f := range fields {
+				if k > 0 {
+					buf = append(buf, ',')
+				}
+				buf = append(buf, keys[k]...)
+				buf = append(buf, ':')
+				c := row.StructField(f.Name)
+				enc, _ := newJSONEncoder(c)
+				buf = appendJSONValue(buf, c, enc, 0)
+				c.Release()
+			}
+			return append(buf, '}')
+		}, nil
+	default:
+		if s.DataType() == series.Categorical {
+			return func(buf []byte, i int) []byte {
+				return appendMarshaled(buf, s.Value(i))
+			}, nil
+		}
+		return nil, fmt.Errorf("unsupported type %s", s.DataType())
+	}
+}
@@ -0,0 +1,590 @@
+package dataframe
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/poopoothegorilla/fastframe/series"
+	"github.com/xitongsys/parquet-go/marshal"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/reader"
+	"github.com/xitongsys/parquet-go/source"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+// parquetRowGroupSize is the default number of rows written to each Parquet
+// row group.
+const parquetRowGroupSize = 64 * 1024
+
+// ParquetCompression is a compression codec used for Parquet column chunks.
+type ParquetCompression int
+
+// The compression codecs supported when writing Parquet.
+const (
+	ParquetSnappy ParquetCompression = iota
+	ParquetUncompressed
+	ParquetGzip
+	ParquetZstd
+)
+
+func (c ParquetCompression) codec() (parquet.CompressionCodec, error) {
+	switch c {
+	case ParquetSnappy:
+		return parquet.CompressionCodec_SNAPPY, nil
+	case ParquetUncompressed:
+		return parquet.CompressionCodec_UNCOMPRESSED, nil
+	case ParquetGzip:
+		return parquet.CompressionCodec_GZIP, nil
+	case ParquetZstd:
+		return parquet.CompressionCodec_ZSTD, nil
+	}
+
+	return 0, fmt.Errorf("unsupported compression %d", c)
+}
+
+// ParquetOption configures how Parquet data is read or written.
+type ParquetOption func(*parquetConfig)
+
+type parquetConfig struct {
+	columns   []string
+	rowGroups []int
+
+	compression  ParquetCompression
+	rowGroupSize int
+}
+
+func newParquetConfig(opts ...ParquetOption) *parquetConfig {
+	cfg := &parquetConfig{
+		rowGroupSize: parquetRowGroupSize,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	return cfg
+}
+
+// WithParquetColumns only reads the columns with the given names. Columns are
+// ordered as they appear in the Parquet schema.
+func WithParquetColumns(names ...string) ParquetOption {
+	return func(cfg *parquetConfig) {
+		cfg.columns = names
+	}
+}
+
+// WithParquetRowGroups only reads the row groups at the given indices.
+func WithParquetRowGroups(indices ...int) ParquetOption {
+	return func(cfg *parquetConfig) {
+		cfg.rowGroups = indices
+	}
+}
+
+// WithParquetCompression sets the codec used to compress written column
+// chunks. The default is ParquetSnappy.
+func WithParquetCompression(c ParquetCompression) ParquetOption {
+	return func(cfg *parquetConfig) {
+		cfg.compression = c
+	}
+}
+
+// WithParquetRowGroupSize sets the maximum number of rows written to each row
+// group.
+func WithParquetRowGroupSize(n int) ParquetOption {
+	return func(cfg *parquetConfig) {
+		cfg.rowGroupSize = n
+	}
+}
+
+// NewFromParquet creates a DataFrame from the Parquet file in r, which is size
+// bytes long.
+//
+// Only flat schemas are supported. Physical and logical types are mapped to
+// Series types as follows:
+//
+//	BOOLEAN                   -> Boolean
+//	INT32                     -> Int32
+//	INT32 (INT_8, INT_16)     -> Int8, Int16
+//	INT32 (UINT_8, ...)       -> Uint8, Uint16, Uint32
+//	INT32 (DATE)              -> Date32
+//	INT64                     -> Int64
+//	INT64 (UINT_64)           -> Uint64
+//	INT64 (TIMESTAMP)         -> Timestamp
+//	FLOAT                     -> Float32
+//	DOUBLE                    -> Float64
+//	BYTE_ARRAY, FIXED_LEN_... -> String
+func NewFromParquet(pool memory.Allocator, r io.ReaderAt, size int64, opts ...ParquetOption) DataFrame {
+	cfg := newParquetConfig(opts...)
+
+	pr, err := reader.NewParquetColumnReader(&parquetFile{r: io.NewSectionReader(r, 0, size)}, 1)
+	if err != nil {
+		panic(fmt.Sprintf("dataframe: new_from_parquet: %s", err))
+	}
+	defer pr.ReadStop()
+
+	elems := pr.SchemaHandler.SchemaElements
+	if int(elems[0].GetNumChildren()) != len(elems)-1 {
+		panic("dataframe: new_from_parquet: nested schemas are not supported")
+	}
+
+	var indices []int
+	if cfg.columns != nil {
+		for _, name := range cfg.columns {
+			i := parquetColumnIndex(pr, name)
+			if i < 0 {
+				panic(fmt.Sprintf("dataframe: new_from_parquet: column %q not found", name))
+			}
+			indices = append(indices, i)
+		}
+		sort.Ints(indices)
+	} else {
+		for i := 0; i < len(elems)-1; i++ {
+			indices = append(indices, i)
+		}
+	}
+
+	groups := pr.Footer.GetRowGroups()
+	starts := make([]int64, len(groups)+1)
+	for i, g := range groups {
+		starts[i+1] = starts[i] + g.GetNumRows()
+	}
+	selected := cfg.rowGroups
+	if selected == nil {
+		for i := range groups {
+			selected = append(selected, i)
+		}
+	}
+	selected = append([]int(nil), selected...)
+	sort.Ints(selected)
+	for _, g := range selected {
+		if g < 0 || g >= len(groups) {
+			panic(fmt.Sprintf("dataframe: new_from_parquet: row group %d out of range [0, %d)", g, len(groups)))
+		}
+	}
+
+	fields := make([]arrow.Field, len(indices))
+	ss := make([]series.Series, len(indices))
+	defer func() {
+		for _, s := range ss {
+			if s.Interface != nil {
+				s.Release()
+			}
+		}
+	}()
+	for i, idx := range indices {
+		elem := elems[idx+1]
+		name := pr.SchemaHandler.Infos[idx+1].ExName
+		if elem.GetRepetitionType() == parquet.FieldRepetitionType_REPEATED {
+			panic(fmt.Sprintf("dataframe: new_from_parquet: column %q: repeated columns are not supported", name))
+		}
+		typ, err := parquetArrowType(elem)
+		if err != nil {
+			panic(fmt.Sprintf("dataframe: new_from_parquet: column %q: %s", name, err))
+		}
+		fields[i] = arrow.Field{
+			Name:     name,
+			Type:     typ,
+			Nullable: elem.GetRepetitionType() == parquet.FieldRepetitionType_OPTIONAL,
+		}
+
+		col := newParquetColumn(pool, typ)
+		var pos int64
+		for _, g := range selected {
+			if skip := starts[g] - pos; skip > 0 {
+				if err := pr.SkipRowsByPath(pr.SchemaHandler.ValueColumns[idx], skip); err != nil {
+					col.b.Release()
+					panic(fmt.Sprintf("dataframe: new_from_parquet: column %q: %s", name, err))
+				}
+			}
+			n := starts[g+1] - starts[g]
+			if n > 0 {
+				vals, _, _, err := pr.ReadColumnByIndex(int64(idx), n)
+				if err != nil {
+					col.b.Release()
+					panic(fmt.Sprintf("dataframe: new_from_parquet: column %q: %s", name, err))
+				}
+				for _, v := range vals {
+					col.append(v)
+				}
+			}
+			pos = starts[g+1]
+		}
+
+		ss[i] = series.FromArrow(pool, fields[i], col.b.NewArray())
+		col.b.Release()
+	}
+
+	return NewFromSeries(pool, ss)
+}
+
+// parquetColumnIndex returns the index of the leaf column with the given name,
+// or -1 if there is none.
+func parquetColumnIndex(pr *reader.ParquetReader, name string) int {
+	for i, info := range pr.SchemaHandler.Infos[1:] {
+		if info.ExName == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// parquetArrowType returns the Arrow type used for the Parquet column.
+func parquetArrowType(elem *parquet.SchemaElement) (arrow.DataType, error) {
+	var ts *parquet.TimestampType
+	if elem.IsSetLogicalType() && elem.GetLogicalType().IsSetTIMESTAMP() {
+		ts = elem.GetLogicalType().GetTIMESTAMP()
+	}
+	converted := parquet.ConvertedType(-1)
+	if elem.IsSetConvertedType() {
+		converted = elem.GetConvertedType()
+	}
+
+	switch elem.GetType() {
+	case parquet.Type_BOOLEAN:
+		return arrow.FixedWidthTypes.Boolean, nil
+	case parquet.Type_INT32:
+		switch converted {
+		case parquet.ConvertedType_INT_8:
+			return arrow.PrimitiveTypes.Int8, nil
+		case parquet.ConvertedType_INT_16:
+			return arrow.PrimitiveTypes.Int16, nil
+		case parquet.ConvertedType_UINT_8:
+			return arrow.PrimitiveTypes.Uint8, nil
+		case parquet.ConvertedType_UINT_16:
+			return arrow.PrimitiveTypes.Uint16, nil
+		case parquet.ConvertedType_UINT_32:
+			return arrow.PrimitiveTypes.Uint32, nil
+		case parquet.ConvertedType_DATE:
+			return arrow.FixedWidthTypes.Date32, nil
+		case parquet.ConvertedType_DECIMAL:
+		default:
+			return arrow.PrimitiveTypes.Int32, nil
+		}
+	case parquet.Type_INT64:
+		var tz string
+		if ts != nil && ts.GetIsAdjustedToUTC() {
+			tz = "UTC"
+		}
+		switch {
+		case ts != nil && ts.GetUnit().IsSetNANOS():
+			return &arrow.TimestampType{Unit: arrow.Nanosecond, TimeZone: tz}, nil
+		case ts != nil && ts.GetUnit().IsSetMICROS(), converted == parquet.ConvertedType_TIMESTAMP_MICROS:
+			return &arrow.TimestampType{Unit: arrow.Microsecond, TimeZone: tz}, nil
+		case ts != nil, converted == parquet.ConvertedType_TIMESTAMP_MILLIS:
+			return &arrow.TimestampType{Unit: arrow.Millisecond, TimeZone: tz}, nil
+		case converted == parquet.ConvertedType_UINT_64:
+			return arrow.PrimitiveTypes.Uint64, nil
+		case converted != parquet.ConvertedType_DECIMAL:
+			return arrow.PrimitiveTypes.Int64, nil
+		}
+	case parquet.Type_FLOAT:
+		return arrow.PrimitiveTypes.Float32, nil
+	case parquet.Type_DOUBLE:
+		return arrow.PrimitiveTypes.Float64, nil
+	case parquet.Type_BYTE_ARRAY, parquet.Type_FIXED_LEN_BYTE_ARRAY:
+		if converted == parquet.ConvertedType_DECIMAL {
+			break
+		}
+		return arrow.BinaryTypes.String, nil
+	}
+
+	return nil, fmt.Errorf("unsupported type %s", elem.GetType())
+}
+
+// parquetColumn builds an Arrow array from the values of a Parquet column.
+type parquetColumn struct {
+	b array.Builder
+}
+
+func newParquetColumn(pool memory.Allocator, t arrow.DataType) parquetColumn {
+	switch t := t.(type) {
+	case *arrow.BooleanType:
+		return parquetColumn{b: array.NewBooleanBuilder(pool)}
+	case *arrow.Int32Type:
+		return parquetColumn{b: array.NewInt32Builder(pool)}
+	case *arrow.Int64Type:
+		return parquetColumn{b: array.NewInt64Builder(pool)}
+	case *arrow.Int8Type:
+		return parquetColumn{b: array.NewInt8Builder(pool)}
+	case *arrow.Int16Type:
+		return parquetColumn{b: array.NewInt16Builder(pool)}
+	case *arrow.Uint8Type:
+		return parquetColumn{b: array.NewUint8Builder(pool)}
+	case *arrow.Uint16Type:
+		return parquetColumn{b: array.NewUint16Builder(pool)}
+	case *arrow.Uint32Type:
+		return parquetColumn{b: array.NewUint32Builder(pool)}
+	case *arrow.Uint64Type:
+		return parquetColumn{b: array.NewUint64Builder(pool)}
+	case *arrow.Date32Type:
+		return parquetColumn{b: array.NewDate32Builder(pool)}
+	case *arrow.TimestampType:
+		return parquetColumn{b: array.NewTimestampBuilder(pool, t)}
+	case *arrow.Float32Type:
+		return parquetColumn{b: array.NewFloat32Builder(pool)}
+	case *arrow.Float64Type:
+		return parquetColumn{b: array.NewFloat64Builder(pool)}
+	default:
+		return parquetColumn{b: array.NewStringBuilder(pool)}
+	}
+}
+
+// append appends a value as decoded by the Parquet reader, where nil is a null.
+func (c parquetColumn) append(v interface{}) {
+	if v == nil {
+		c.b.AppendNull()
+		return
+	}
+
+	switch b := c.b.(type) {
+	case *array.BooleanBuilder:
+		b.Append(v.(bool))
+	case *array.Int32Builder:
+		b.Append(v.(int32))
+	case *array.Int64Builder:
+		b.Append(v.(int64))
+	case *array.Int8Builder:
+		b.Append(int8(v.(int32)))
+	case *array.Int16Builder:
+		b.Append(int16(v.(int32)))
+	case *array.Uint8Builder:
+		b.Append(uint8(v.(int32)))
+	case *array.Uint16Builder:
+		b.Append(uint16(v.(int32)))
+	case *array.Uint32Builder:
+		b.Append(uint32(v.(int32)))
+	case *array.Uint64Builder:
+		b.Append(uint64(v.(int64)))
+	case *array.Date32Builder:
+		b.Append(arrow.Date32(v.(int32)))
+	case *array.TimestampBuilder:
+		b.Append(arrow.Timestamp(v.(int64)))
+	case *array.Float32Builder:
+		b.Append(v.(float32))
+	case *array.Float64Builder:
+		b.Append(v.(float64))
+	case *array.StringBuilder:
+		b.Append(v.(string))
+	}
+}
+
+// WriteParquet writes the DataFrame to w in the Parquet format. Every column
+// is written as optional and categorical Series are written as String columns.
+// Date32 and Date64 Series are written as DATE columns, which are read back as
+// Date32. Duration Series are not supported as Parquet has no duration type.
+func (df DataFrame) WriteParquet(w io.Writer, opts ...ParquetOption) error {
+	df = df.decodeCategoricals()
+	defer df.Release()
+
+	cfg := newParquetConfig(opts...)
+	if cfg.rowGroupSize <= 0 {
+		return fmt.Errorf("dataframe: write_parquet: invalid row group size %d", cfg.rowGroupSize)
+	}
+	codec, err := cfg.compression.codec()
+	if err != nil {
+		return fmt.Errorf("dataframe: write_parquet: %w", err)
+	}
+
+	numChildren := int32(len(df.series))
+	elems := []*parquet.SchemaElement{
+		&parquet.SchemaElement{
+			Name:           "schema",
+			NumChildren:    &numChildren,
+			RepetitionType: parquet.FieldRepetitionTypePtr(parquet.FieldRepetitionType_REQUIRED),
+		},
+	}
+	values := make([]func(int) interface{}, len(df.series))
+	for i, s := range df.series {
+		elem, value, err := newParquetSchemaElement(s)
+		if err != nil {
+			return fmt.Errorf("dataframe: write_parquet: column %q: %w", s.Name(), err)
+		}
+		elems = append(elems, elem)
+		values[i] = value
+	}
+
+	pw, err := writer.NewParquetWriterFromWriter(w, elems, 1)
+	if err != nil {
+		return fmt.Errorf("dataframe: write_parquet: %w", err)
+	}
+	pw.MarshalFunc = marshal.MarshalCSV
+	pw.CompressionType = codec
+	// NOTE: row groups are cut by row count in the loop below rather than by
+	// the writer's estimate of their size in bytes.
+	pw.RowGroupSize = math.MaxInt64
+
+	numRows := int(df.NumRows())
+	for j := 0; j < numRows; j++ {
+		row := make([]interface{}, len(values))
+		for i, value := range values {
+			if df.series[i].IsValid(j) {
+				row[i] = value(j)
+			}
+		}
+		if err := pw.Write(row); err != nil {
+			return fmt.Errorf("dataframe: write_parquet: %w", err)
+		}
+		if (j+1)%cfg.rowGroupSize == 0 {
+			if err := pw.Flush(true); err != nil {
+				return fmt.Errorf("dataframe: write_parquet: %w", err)
+			}
+		}
+	}
+	if err := pw.WriteStop(); err != nil {
+		return fmt.Errorf("dataframe: write_parquet: %w", err)
+	}
+
+	return nil
+}
+
+// newParquetSchemaElement returns the Parquet schema element of the Series and
+// a function returning its value at an index as the writer expects it.
+func newParquetSchemaElement(s series.Series) (*parquet.SchemaElement, func(int) interface{}, error) {
+	elem := &parquet.SchemaElement{
+		Name:           s.Name(),
+		RepetitionType: parquet.FieldRepetitionTypePtr(parquet.FieldRepetitionType_OPTIONAL),
+	}
+
+	switch t := s.DataType().(type) {
+	case *arrow.BooleanType:
+		elem.Type = parquet.TypePtr(parquet.Type_BOOLEAN)
+		arr := s.Interface.(*array.Boolean)
+		return elem, func(i int) interface{} { return arr.Value(i) }, nil
+	case *arrow.Int32Type:
+		elem.Type = parquet.TypePtr(parquet.Type_INT32)
+		arr := s.Interface.(*array.Int32)
+		return elem, func(i int) interface{} { return arr.Value(i) }, nil
+	case *arrow.Int64Type:
+		elem.Type = parquet.TypePtr(parquet.Type_INT64)
+		arr := s.Interface.(*array.Int64)
+		return elem, func(i int) interface{} { return arr.Value(i) }, nil
+	case *arrow.Int8Type:
+		elem.Type = parquet.TypePtr(parquet.Type_INT32)
+		elem.ConvertedType = parquet.ConvertedTypePtr(parquet.ConvertedType_INT_8)
+		elem.LogicalType = &parquet.LogicalType{INTEGER: &parquet.IntType{BitWidth: 8, IsSigned: true}}
+		arr := s.Interface.(*array.Int8)
+		return elem, func(i int) interface{} { return int32(arr.Value(i)) }, nil
+	case *arrow.Int16Type:
+		elem.Type = parquet.TypePtr(parquet.Type_INT32)
+		elem.ConvertedType = parquet.ConvertedTypePtr(parquet.ConvertedType_INT_16)
+		elem.LogicalType = &parquet.LogicalType{INTEGER: &parquet.IntType{BitWidth: 16, IsSigned: true}}
+		arr := s.Interface.(*array.Int16)
+		return elem, func(i int) interface{} { return int32(arr.Value(i)) }, nil
+	case *arrow.Uint8Type:
+		elem.Type = parquet.TypePtr(parquet.Type_INT32)
+		elem.ConvertedType = parquet.ConvertedTypePtr(parquet.ConvertedType_UINT_8)
+		elem.LogicalType = &parquet.LogicalType{INTEGER: &parquet.IntType{BitWidth: 8, IsSigned: false}}
+		arr := s.Interface.(*array.Uint8)
+		return elem, func(i int) interface{} { return int32(arr.Value(i)) }, nil
+	case *arrow.Uint16Type:
+		elem.Type = parquet.TypePtr(parquet.Type_INT32)
+		elem.ConvertedType = parquet.ConvertedTypePtr(parquet.ConvertedType_UINT_16)
+		elem.LogicalType = &parquet.LogicalType{INTEGER: &parquet.IntType{BitWidth: 16, IsSigned: false}}
+		arr := s.Interface.(*array.Uint16)
+		return elem, func(i int) interface{} { return int32(arr.Value(i)) }, nil
+	case *arrow.Uint32Type:
+		elem.Type = parquet.TypePtr(parquet.Type_INT32)
+		elem.ConvertedType = parquet.ConvertedTypePtr(parquet.ConvertedType_UINT_32)
+		elem.LogicalType = &parquet.LogicalType{INTEGER: &parquet.IntType{BitWidth: 32, IsSigned: false}}
+		arr := s.Interface.(*array.Uint32)
+		return elem, func(i int) interface{} { return int32(arr.Value(i)) }, nil
+	case *arrow.Uint64Type:
+		elem.Type = parquet.TypePtr(parquet.Type_INT64)
+		elem.ConvertedType = parquet.ConvertedTypePtr(parquet.ConvertedType_UINT_64)
+		elem.LogicalType = &parquet.LogicalType{INTEGER: &parquet.IntType{BitWidth: 64, IsSigned: false}}
+		arr := s.Interface.(*array.Uint64)
+		return elem, func(i int) interface{} { return int64(arr.Value(i)) }, nil
+	case *arrow.Float32Type:
+		elem.Type = parquet.TypePtr(parquet.Type_FLOAT)
+		arr := s.Interface.(*array.Float32)
+		return elem, func(i int) interface{} { return arr.Value(i) }, nil
+	case *arrow.Float64Type:
+		elem.Type = parquet.TypePtr(parquet.Type_DOUBLE)
+		arr := s.Interface.(*array.Float64)
+		return elem, func(i int) interface{} { return arr.Value(i) }, nil
+	case *arrow.StringType:
+		elem.Type = parquet.TypePtr(parquet.Type_BYTE_ARRAY)
+		elem.ConvertedType = parquet.ConvertedTypePtr(parquet.ConvertedType_UTF8)
+		elem.LogicalType = &parquet.LogicalType{STRING: parquet.NewStringType()}
+		arr := s.Interface.(*array.String)
+		return elem, func(i int) interface{} { return arr.Value(i) }, nil
+	case *arrow.Date32Type:
+		elem.Type = parquet.TypePtr(parquet.Type_INT32)
+		elem.ConvertedType = parquet.ConvertedTypePtr(parquet.ConvertedType_DATE)
+		elem.LogicalType = &parquet.LogicalType{DATE: parquet.NewDateType()}
+		arr := s.Interface.(*array.Date32)
+		return elem, func(i int) interface{} { return int32(arr.Value(i)) }, nil
+	case *arrow.Date64Type:
+		// NOTE: Parquet has no milliseconds date so Date64 values are written
+		// as the day they fall on.
+		elem.Type = parquet.TypePtr(parquet.Type_INT32)
+		elem.ConvertedType = parquet.ConvertedTypePtr(parquet.ConvertedType_DATE)
+		elem.LogicalType = &parquet.LogicalType{DATE: parquet.NewDateType()}
+		arr := s.Interface.(*array.Date64)
+		return elem, func(i int) interface{} {
+			return int32(series.TimeToDate32(series.Date64ToTime(arr.Value(i))))
+		}, nil
+	case *arrow.TimestampType:
+		elem.Type = parquet.TypePtr(parquet.Type_INT64)
+		ts := &parquet.TimestampType{
+			IsAdjustedToUTC: t.TimeZone != "",
+			Unit:            parquet.NewTimeUnit(),
+		}
+		elem.LogicalType = &parquet.LogicalType{TIMESTAMP: ts}
+		// NOTE: Parquet has no seconds unit so seconds are written as
+		// milliseconds.
+		scale := int64(1)
+		switch t.Unit {
+		case arrow.Second:
+			scale = 1000
+			fallthrough
+		case arrow.Millisecond:
+			ts.Unit.MILLIS = parquet.NewMilliSeconds()
+			elem.ConvertedType = parquet.ConvertedTypePtr(parquet.ConvertedType_TIMESTAMP_MILLIS)
+		case arrow.Microsecond:
+			ts.Unit.MICROS = parquet.NewMicroSeconds()
+			elem.ConvertedType = parquet.ConvertedTypePtr(parquet.ConvertedType_TIMESTAMP_MICROS)
+		case arrow.Nanosecond:
+			ts.Unit.NANOS = parquet.NewNanoSeconds()
+		}
+		arr := s.Interface.(*array.Timestamp)
+		return elem, func(i int) interface{} { return int64(arr.Value(i)) * scale }, nil
+	}
+
+	return nil, nil, fmt.Errorf("unsupported type %s", s.DataType().Name())
+}
+
+// parquetFile adapts an io.SectionReader to the file interface of the Parquet
+// reader. Each column is read through its own section reader.
+type parquetFile struct {
+	r *io.SectionReader
+}
+
+func (f *parquetFile) Read(p []byte) (int, error) {
+	return f.r.Read(p)
+}
+
+func (f *parquetFile) Seek(offset int64, whence int) (int64, error) {
+	return f.r.Seek(offset, whence)
+}
+
+func (f *parquetFile) Write(p []byte) (int, error) {
+	return 0, errors.New("parquet file is read only")
+}
+
+func (f *parquetFile) Close() error {
+	return nil
+}
+
+func (f *parquetFile) Open(string) (source.ParquetFile, error) {
+	return &parquetFile{r: io.NewSectionReader(f.r, 0, f.r.Size())}, nil
+}
+
+func (f *parquetFile) Create(string) (source.ParquetFile, error) {
+	return nil, errors.New("parquet file is read only")
+}
@@ -0,0 +1,376 @@
+package dataframe
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/poopoothegorilla/fastframe/series"
+)
+
+// Aggregation is a function which reduces the values of a column to a single
+// value.
+type Aggregation int
+
+const (
+	// AggSum is the sum of the values.
+	AggSum Aggregation = iota
+	// AggMean is the mean of the values.
+	AggMean
+	// AggMin is the minimum value.
+	AggMin
+	// AggMax is the maximum value.
+	AggMax
+	// AggCount is the number of non null values.
+	AggCount
+	// AggFirst is the first non null value.
+	AggFirst
+	// AggLast is the last non null value.
+	AggLast
+)
+
+// ResampleEdge is an edge of a resampling bin.
+type ResampleEdge int
+
+const (
+	// ResampleLeft is the start of a bin.
+	ResampleLeft ResampleEdge = iota
+	// ResampleRight is the end of a bin.
+	ResampleRight
+)
+
+// ResampleFill is how bins without rows are filled.
+type ResampleFill int
+
+const (
+	// ResampleFillNull fills bins without rows with nulls.
+	ResampleFillNull ResampleFill = iota
+	// ResampleFillForward fills bins without rows with the values of the
+	// previous bin.
+	ResampleFillForward
+)
+
+// maxResampleBins is the largest number of bins a resampled DataFrame may
+// have.
+const maxResampleBins = 1 << 24
+
+// ResampleOption configures how a DataFrame is resampled.
+type ResampleOption func(*resampleConfig)
+
+type resampleConfig struct {
+	label  ResampleEdge
+	closed ResampleEdge
+	fill   ResampleFill
+}
+
+func newResampleConfig(opts ...ResampleOption) *resampleConfig {
+	cfg := &resampleConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	return cfg
+}
+
+// WithResampleLabel sets the edge of the bins used as their label. The default
+// is ResampleLeft.
+func WithResampleLabel(edge ResampleEdge) ResampleOption {
+	return func(cfg *resampleConfig) {
+		cfg.label = edge
+	}
+}
+
+// WithResampleClosed sets the edge of the bins which is included in them. With
+// ResampleLeft, the default, a bin holds times in [start, end) and with
+// ResampleRight in (start, end].
+func WithResampleClosed(edge ResampleEdge) ResampleOption {
+	return func(cfg *resampleConfig) {
+		cfg.closed = edge
+	}
+}
+
+// WithResampleFill sets how bins without rows are filled. The default is
+// ResampleFillNull. AggCount columns are never forward filled.
+func WithResampleFill(fill ResampleFill) ResampleOption {
+	return func(cfg *resampleConfig) {
+		cfg.fill = fill
+	}
+}
+
+// Resample buckets the rows of the DataFrame into bins of length freq by the
+// timeCol Date or Timestamp column, and aggregates each column in aggs over the
+// rows of every bin. Bins are aligned on the UNIX epoch and span from the bin
+// of the earliest time to the bin of the latest time. Rows with a null time are
+// ignored.
+//
+// The result has the timeCol column, holding the label of each bin, followed by
+// the aggregated columns in the order of the DataFrame. Columns without an
+// aggregation are dropped. AggSum, AggMean, AggMin and AggMax give Float64
+// columns and AggCount gives an Int64 column, while AggFirst and AggLast keep
+// the type of the column. Aggregations skip nulls, and give null for a bin
+// without non null values except AggCount which gives 0.
+//
+// A panic is triggered if timeCol is not a Date or Timestamp column, freq is
+// not positive, the bins from the earliest to the latest time are more than
+// 1 << 24, or a numeric aggregation is used on a non numeric column.
+func (df DataFrame) Resample(timeCol string, freq time.Duration, aggs map[string]Aggregation, opts ...ResampleOption) DataFrame {
+	df.Retain()
+	defer df.Release()
+
+	cfg := newResampleConfig(opts...)
+	if freq <= 0 {
+		panic("dataframe: resample: freq must be positive")
+	}
+	timeSeries := df.SeriesByName(timeCol)
+	nanos, fromNanos := resampleNanos(df.pool, timeSeries)
+	if nanos == nil {
+		panic(fmt.Sprintf("dataframe: resample: column %q is not a date or timestamp", timeCol))
+	}
+
+	// NOTE: with closed right edges a bin holds (start, end], so a time on the
+	// start of a bin belongs to the previous bin.
+	binOf := func(t int64) int64 {
+		if cfg.closed == ResampleRight {
+			t--
+		}
+		return floorDiv(t, int64(freq))
+	}
+
+	groups := make(map[int64][]int)
+	for i := 0; i < timeSeries.Len(); i++ {
+		if timeSeries.IsNull(i) {
+			continue
+		}
+		bin := binOf(nanos(i))
+		groups[bin] = append(groups[bin], i)
+	}
+	keys := make([]int64, 0, len(groups))
+	for bin := range groups {
+		keys = append(keys, bin)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+	// NOTE: only the occupied bins hold rows, the bins between them exist in
+	// the result alone.
+	var bins resampleBins
+	var first int64
+	if len(keys) > 0 {
+		first = keys[0]
+		span := uint64(keys[len(keys)-1]) - uint64(first)
+		if span >= maxResampleBins {
+			panic(fmt.Sprintf("dataframe: resample: %d bins of %s exceed the limit of %d", span+1, freq, maxResampleBins))
+		}
+		bins.n = int(span) + 1
+	}
+	bins.index = make([]int, len(keys))
+	bins.rows = make([][]int, len(keys))
+	for g, bin := range keys {
+		bins.index[g] = int(bin - first)
+		bins.rows[g] = groups[bin]
+	}
+
+	labels := make([]int64, bins.n)
+	for k := range labels {
+		bin := first + int64(k)
+		if cfg.label == ResampleRight {
+			bin++
+		}
+		labels[k] = bin * int64(freq)
+	}
+	labelSeries := fromNanos(labels)
+	defer labelSeries.Release()
+
+	ss := []series.Series{labelSeries}
+	for _, s := range df.series {
+		agg, ok := aggs[s.Name()]
+		if !ok || s.Name() == timeCol {
+			continue
+		}
+		res := resampleAggregate(df.pool, s, agg, bins, cfg.fill)
+		defer res.Release()
+		ss = append(ss, res)
+	}
+
+	return NewFromSeries(df.pool, ss)
+}
+
+// resampleNanos returns a function which returns the value at position i of a
+// Date or Timestamp Series as nanoseconds since the UNIX epoch, and a function
+// which creates a Series of the same field from nanoseconds. Nil functions are
+// returned for other Series.
+func resampleNanos(pool memory.Allocator, s series.Series) (func(int) int64, func([]int64) series.Series) {
+	field := s.Field()
+
+	var scale int64
+	var nanos func(int) int64
+	switch a := s.Interface.(type) {
+	case *array.Date32:
+		scale = int64(24 * time.Hour)
+		nanos = func(i int) int64 { return int64(a.Value(i)) * scale }
+	case *array.Date64:
+		scale = int64(time.Millisecond)
+		nanos = func(i int) int64 { return int64(a.Value(i)) * scale }
+	case *array.Timestamp:
+		scale = int64(series.UnitDuration(field.Type.(*arrow.TimestampType).Unit))
+		nanos = func(i int) int64 { return int64(a.Value(i)) * scale }
+	default:
+		return nil, nil
+	}
+
+	fromNanos := func(vals []int64) series.Series {
+		switch field.Type.ID() {
+		case arrow.DATE32:
+			res := make([]arrow.Date32, len(vals))
+			for i, v := range vals {
+				res[i] = arrow.Date32(floorDiv(v, scale))
+			}
+			return series.FromDate32(pool, field, res, nil)
+		case arrow.DATE64:
+			res := make([]arrow.Date64, len(vals))
+			for i, v := range vals {
+				res[i] = arrow.Date64(floorDiv(v, scale))
+			}
+			return series.FromDate64(pool, field, res, nil)
+		default:
+			res := make([]arrow.Timestamp, len(vals))
+			for i, v := range vals {
+				res[i] = arrow.Timestamp(floorDiv(v, scale))
+			}
+			return series.FromTimestamp(pool, field, res, nil)
+		}
+	}
+
+	return nanos, fromNanos
+}
+
+// resampleBins are the bins of a resampled DataFrame. Only the bins holding
+// rows are listed, each with its position in the n bins of the result.
+type resampleBins struct {
+	n     int
+	index []int
+	rows  [][]int
+}
+
+// forwardFill calls fill with the position of every bin without rows and the
+// position of the previous bin with rows.
+func (bins resampleBins) forwardFill(fill func(dst, src int)) {
+	for g := 1; g < len(bins.index); g++ {
+		for k := bins.index[g-1] + 1; k < bins.index[g]; k++ {
+			fill(k, bins.index[g-1])
+		}
+	}
+}
+
+// resampleAggregate aggregates the values of the s Series at the rows of every
+// bin.
+func resampleAggregate(pool memory.Allocator, s series.Series, agg Aggregation, bins resampleBins, fill ResampleFill) series.Series {
+	field := s.Field()
+
+	switch agg {
+	case AggCount:
+		vals := make([]int64, bins.n)
+		for g, rows := range bins.rows {
+			for _, i := range rows {
+				if s.IsValid(i) {
+					vals[bins.index[g]]++
+				}
+			}
+		}
+		field.Type = arrow.PrimitiveTypes.Int64
+		return series.FromInt64(pool, field, vals, nil)
+	case AggFirst, AggLast:
+		indices := make([]int, bins.n)
+		for k := range indices {
+			indices[k] = -1
+		}
+		for g, rows := range bins.rows {
+			for j := range rows {
+				i := rows[j]
+				if agg == AggLast {
+					i = rows[len(rows)-1-j]
+				}
+				if s.IsValid(i) {
+					indices[bins.index[g]] = i
+					break
+				}
+			}
+		}
+		if fill == ResampleFillForward {
+			bins.forwardFill(func(dst, src int) {
+				indices[dst] = indices[src]
+			})
+		}
+		return s.Take(indices)
+	case AggSum, AggMean, AggMin, AggMax:
+		if !isNumeric(field.Type) {
+			panic(fmt.Sprintf("dataframe: resample: column %q is not numeric", field.Name))
+		}
+	default:
+		panic(fmt.Sprintf("dataframe: resample: unknown aggregation %d", agg))
+	}
+
+	vals := make([]float64, bins.n)
+	valid := make([]bool, bins.n)
+	for g, rows := range bins.rows {
+		var n int
+		var res float64
+		for _, i := range rows {
+			if s.IsNull(i) {
+				continue
+			}
+			v := s.AtVec(i)
+			switch {
+			case n == 0:
+				res = v
+			case agg == AggSum || agg == AggMean:
+				res += v
+			case agg == AggMin:
+				res = math.Min(res, v)
+			case agg == AggMax:
+				res = math.Max(res, v)
+			}
+			n++
+		}
+		if n == 0 {
+			continue
+		}
+		if agg == AggMean {
+			res /= float64(n)
+		}
+		vals[bins.index[g]] = res
+		valid[bins.index[g]] = true
+	}
+	if fill == ResampleFillForward {
+		bins.forwardFill(func(dst, src int) {
+			vals[dst] = vals[src]
+			valid[dst] = valid[src]
+		})
+	}
+
+	field.Type = arrow.PrimitiveTypes.Float64
+	return series.FromFloat64(pool, field, vals, valid)
+}
+
+// isNumeric reports whether t is an integer or float type.
+func isNumeric(t arrow.DataType) bool {
+	switch t.ID() {
+	case arrow.INT8, arrow.INT16, arrow.INT32, arrow.INT64,
+		arrow.UINT8, arrow.UINT16, arrow.UINT32, arrow.UINT64,
+		arrow.FLOAT32, arrow.FLOAT64:
+		return true
+	}
+	return false
+}
+
+// floorDiv returns a divided by b rounded towards negative infinity.
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
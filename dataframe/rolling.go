@@ -0,0 +1,128 @@
+package dataframe
+
+import (
+	"time"
+
+	"github.com/poopoothegorilla/fastframe/series"
+)
+
+// Rolling is a moving window over the rows of a DataFrame, created by
+// DataFrame.Rolling or DataFrame.RollingTime. Its aggregations return a
+// DataFrame with every numeric column replaced by the aggregation of its
+// windows, as in series.Rolling, and every other column kept as is. A Rolling
+// uses the DataFrame it was created from, which must not be released before
+// the Rolling is used.
+type Rolling struct {
+	df         DataFrame
+	window     int
+	minPeriods int
+
+	// NOTE: timeCol is the column holding the time of every row for windows
+	// spanning a duration, and is empty for windows of a number of rows.
+	timeCol string
+	span    time.Duration
+}
+
+// Rolling returns a moving window over the last window rows of the DataFrame,
+// as in series.Series.Rolling.
+func (df DataFrame) Rolling(window, minPeriods int) Rolling {
+	if window <= 0 {
+		panic("dataframe: rolling: window must be positive")
+	}
+
+	return Rolling{
+		df:         df,
+		window:     window,
+		minPeriods: minPeriods,
+	}
+}
+
+// RollingTime returns a moving window over the rows of the DataFrame whose
+// time in the timeCol Date or Timestamp column is within the window duration
+// up to and including the time of each row, as in
+// series.Series.RollingTime.
+func (df DataFrame) RollingTime(timeCol string, window time.Duration, minPeriods int) Rolling {
+	if window <= 0 {
+		panic("dataframe: rolling_time: window must be positive")
+	}
+	// NOTE: panics early for a missing column.
+	df.SeriesByName(timeCol)
+
+	return Rolling{
+		df:         df,
+		minPeriods: minPeriods,
+		timeCol:    timeCol,
+		span:       window,
+	}
+}
+
+// Sum returns the sums of the windows.
+func (r Rolling) Sum() DataFrame {
+	return r.apply(series.Rolling.Sum)
+}
+
+// Mean returns the means of the windows.
+func (r Rolling) Mean() DataFrame {
+	return r.apply(series.Rolling.Mean)
+}
+
+// STD returns the sample standard deviations of the windows.
+func (r Rolling) STD() DataFrame {
+	return r.apply(series.Rolling.STD)
+}
+
+// Min returns the minimums of the windows.
+func (r Rolling) Min() DataFrame {
+	return r.apply(series.Rolling.Min)
+}
+
+// Max returns the maximums of the windows.
+func (r Rolling) Max() DataFrame {
+	return r.apply(series.Rolling.Max)
+}
+
+// Median returns the medians of the windows.
+func (r Rolling) Median() DataFrame {
+	return r.apply(series.Rolling.Median)
+}
+
+// Apply returns the results of fn for the valid values of the windows, as in
+// series.Rolling.Apply.
+func (r Rolling) Apply(fn func(vals []float64) float64) DataFrame {
+	return r.apply(func(sr series.Rolling) series.Series {
+		return sr.Apply(fn)
+	})
+}
+
+// apply returns a DataFrame with every numeric column replaced by the result
+// of fn for its windows.
+func (r Rolling) apply(fn func(series.Rolling) series.Series) DataFrame {
+	df := r.df
+	df.Retain()
+	defer df.Release()
+
+	var times series.Series
+	if r.timeCol != "" {
+		times = df.SeriesByName(r.timeCol)
+	}
+
+	ss := make([]series.Series, len(df.series))
+	for i, col := range df.series {
+		if !isNumeric(col.DataType()) {
+			ss[i] = col
+			continue
+		}
+
+		var sr series.Rolling
+		if r.timeCol != "" {
+			sr = col.RollingTime(times, r.span, r.minPeriods)
+		} else {
+			sr = col.Rolling(r.window, r.minPeriods)
+		}
+		s := fn(sr)
+		defer s.Release()
+		ss[i] = s
+	}
+
+	return NewFromSeries(df.pool, ss)
+}
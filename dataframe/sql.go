@@ -0,0 +1,556 @@
+package dataframe
+
+import (
+	"database/sql"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/poopoothegorilla/fastframe/series"
+)
+
+// sqlBatchSize is the default number of rows inserted by each INSERT statement.
+const sqlBatchSize = 500
+
+// SQLPlaceholder is the style of the bind parameters used in SQL statements.
+type SQLPlaceholder int
+
+const (
+	// SQLQuestion uses ? placeholders, as in SQLite and MySQL.
+	SQLQuestion SQLPlaceholder = iota
+	// SQLDollar uses numbered $1 placeholders, as in Postgres.
+	SQLDollar
+)
+
+// SQLOption configures how a DataFrame is written to a database.
+type SQLOption func(*sqlConfig)
+
+type sqlConfig struct {
+	batchSize   int
+	placeholder SQLPlaceholder
+}
+
+func newSQLConfig(opts ...SQLOption) *sqlConfig {
+	cfg := &sqlConfig{
+		batchSize: sqlBatchSize,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	return cfg
+}
+
+// WithSQLBatchSize sets the number of rows inserted by each INSERT statement.
+func WithSQLBatchSize(n int) SQLOption {
+	return func(cfg *sqlConfig) {
+		cfg.batchSize = n
+	}
+}
+
+// WithSQLPlaceholder sets the style of the bind parameters. The default is
+// SQLQuestion.
+func WithSQLPlaceholder(p SQLPlaceholder) SQLOption {
+	return func(cfg *sqlConfig) {
+		cfg.placeholder = p
+	}
+}
+
+var (
+	sqlNullInt64Type   = reflect.TypeOf(sql.NullInt64{})
+	sqlNullInt32Type   = reflect.TypeOf(sql.NullInt32{})
+	sqlNullFloat64Type = reflect.TypeOf(sql.NullFloat64{})
+	sqlNullBoolType    = reflect.TypeOf(sql.NullBool{})
+	sqlNullStringType  = reflect.TypeOf(sql.NullString{})
+	sqlNullTimeType    = reflect.TypeOf(sql.NullTime{})
+	sqlRawBytesType    = reflect.TypeOf(sql.RawBytes{})
+	sqlBytesType       = reflect.TypeOf([]byte{})
+)
+
+// NewFromSQLRows creates a DataFrame from the rows of a query. All rows are
+// read, leaving rows closed.
+//
+// The type of each column is resolved from the scan type reported by the
+// driver, falling back to the database type name when the driver does not
+// report one:
+//
+//	int8, int16, int32                    -> Int8, Int16, Int32
+//	int, int64, INTEGER, BIGINT, ...      -> Int64
+//	uint8, uint16, uint32                 -> Uint8, Uint16, Uint32
+//	uint, uint64, BIGINT UNSIGNED         -> Uint64
+//	float32                               -> Float32
+//	float64, REAL, DOUBLE, ...            -> Float64
+//	NUMERIC, DECIMAL                      -> Decimal128, or Float64 without
+//	                                         a precision and scale
+//	bool, BOOLEAN                         -> Boolean
+//	time.Time, TIMESTAMP, DATETIME        -> Timestamp
+//	DATE                                  -> Date32
+//	anything else                         -> String
+//
+// Values are scanned into the sql.Null types and NULLs are stored as nulls. A
+// panic is triggered if the rows can not be read or scanned, or if a value
+// does not fit the type of its column.
+func NewFromSQLRows(pool memory.Allocator, rows *sql.Rows) DataFrame {
+	defer rows.Close()
+
+	colTypes, err := rows.ColumnTypes()
+	if err != nil {
+		panic(fmt.Sprintf("dataframe: new_from_sql_rows: %s", err))
+	}
+
+	cols := make([]sqlColumn, len(colTypes))
+	defer func() {
+		for _, c := range cols {
+			if c.b != nil {
+				c.b.Release()
+			}
+		}
+	}()
+	fields := make([]arrow.Field, len(colTypes))
+	dest := make([]interface{}, len(colTypes))
+	for i, ct := range colTypes {
+		typ := sqlArrowType(ct)
+		nullable, ok := ct.Nullable()
+		fields[i] = arrow.Field{Name: ct.Name(), Type: typ, Nullable: nullable || !ok}
+		cols[i] = newSQLColumn(pool, typ)
+		dest[i] = cols[i].dest
+	}
+
+	for rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			panic(fmt.Sprintf("dataframe: new_from_sql_rows: %s", err))
+		}
+		for i, c := range cols {
+			if err := c.append(); err != nil {
+				panic(fmt.Sprintf("dataframe: new_from_sql_rows: column %q: %s", fields[i].Name, err))
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		panic(fmt.Sprintf("dataframe: new_from_sql_rows: %s", err))
+	}
+
+	ss := make([]series.Series, len(cols))
+	for i, c := range cols {
+		ss[i] = series.FromArrow(pool, fields[i], c.b.NewArray())
+	}
+	defer func() {
+		for _, s := range ss {
+			s.Release()
+		}
+	}()
+
+	return NewFromSeries(pool, ss)
+}
+
+// sqlArrowType returns the Arrow type used for a column of a query.
+func sqlArrowType(ct *sql.ColumnType) arrow.DataType {
+	switch t := ct.ScanType(); {
+	case t == nil:
+	case t == sqlNullInt32Type:
+		return arrow.PrimitiveTypes.Int32
+	case t == sqlNullInt64Type:
+		return arrow.PrimitiveTypes.Int64
+	case t == sqlNullFloat64Type:
+		return arrow.PrimitiveTypes.Float64
+	case t == sqlNullBoolType:
+		return arrow.FixedWidthTypes.Boolean
+	case t == sqlNullTimeType, t == timeType:
+		return &arrow.TimestampType{Unit: arrow.Nanosecond, TimeZone: "UTC"}
+	case t == sqlNullStringType, t == sqlRawBytesType, t == sqlBytesType:
+		return arrow.BinaryTypes.String
+	default:
+		switch t.Kind() {
+		case reflect.Int8:
+			return arrow.PrimitiveTypes.Int8
+		case reflect.Int16:
+			return arrow.PrimitiveTypes.Int16
+		case reflect.Int32:
+			return arrow.PrimitiveTypes.Int32
+		case reflect.Int, reflect.Int64:
+			return arrow.PrimitiveTypes.Int64
+		case reflect.Uint8:
+			return arrow.PrimitiveTypes.Uint8
+		case reflect.Uint16:
+			return arrow.PrimitiveTypes.Uint16
+		case reflect.Uint32:
+			return arrow.PrimitiveTypes.Uint32
+		case reflect.Uint, reflect.Uint64:
+			return arrow.PrimitiveTypes.Uint64
+		case reflect.Float32:
+			return arrow.PrimitiveTypes.Float32
+		case reflect.Float64:
+			return arrow.PrimitiveTypes.Float64
+		case reflect.Bool:
+			return arrow.FixedWidthTypes.Boolean
+		case reflect.String:
+			return arrow.BinaryTypes.String
+		}
+	}
+
+	name := strings.ToUpper(ct.DatabaseTypeName())
+	if i := strings.IndexByte(name, '('); i >= 0 {
+		name = name[:i]
+	}
+	switch name {
+	case "INT", "INTEGER", "BIGINT", "SMALLINT", "TINYINT", "INT2", "INT4", "INT8", "SERIAL", "BIGSERIAL":
+		return arrow.PrimitiveTypes.Int64
+	case "BIGINT UNSIGNED", "UNSIGNED BIGINT", "UNSIGNED BIG INT":
+		return arrow.PrimitiveTypes.Uint64
+	case "NUMERIC", "DECIMAL":
+		if precision, scale, ok := ct.DecimalSize(); ok && precision > 0 && precision <= 38 && scale >= 0 && scale <= precision {
+			return &arrow.Decimal128Type{Precision: int32(precision), Scale: int32(scale)}
+		}
+		return arrow.PrimitiveTypes.Float64
+	case "REAL", "FLOAT", "FLOAT4", "FLOAT8", "DOUBLE", "DOUBLE PRECISION":
+		return arrow.PrimitiveTypes.Float64
+	case "BOOL", "BOOLEAN":
+		return arrow.FixedWidthTypes.Boolean
+	case "DATE":
+		return arrow.FixedWidthTypes.Date32
+	case "DATETIME", "TIMESTAMP", "TIMESTAMPTZ":
+		return &arrow.TimestampType{Unit: arrow.Nanosecond, TimeZone: "UTC"}
+	}
+
+	return arrow.BinaryTypes.String
+}
+
+// sqlColumn scans the values of a column into a sql.Null type and appends them
+// to a builder.
+type sqlColumn struct {
+	typ  arrow.DataType
+	b    array.Builder
+	dest interface{}
+}
+
+func newSQLColumn(pool memory.Allocator, t arrow.DataType) sqlColumn {
+	c := sqlColumn{typ: t}
+	switch t := t.(type) {
+	case *arrow.Int8Type:
+		c.b, c.dest = array.NewInt8Builder(pool), &sql.NullInt32{}
+	case *arrow.Int16Type:
+		c.b, c.dest = array.NewInt16Builder(pool), &sql.NullInt32{}
+	case *arrow.Int32Type:
+		c.b, c.dest = array.NewInt32Builder(pool), &sql.NullInt32{}
+	case *arrow.Uint8Type:
+		c.b, c.dest = array.NewUint8Builder(pool), &sqlNullUint64{bitSize: 8}
+	case *arrow.Uint16Type:
+		c.b, c.dest = array.NewUint16Builder(pool), &sqlNullUint64{bitSize: 16}
+	case *arrow.Uint32Type:
+		c.b, c.dest = array.NewUint32Builder(pool), &sqlNullUint64{bitSize: 32}
+	case *arrow.Uint64Type:
+		c.b, c.dest = array.NewUint64Builder(pool), &sqlNullUint64{bitSize: 64}
+	case *arrow.Int64Type:
+		c.b, c.dest = array.NewInt64Builder(pool), &sql.NullInt64{}
+	case *arrow.Float32Type:
+		c.b, c.dest = array.NewFloat32Builder(pool), &sql.NullFloat64{}
+	case *arrow.Float64Type:
+		c.b, c.dest = array.NewFloat64Builder(pool), &sql.NullFloat64{}
+	case *arrow.BooleanType:
+		c.b, c.dest = array.NewBooleanBuilder(pool), &sql.NullBool{}
+	case *arrow.TimestampType:
+		c.b, c.dest = array.NewTimestampBuilder(pool, t), &sql.NullTime{}
+	case *arrow.Date32Type:
+		c.b, c.dest = array.NewDate32Builder(pool), &sql.NullTime{}
+	case *arrow.Decimal128Type:
+		c.b, c.dest = array.NewDecimal128Builder(pool, t), &sql.NullString{}
+	default:
+		c.b, c.dest = array.NewStringBuilder(pool), &sql.NullString{}
+	}
+
+	return c
+}
+
+// append appends the last scanned value to the builder. An error is returned
+// if the value does not fit the builder.
+func (c sqlColumn) append() error {
+	switch d := c.dest.(type) {
+	case *sql.NullInt32:
+		if !d.Valid {
+			c.b.AppendNull()
+			return nil
+		}
+		switch b := c.b.(type) {
+		case *array.Int8Builder:
+			if d.Int32 < math.MinInt8 || d.Int32 > math.MaxInt8 {
+				return fmt.Errorf("value %d out of range of int8", d.Int32)
+			}
+			b.Append(int8(d.Int32))
+		case *array.Int16Builder:
+			if d.Int32 < math.MinInt16 || d.Int32 > math.MaxInt16 {
+				return fmt.Errorf("value %d out of range of int16", d.Int32)
+			}
+			b.Append(int16(d.Int32))
+		case *array.Int32Builder:
+			b.Append(d.Int32)
+		}
+	case *sql.NullInt64:
+		if !d.Valid {
+			c.b.AppendNull()
+			return nil
+		}
+		c.b.(*array.Int64Builder).Append(d.Int64)
+	case *sqlNullUint64:
+		if !d.Valid {
+			c.b.AppendNull()
+			return nil
+		}
+		switch b := c.b.(type) {
+		case *array.Uint8Builder:
+			b.Append(uint8(d.Uint64))
+		case *array.Uint16Builder:
+			b.Append(uint16(d.Uint64))
+		case *array.Uint32Builder:
+			b.Append(uint32(d.Uint64))
+		case *array.Uint64Builder:
+			b.Append(d.Uint64)
+		}
+	case *sql.NullFloat64:
+		if !d.Valid {
+			c.b.AppendNull()
+			return nil
+		}
+		switch b := c.b.(type) {
+		case *array.Float32Builder:
+			b.Append(float32(d.Float64))
+		case *array.Float64Builder:
+			b.Append(d.Float64)
+		}
+	case *sql.NullBool:
+		if !d.Valid {
+			c.b.AppendNull()
+			return nil
+		}
+		c.b.(*array.BooleanBuilder).Append(d.Bool)
+	case *sql.NullTime:
+		if !d.Valid {
+			c.b.AppendNull()
+			return nil
+		}
+		switch b := c.b.(type) {
+		case *array.TimestampBuilder:
+			b.Append(arrow.Timestamp(d.Time.UnixNano()))
+		case *array.Date32Builder:
+			b.Append(series.TimeToDate32(d.Time))
+		}
+	case *sql.NullString:
+		if !d.Valid {
+			c.b.AppendNull()
+			return nil
+		}
+		switch b := c.b.(type) {
+		case *array.Decimal128Builder:
+			v, err := series.ParseDecimal(d.String, c.typ.(*arrow.Decimal128Type))
+			if err != nil {
+				return err
+			}
+			b.Append(v)
+		case *array.StringBuilder:
+			b.Append(d.String)
+		}
+	}
+
+	return nil
+}
+
+// sqlNullUint64 is an unsigned integer which may be NULL, as there is no
+// sql.Null type for them. Values must fit in bitSize bits.
+type sqlNullUint64 struct {
+	Uint64  uint64
+	Valid   bool
+	bitSize int
+}
+
+// Scan implements the sql.Scanner interface.
+func (n *sqlNullUint64) Scan(src interface{}) error {
+	n.Uint64, n.Valid = 0, src != nil
+
+	var v uint64
+	switch src := src.(type) {
+	case nil:
+		return nil
+	case int64:
+		if src < 0 {
+			return fmt.Errorf("converting %d to uint%d: value out of range", src, n.bitSize)
+		}
+		v = uint64(src)
+	case uint64:
+		v = src
+	case []byte:
+		return n.parse(string(src))
+	case string:
+		return n.parse(src)
+	default:
+		return fmt.Errorf("unsupported scan of %T into uint%d", src, n.bitSize)
+	}
+	if n.bitSize < 64 && v>>uint(n.bitSize) != 0 {
+		return fmt.Errorf("converting %d to uint%d: value out of range", v, n.bitSize)
+	}
+	n.Uint64 = v
+
+	return nil
+}
+
+func (n *sqlNullUint64) parse(s string) error {
+	v, err := strconv.ParseUint(s, 10, n.bitSize)
+	if err != nil {
+		return fmt.Errorf("converting %q to uint%d: %w", s, n.bitSize, err)
+	}
+	n.Uint64 = v
+
+	return nil
+}
+
+// WriteSQL inserts the rows of the DataFrame into the table of db, which must
+// already exist with a column for each Series. Rows are inserted in batches by
+// multi row INSERT statements inside a single transaction, which is rolled back
+// if any statement fails.
+//
+// Nulls are inserted as NULL, timestamps and dates as time.Time values and
+// decimals as strings with all the fractional digits of their scale. Uint64
+// values above math.MaxInt64 are only accepted by drivers which convert them.
+func (df DataFrame) WriteSQL(db *sql.DB, table string, opts ...SQLOption) error {
+	df.Retain()
+	defer df.Release()
+
+	cfg := newSQLConfig(opts...)
+	if cfg.batchSize <= 0 {
+		return fmt.Errorf("dataframe: write_sql: invalid batch size %d", cfg.batchSize)
+	}
+
+	values := make([]func(int) interface{}, len(df.series))
+	for i, s := range df.series {
+		v, err := newSQLValue(s)
+		if err != nil {
+			return fmt.Errorf("dataframe: write_sql: column %q: %w", s.Name(), err)
+		}
+		values[i] = v
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("dataframe: write_sql: %w", err)
+	}
+
+	numRows := int(df.NumRows())
+	for start := 0; start < numRows; start += cfg.batchSize {
+		end := start + cfg.batchSize
+		if end > numRows {
+			end = numRows
+		}
+
+		args := make([]interface{}, 0, (end-start)*len(values))
+		for i := start; i < end; i++ {
+			for j, value := range values {
+				if df.series[j].IsNull(i) {
+					args = append(args, nil)
+					continue
+				}
+				args = append(args, value(i))
+			}
+		}
+		if _, err := tx.Exec(df.sqlInsert(table, end-start, cfg.placeholder), args...); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("dataframe: write_sql: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("dataframe: write_sql: %w", err)
+	}
+
+	return nil
+}
+
+// sqlInsert returns an INSERT statement into table for n rows.
+func (df DataFrame) sqlInsert(table string, n int, placeholder SQLPlaceholder) string {
+	var sb strings.Builder
+	sb.WriteString("INSERT INTO ")
+	sb.WriteString(sqlQuote(table))
+	sb.WriteString(" (")
+	for i, s := range df.series {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(sqlQuote(s.Name()))
+	}
+	sb.WriteString(") VALUES ")
+
+	param := 1
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteByte('(')
+		for j := range df.series {
+			if j > 0 {
+				sb.WriteString(", ")
+			}
+			if placeholder == SQLDollar {
+				sb.WriteByte('$')
+				sb.WriteString(strconv.Itoa(param))
+			} else {
+				sb.WriteByte('?')
+			}
+			param++
+		}
+		sb.WriteByte(')')
+	}
+
+	return sb.String()
+}
+
+// sqlQuote quotes an SQL identifier.
+func sqlQuote(name string) string {
+	return `"` + strings.Replace(name, `"`, `""`, -1) + `"`
+}
+
+// newSQLValue returns a function which returns the value at position i of the
+// s Series as an SQL argument.
+func newSQLValue(s series.Series) (func(int) interface{}, error) {
+	switch a := s.Interface.(type) {
+	case *array.Int8:
+		return func(i int) interface{} { return a.Value(i) }, nil
+	case *array.Int16:
+		return func(i int) interface{} { return a.Value(i) }, nil
+	case *array.Int32:
+		return func(i int) interface{} { return a.Value(i) }, nil
+	case *array.Uint8:
+		return func(i int) interface{} { return a.Value(i) }, nil
+	case *array.Uint16:
+		return func(i int) interface{} { return a.Value(i) }, nil
+	case *array.Uint32:
+		return func(i int) interface{} { return a.Value(i) }, nil
+	case *array.Uint64:
+		return func(i int) interface{} { return a.Value(i) }, nil
+	case *array.Int64:
+		return func(i int) interface{} { return a.Value(i) }, nil
+	case *array.Float32:
+		return func(i int) interface{} { return a.Value(i) }, nil
+	case *array.Float64:
+		return func(i int) interface{} { return a.Value(i) }, nil
+	case *array.Boolean:
+		return func(i int) interface{} { return a.Value(i) }, nil
+	case *array.String:
+		return func(i int) interface{} { return a.Value(i) }, nil
+	case *array.Timestamp:
+		unit := s.DataType().(*arrow.TimestampType).Unit
+		return func(i int) interface{} {
+			return series.TimestampToTime(a.Value(i), unit)
+		}, nil
+	case *array.Date32:
+		return func(i int) interface{} { return series.Date32ToTime(a.Value(i)) }, nil
+	case *array.Date64:
+		return func(i int) interface{} { return series.Date64ToTime(a.Value(i)) }, nil
+	case *array.Decimal128:
+		scale := s.DataType().(*arrow.Decimal128Type).Scale
+		return func(i int) interface{} { return series.FormatDecimal(a.Value(i), scale) }, nil
+	default:
+		return nil, fmt.Errorf("unsupported type %s", s.DataType())
+	}
+}
@@ -0,0 +1,446 @@
+package dataframe
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/poopoothegorilla/fastframe/series"
+)
+
+// structTag is the struct tag key naming the column of a field.
+const structTag = "fastframe"
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// structField describes an exported struct field mapped to a column.
+type structField struct {
+	name  string
+	index int
+	// ptr is true for pointer fields, which are nullable.
+	ptr bool
+	typ reflect.Type
+}
+
+// structFields returns the fields of the struct type t which map to columns.
+// A field maps to the column named by its fastframe tag, or by its name if it
+// has no tag. Fields tagged with "-" and unexported fields are skipped.
+func structFields(t reflect.Type) []structField {
+	var fields []structField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+		name := f.Name
+		if tag, ok := f.Tag.Lookup(structTag); ok {
+			if tag == "-" {
+				continue
+			}
+			if tag != "" {
+				name = tag
+			}
+		}
+
+		sf := structField{name: name, index: i, typ: f.Type}
+		if f.Type.Kind() == reflect.Ptr {
+			sf.ptr = true
+			sf.typ = f.Type.Elem()
+		}
+		fields = append(fields, sf)
+	}
+
+	return fields
+}
+
+// structArrowType returns the Arrow type of the columns built from fields of
+// type t.
+func structArrowType(t reflect.Type) (arrow.DataType, error) {
+	if t == timeType {
+		return &arrow.TimestampType{Unit: arrow.Nanosecond, TimeZone: "UTC"}, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Int8:
+		return arrow.PrimitiveTypes.Int8, nil
+	case reflect.Int16:
+		return arrow.PrimitiveTypes.Int16, nil
+	case reflect.Int32:
+		return arrow.PrimitiveTypes.Int32, nil
+	case reflect.Int, reflect.Int64:
+		return arrow.PrimitiveTypes.Int64, nil
+	case reflect.Uint8:
+		return arrow.PrimitiveTypes.Uint8, nil
+	case reflect.Uint16:
+		return arrow.PrimitiveTypes.Uint16, nil
+	case reflect.Uint32:
+		return arrow.PrimitiveTypes.Uint32, nil
+	case reflect.Uint, reflect.Uint64:
+		return arrow.PrimitiveTypes.Uint64, nil
+	case reflect.Float32:
+		return arrow.PrimitiveTypes.Float32, nil
+	case reflect.Float64:
+		return arrow.PrimitiveTypes.Float64, nil
+	case reflect.Bool:
+		return arrow.FixedWidthTypes.Boolean, nil
+	case reflect.String:
+		return arrow.BinaryTypes.String, nil
+	}
+
+	return nil, fmt.Errorf("unsupported type %s", t)
+}
+
+// NewFromStructs creates a DataFrame from a slice of structs or of pointers to
+// structs, with a column per exported field.
+//
+// Columns are named by the fastframe struct tag of their field, or by the
+// field name if it has no tag, and fields tagged with "-" are skipped:
+//
+//	type User struct {
+//		ID    int64    `fastframe:"id"`
+//		Score *float64 `fastframe:"score"`
+//		Notes string   `fastframe:"-"`
+//	}
+//
+// Fields of kind int8, int16, int32, int, int64, uint8, uint16, uint32, uint,
+// uint64, float32, float64, bool and string, and time.Time fields, are
+// supported. Pointer fields build nullable columns where
+// nil pointers are stored as nulls. time.Time fields build Timestamp columns
+// in nanoseconds. A panic is triggered if structs is not a slice of structs or
+// a field has an unsupported type.
+func NewFromStructs(pool memory.Allocator, structs interface{}) DataFrame {
+	v := reflect.ValueOf(structs)
+	if v.Kind() != reflect.Slice {
+		panic(fmt.Sprintf("dataframe: new_from_structs: expected a slice of structs, got %T", structs))
+	}
+	elemType := v.Type().Elem()
+	elemPtr := elemType.Kind() == reflect.Ptr
+	if elemPtr {
+		elemType = elemType.Elem()
+	}
+	if elemType.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("dataframe: new_from_structs: expected a slice of structs, got %T", structs))
+	}
+
+	fields := structFields(elemType)
+	builders := make([]array.Builder, len(fields))
+	defer func() {
+		for _, b := range builders {
+			if b != nil {
+				b.Release()
+			}
+		}
+	}()
+	arrowFields := make([]arrow.Field, len(fields))
+	for i, f := range fields {
+		typ, err := structArrowType(f.typ)
+		if err != nil {
+			panic(fmt.Sprintf("dataframe: new_from_structs: field %q: %s", f.name, err))
+		}
+		arrowFields[i] = arrow.Field{Name: f.name, Type: typ, Nullable: f.ptr}
+		builders[i] = newStructBuilder(pool, typ)
+	}
+
+	for j := 0; j < v.Len(); j++ {
+		elem := v.Index(j)
+		if elemPtr {
+			if elem.IsNil() {
+				panic(fmt.Sprintf("dataframe: new_from_structs: element %d is nil", j))
+			}
+			elem = elem.Elem()
+		}
+		for i, f := range fields {
+			fv := elem.Field(f.index)
+			if f.ptr {
+				if fv.IsNil() {
+					builders[i].AppendNull()
+					continue
+				}
+				fv = fv.Elem()
+			}
+			appendStructValue(builders[i], fv)
+		}
+	}
+
+	ss := make([]series.Series, len(fields))
+	for i, b := range builders {
+		ss[i] = series.FromArrow(pool, arrowFields[i], b.NewArray())
+	}
+	defer func() {
+		for _, s := range ss {
+			s.Release()
+		}
+	}()
+
+	return NewFromSeries(pool, ss)
+}
+
+// newStructBuilder returns a builder for a column of type t, as returned by
+// structArrowType.
+func newStructBuilder(pool memory.Allocator, t arrow.DataType) array.Builder {
+	switch t := t.(type) {
+	case *arrow.Int8Type:
+		return array.NewInt8Builder(pool)
+	case *arrow.Int16Type:
+		return array.NewInt16Builder(pool)
+	case *arrow.Int32Type:
+		return array.NewInt32Builder(pool)
+	case *arrow.Int64Type:
+		return array.NewInt64Builder(pool)
+	case *arrow.Uint8Type:
+		return array.NewUint8Builder(pool)
+	case *arrow.Uint16Type:
+		return array.NewUint16Builder(pool)
+	case *arrow.Uint32Type:
+		return array.NewUint32Builder(pool)
+	case *arrow.Uint64Type:
+		return array.NewUint64Builder(pool)
+	case *arrow.Float32Type:
+		return array.NewFloat32Builder(pool)
+	case *arrow.Float64Type:
+		return array.NewFloat64Builder(pool)
+	case *arrow.BooleanType:
+		return array.NewBooleanBuilder(pool)
+	case *arrow.TimestampType:
+		return array.NewTimestampBuilder(pool, t)
+	default:
+		return array.NewStringBuilder(pool)
+	}
+}
+
+// appendStructValue appends the field value v to the builder b.
+func appendStructValue(b array.Builder, v reflect.Value) {
+	switch b := b.(type) {
+	case *array.Int8Builder:
+		b.Append(int8(v.Int()))
+	case *array.Int16Builder:
+		b.Append(int16(v.Int()))
+	case *array.Int32Builder:
+		b.Append(int32(v.Int()))
+	case *array.Int64Builder:
+		b.Append(v.Int())
+	case *array.Uint8Builder:
+		b.Append(uint8(v.Uint()))
+	case *array.Uint16Builder:
+		b.Append(uint16(v.Uint()))
+	case *array.Uint32Builder:
+		b.Append(uint32(v.Uint()))
+	case *array.Uint64Builder:
+		b.Append(v.Uint())
+	case *array.Float32Builder:
+		b.Append(float32(v.Float()))
+	case *array.Float64Builder:
+		b.Append(v.Float())
+	case *array.BooleanBuilder:
+		b.Append(v.Bool())
+	case *array.TimestampBuilder:
+		b.Append(arrow.Timestamp(v.Interface().(time.Time).UnixNano()))
+	case *array.StringBuilder:
+		b.Append(v.String())
+	}
+}
+
+// ToStructs fills dst, a pointer to a slice of structs or of pointers to
+// structs, with a struct per row of the DataFrame. The slice is replaced.
+//
+// Fields are matched to columns by name as in NewFromStructs. Fields without a
+// matching column are left as their zero value. Nulls are stored as nil in
+// pointer fields and as the zero value in other fields. Integer columns can be
+// stored in any integer or float field and float columns in any float field.
+// An error is returned if an integer value overflows its field.
+func (df DataFrame) ToStructs(dst interface{}) error {
+	df.Retain()
+	defer df.Release()
+
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("dataframe: to_structs: expected a pointer to a slice of structs, got %T", dst)
+	}
+	sliceType := v.Elem().Type()
+	elemType := sliceType.Elem()
+	elemPtr := elemType.Kind() == reflect.Ptr
+	if elemPtr {
+		elemType = elemType.Elem()
+	}
+	if elemType.Kind() != reflect.Struct {
+		return fmt.Errorf("dataframe: to_structs: expected a pointer to a slice of structs, got %T", dst)
+	}
+
+	var fields []structField
+	var cols []series.Series
+	var setters []func(reflect.Value, int) error
+	for _, f := range structFields(elemType) {
+		if !df.HasSeries(f.name) {
+			continue
+		}
+		s := df.SeriesByName(f.name)
+		set, err := newStructSetter(s, f.typ)
+		if err != nil {
+			return fmt.Errorf("dataframe: to_structs: field %q: %w", f.name, err)
+		}
+		fields = append(fields, f)
+		cols = append(cols, s)
+		setters = append(setters, set)
+	}
+
+	numRows := int(df.NumRows())
+	out := reflect.MakeSlice(sliceType, numRows, numRows)
+	for j := 0; j < numRows; j++ {
+		elem := out.Index(j)
+		if elemPtr {
+			elem.Set(reflect.New(elemType))
+			elem = elem.Elem()
+		}
+		for i, f := range fields {
+			if cols[i].IsNull(j) {
+				continue
+			}
+			fv := elem.Field(f.index)
+			if f.ptr {
+				fv.Set(reflect.New(f.typ))
+				fv = fv.Elem()
+			}
+			if err := setters[i](fv, j); err != nil {
+				return fmt.Errorf("dataframe: to_structs: field %q: row %d: %w", f.name, j, err)
+			}
+		}
+	}
+	v.Elem().Set(out)
+
+	return nil
+}
+
+// newStructSetter returns a function which stores the value at position i of
+// the s Series in a field of type t.
+func newStructSetter(s series.Series, t reflect.Type) (func(reflect.Value, int) error, error) {
+	kind := t.Kind()
+	isFloat := kind == reflect.Float32 || kind == reflect.Float64
+
+	var set func(reflect.Value, int) error
+	switch a := s.Interface.(type) {
+	case *array.Int8:
+		set = intStructSetter(kind, func(i int) int64 { return int64(a.Value(i)) })
+	case *array.Int16:
+		set = intStructSetter(kind, func(i int) int64 { return int64(a.Value(i)) })
+	case *array.Int32:
+		set = intStructSetter(kind, func(i int) int64 { return int64(a.Value(i)) })
+	case *array.Int64:
+		set = intStructSetter(kind, a.Value)
+	case *array.Uint8:
+		set = uintStructSetter(kind, func(i int) uint64 { return uint64(a.Value(i)) })
+	case *array.Uint16:
+		set = uintStructSetter(kind, func(i int) uint64 { return uint64(a.Value(i)) })
+	case *array.Uint32:
+		set = uintStructSetter(kind, func(i int) uint64 { return uint64(a.Value(i)) })
+	case *array.Uint64:
+		set = uintStructSetter(kind, a.Value)
+	case *array.Float32:
+		if isFloat {
+			set = func(v reflect.Value, i int) error { v.SetFloat(float64(a.Value(i))); return nil }
+		}
+	case *array.Float64:
+		if isFloat {
+			set = func(v reflect.Value, i int) error { v.SetFloat(a.Value(i)); return nil }
+		}
+	case *array.Boolean:
+		if kind == reflect.Bool {
+			set = func(v reflect.Value, i int) error { v.SetBool(a.Value(i)); return nil }
+		}
+	case *array.String:
+		if kind == reflect.String {
+			set = func(v reflect.Value, i int) error { v.SetString(a.Value(i)); return nil }
+		}
+	case *array.Timestamp:
+		if t == timeType {
+			ts := s.DataType().(*arrow.TimestampType)
+			loc, err := series.TimeLocation(ts.TimeZone)
+			if err != nil {
+				return nil, err
+			}
+			set = func(v reflect.Value, i int) error {
+				v.Set(reflect.ValueOf(series.TimestampToTime(a.Value(i), ts.Unit).In(loc)))
+				return nil
+			}
+		}
+	}
+	if set == nil {
+		return nil, fmt.Errorf("can not store %s column in %s", s.DataType().Name(), t)
+	}
+
+	return set, nil
+}
+
+// intStructSetter returns a function which stores the signed integer at
+// position i, as returned by value, in a field of kind k, or nil if k is not
+// an integer or float kind.
+func intStructSetter(k reflect.Kind, value func(int) int64) func(reflect.Value, int) error {
+	switch {
+	case isStructInt(k):
+		return func(v reflect.Value, i int) error { return setStructInt(v, value(i)) }
+	case isStructUint(k):
+		return func(v reflect.Value, i int) error {
+			x := value(i)
+			if x < 0 {
+				return fmt.Errorf("value %d out of range of %s", x, v.Type())
+			}
+			return setStructUint(v, uint64(x))
+		}
+	case k == reflect.Float32 || k == reflect.Float64:
+		return func(v reflect.Value, i int) error { v.SetFloat(float64(value(i))); return nil }
+	}
+
+	return nil
+}
+
+// uintStructSetter is as intStructSetter for unsigned integers.
+func uintStructSetter(k reflect.Kind, value func(int) uint64) func(reflect.Value, int) error {
+	switch {
+	case isStructInt(k):
+		return func(v reflect.Value, i int) error {
+			x := value(i)
+			if x > math.MaxInt64 {
+				return fmt.Errorf("value %d out of range of %s", x, v.Type())
+			}
+			return setStructInt(v, int64(x))
+		}
+	case isStructUint(k):
+		return func(v reflect.Value, i int) error { return setStructUint(v, value(i)) }
+	case k == reflect.Float32 || k == reflect.Float64:
+		return func(v reflect.Value, i int) error { v.SetFloat(float64(value(i))); return nil }
+	}
+
+	return nil
+}
+
+// isStructInt reports whether k is a signed integer kind.
+func isStructInt(k reflect.Kind) bool {
+	return k >= reflect.Int && k <= reflect.Int64
+}
+
+// isStructUint reports whether k is an unsigned integer kind.
+func isStructUint(k reflect.Kind) bool {
+	return k >= reflect.Uint && k <= reflect.Uint64
+}
+
+// setStructInt stores x in the integer field v, or returns an error if x
+// overflows it.
+func setStructInt(v reflect.Value, x int64) error {
+	if v.OverflowInt(x) {
+		return fmt.Errorf("value %d out of range of %s", x, v.Type())
+	}
+	v.SetInt(x)
+	return nil
+}
+
+// setStructUint is as setStructInt for unsigned integer fields.
+func setStructUint(v reflect.Value, x uint64) error {
+	if v.OverflowUint(x) {
+		return fmt.Errorf("value %d out of range of %s", x, v.Type())
+	}
+	v.SetUint(x)
+	return nil
+}
@@ -7,5 +7,6 @@ require (
 	github.com/go-gota/gota v0.10.1
 	github.com/ptiger10/tada v0.8.8
 	github.com/stretchr/testify v1.5.1
+	github.com/xitongsys/parquet-go v1.5.5-0.20201110004701-b09c49d6d457
 	gonum.org/v1/gonum v0.7.0
 )
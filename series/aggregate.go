@@ -0,0 +1,99 @@
+package series
+
+import (
+	"github.com/apache/arrow/go/arrow/array"
+)
+
+// AGGREGATION
+
+// AggOption configures Sum, Mean, STD, Min, Max, Median, Magnitude and Dot.
+type AggOption func(*aggConfig)
+
+type aggConfig struct {
+	skipNA bool
+}
+
+func newAggConfig(opts ...AggOption) *aggConfig {
+	cfg := &aggConfig{
+		skipNA: true,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	return cfg
+}
+
+// WithSkipNA sets whether null values are skipped. The default is true. When
+// false, an aggregation of a Series with nulls is NaN.
+func WithSkipNA(skip bool) AggOption {
+	return func(cfg *aggConfig) {
+		cfg.skipNA = skip
+	}
+}
+
+// SkipsNA reports whether null values are skipped by aggregations configured
+// with opts.
+func SkipsNA(opts ...AggOption) bool {
+	return newAggConfig(opts...).skipNA
+}
+
+// propagatesNA reports whether an aggregation of the Series is NaN, as nulls
+// are not skipped and one of the Series has nulls.
+func (cfg *aggConfig) propagatesNA(ss ...Series) bool {
+	if cfg.skipNA {
+		return false
+	}
+	for _, s := range ss {
+		if s.NullN() > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Count returns the number of non null values in the Series.
+func (s Series) Count() int {
+	s.Retain()
+	defer s.Release()
+
+	return s.Len() - s.NullN()
+}
+
+// validity returns whether the values at each position of the arrays are all
+// valid, or nil if none of the arrays have nulls.
+func validity(arrs ...array.Interface) []bool {
+	var valid []bool
+	for _, a := range arrs {
+		if a.NullN() == 0 {
+			continue
+		}
+		if valid == nil {
+			valid = make([]bool, a.Len())
+			for i := range valid {
+				valid[i] = true
+			}
+		}
+		for i := range valid {
+			valid[i] = valid[i] && a.IsValid(i)
+		}
+	}
+	return valid
+}
+
+// validFloat64s returns the non null values of a numeric Series as float64
+// values.
+func validFloat64s(s Series) []float64 {
+	vals := float64Values(s)
+	if s.NullN() == 0 {
+		return vals
+	}
+
+	res := make([]float64, 0, s.Len()-s.NullN())
+	for i, v := range vals {
+		if s.IsValid(i) {
+			res = append(res, v)
+		}
+	}
+	return res
+}
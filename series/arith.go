@@ -0,0 +1,526 @@
+package series
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+)
+
+// ARITHMETIC
+
+// DivideByZero is the result of a division by zero in Divide and Mod.
+type DivideByZero int
+
+const (
+	// DivideByZeroInf gives the result of float division: +Inf or -Inf, or
+	// NaN for 0/0 and for Mod.
+	DivideByZeroInf DivideByZero = iota
+	// DivideByZeroNull gives null.
+	DivideByZeroNull
+)
+
+// DivideOption configures Divide and Mod.
+type DivideOption func(*divideConfig)
+
+type divideConfig struct {
+	byZero DivideByZero
+}
+
+func newDivideConfig(opts ...DivideOption) *divideConfig {
+	cfg := &divideConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	return cfg
+}
+
+// WithDivideByZero sets the result of a division by zero. The default is
+// DivideByZeroInf. Integer Mod by zero is always null, as integers have no
+// Inf or NaN.
+func WithDivideByZero(z DivideByZero) DivideOption {
+	return func(cfg *divideConfig) {
+		cfg.byZero = z
+	}
+}
+
+// ArithOp is an element-wise arithmetic operation.
+type ArithOp int
+
+const (
+	// OpAdd adds values.
+	OpAdd ArithOp = iota
+	// OpSubtract subtracts values.
+	OpSubtract
+	// OpMultiply multiplies values, as in Multiply.
+	OpMultiply
+	// OpDivide divides values, as in Divide.
+	OpDivide
+	// OpMod returns the remainders of dividing values, as in Mod.
+	OpMod
+	// OpPow raises values to powers, as in Pow.
+	OpPow
+)
+
+// name returns the name of the operation used in panics.
+func (op ArithOp) name() string {
+	switch op {
+	case OpAdd:
+		return "add"
+	case OpSubtract:
+		return "subtract"
+	case OpMultiply:
+		return "multiply"
+	case OpDivide:
+		return "divide"
+	case OpMod:
+		return "mod"
+	case OpPow:
+		return "pow"
+	}
+	panic(fmt.Sprintf("series: unknown arithmetic operation %d", op))
+}
+
+// funcs returns the functions of the operation for each promoted type.
+func (op ArithOp) funcs(cfg *divideConfig) arithFuncs {
+	switch op {
+	case OpAdd:
+		return arithFuncs{
+			i: func(x, y int64) (int64, bool) { return x + y, true },
+			u: func(x, y uint64) (uint64, bool) { return x + y, true },
+			f: func(x, y float64) (float64, bool) { return x + y, true },
+		}
+	case OpSubtract:
+		return arithFuncs{
+			i: func(x, y int64) (int64, bool) { return x - y, true },
+			u: func(x, y uint64) (uint64, bool) { return x - y, true },
+			f: func(x, y float64) (float64, bool) { return x - y, true },
+		}
+	case OpMultiply:
+		return arithFuncs{
+			i: func(x, y int64) (int64, bool) { return x * y, true },
+			u: func(x, y uint64) (uint64, bool) { return x * y, true },
+			f: func(x, y float64) (float64, bool) { return x * y, true },
+		}
+	case OpDivide:
+		return arithFuncs{
+			f: func(x, y float64) (float64, bool) {
+				if y == 0 && cfg.byZero == DivideByZeroNull {
+					return 0, false
+				}
+				return x / y, true
+			},
+		}
+	case OpMod:
+		return arithFuncs{
+			i: func(x, y int64) (int64, bool) {
+				if y == 0 {
+					return 0, false
+				}
+				return x % y, true
+			},
+			u: func(x, y uint64) (uint64, bool) {
+				if y == 0 {
+					return 0, false
+				}
+				return x % y, true
+			},
+			f: func(x, y float64) (float64, bool) {
+				if y == 0 && cfg.byZero == DivideByZeroNull {
+					return 0, false
+				}
+				return math.Mod(x, y), true
+			},
+		}
+	case OpPow:
+		return arithFuncs{
+			i: func(x, y int64) (int64, bool) {
+				if y < 0 {
+					return 0, false
+				}
+				return int64(powUint64(uint64(x), uint64(y))), true
+			},
+			u: func(x, y uint64) (uint64, bool) { return powUint64(x, y), true },
+			f: func(x, y float64) (float64, bool) { return math.Pow(x, y), true },
+		}
+	}
+	panic(fmt.Sprintf("series: unknown arithmetic operation %d", op))
+}
+
+// resultType returns the type of the result of the operation on values
+// promoted to t.
+func (op ArithOp) resultType(t arrow.DataType) arrow.DataType {
+	if op == OpDivide {
+		return arrow.PrimitiveTypes.Float64
+	}
+	return t
+}
+
+// Arith applies the operation op to two equal length numeric Series
+// element-wise. Values are promoted as in Multiply, so unlike Add and Subtract,
+// OpAdd and OpSubtract accept Series of different types. The options are used
+// by OpDivide and OpMod. A null on either side gives a null.
+func (s Series) Arith(op ArithOp, ss Series, opts ...DivideOption) Series {
+	s.Retain()
+	defer s.Release()
+	ss.Retain()
+	defer ss.Release()
+
+	method := op.name()
+	t := promoteTypes(method, s, ss)
+	return arith(method, s, seriesOperand(ss), op.resultType(t), op.funcs(newDivideConfig(opts...)))
+}
+
+// ArithScalar applies the operation op to every value of a numeric Series and
+// the scalar v, which is a Go integer or float, or nil to give nulls. Values
+// are promoted as in Multiply, where an integer scalar keeps an unsigned
+// Series unsigned unless it is negative and a float scalar gives Float64. The
+// options are used by OpDivide and OpMod. Null values stay null.
+func (s Series) ArithScalar(op ArithOp, v interface{}, opts ...DivideOption) Series {
+	s.Retain()
+	defer s.Release()
+
+	method := op.name() + "_scalar"
+	t := promoteScalar(method, s, v)
+	return arith(method, s, scalarOperand(v), op.resultType(t), op.funcs(newDivideConfig(opts...)))
+}
+
+// Multiply multiplies two equal length numeric Series element-wise. Values are
+// promoted as in Square: signed integers to Int64, unsigned integers to Uint64
+// and floats to Float64, with Float64 if either Series is a float and Int64 if
+// signed and unsigned integers are mixed. A null on either side gives a null.
+func (s Series) Multiply(ss Series) Series {
+	return s.Arith(OpMultiply, ss)
+}
+
+// Divide divides two equal length numeric Series element-wise into a Float64
+// Series. Division by zero is handled as set by WithDivideByZero. A null on
+// either side gives a null.
+func (s Series) Divide(ss Series, opts ...DivideOption) Series {
+	return s.Arith(OpDivide, ss, opts...)
+}
+
+// Mod returns the remainder of dividing two equal length numeric Series
+// element-wise, with the sign of the dividend as with the % operator. Values
+// are promoted as in Multiply. Integer Mod by zero gives null and float Mod by
+// zero is handled as set by WithDivideByZero. A null on either side gives a
+// null.
+func (s Series) Mod(ss Series, opts ...DivideOption) Series {
+	return s.Arith(OpMod, ss, opts...)
+}
+
+// Pow raises the values of the s Series to the powers of the values of the
+// equal length ss Series element-wise. Values are promoted as in Multiply.
+// Integer results wrap on overflow and negative integer powers give null. A
+// null on either side gives a null.
+func (s Series) Pow(ss Series) Series {
+	return s.Arith(OpPow, ss)
+}
+
+// AddScalar adds the scalar v to every value of a numeric Series, as in
+// ArithScalar.
+func (s Series) AddScalar(v interface{}) Series {
+	return s.ArithScalar(OpAdd, v)
+}
+
+// SubScalar subtracts the scalar v from every value of a numeric Series, as in
+// ArithScalar.
+func (s Series) SubScalar(v interface{}) Series {
+	return s.ArithScalar(OpSubtract, v)
+}
+
+// MulScalar multiplies every value of a numeric Series by the scalar v, as in
+// ArithScalar.
+func (s Series) MulScalar(v interface{}) Series {
+	return s.ArithScalar(OpMultiply, v)
+}
+
+// DivScalar divides every value of a numeric Series by the scalar v into a
+// Float64 Series, as in ArithScalar.
+func (s Series) DivScalar(v interface{}, opts ...DivideOption) Series {
+	return s.ArithScalar(OpDivide, v, opts...)
+}
+
+// ModScalar returns the remainder of dividing every value of a numeric Series
+// by the scalar v, as in ArithScalar.
+func (s Series) ModScalar(v interface{}, opts ...DivideOption) Series {
+	return s.ArithScalar(OpMod, v, opts...)
+}
+
+// PowScalar raises every value of a numeric Series to the power v, as in
+// ArithScalar.
+func (s Series) PowScalar(v interface{}) Series {
+	return s.ArithScalar(OpPow, v)
+}
+
+// powUint64 returns x to the power of y by squaring, wrapping on overflow.
+func powUint64(x, y uint64) uint64 {
+	r := uint64(1)
+	for ; y > 0; y >>= 1 {
+		if y&1 == 1 {
+			r *= x
+		}
+		x *= x
+	}
+	return r
+}
+
+// promotedType returns the type numeric values of type t are promoted to:
+// Int64 for signed integers, Uint64 for unsigned integers and Float64 for
+// floats, or nil for other types.
+func promotedType(t arrow.DataType) arrow.DataType {
+	switch t {
+	case arrow.PrimitiveTypes.Int8, arrow.PrimitiveTypes.Int16, arrow.PrimitiveTypes.Int32, arrow.PrimitiveTypes.Int64:
+		return arrow.PrimitiveTypes.Int64
+	case arrow.PrimitiveTypes.Uint8, arrow.PrimitiveTypes.Uint16, arrow.PrimitiveTypes.Uint32, arrow.PrimitiveTypes.Uint64:
+		return arrow.PrimitiveTypes.Uint64
+	case arrow.PrimitiveTypes.Float32, arrow.PrimitiveTypes.Float64:
+		return arrow.PrimitiveTypes.Float64
+	}
+	return nil
+}
+
+// promoteTypes returns the type the values of the s and ss Series are promoted
+// to, triggering a panic if they are not numeric Series of equal length.
+func promoteTypes(method string, s, ss Series) arrow.DataType {
+	if s.Len() != ss.Len() {
+		panic(fmt.Sprintf("series: %s: series lengths do not match", method))
+	}
+	t, tt := promotedType(s.field.Type), promotedType(ss.field.Type)
+	switch {
+	case t == nil || tt == nil:
+		panic(fmt.Sprintf("series: %s: unsupported type", method))
+	case t == arrow.PrimitiveTypes.Float64 || tt == arrow.PrimitiveTypes.Float64:
+		return arrow.PrimitiveTypes.Float64
+	case t != tt:
+		return arrow.PrimitiveTypes.Int64
+	}
+	return t
+}
+
+// promoteScalar returns the type the values of the s Series and the scalar v
+// are promoted to, triggering a panic if s is not a numeric Series or v is not
+// a Go integer, float or nil.
+func promoteScalar(method string, s Series, v interface{}) arrow.DataType {
+	t := promotedType(s.field.Type)
+	if t == nil {
+		panic(fmt.Sprintf("series: %s: unsupported type", method))
+	}
+	if v == nil {
+		return t
+	}
+
+	_, isUint := scalarUint64(v)
+	_, isInt := scalarInt64(v)
+	switch v.(type) {
+	case uint, uint64:
+		isInt = true
+	}
+	_, isFloat := scalarFloat64(v)
+	switch {
+	case !isFloat:
+		panic(fmt.Sprintf("series: %s: can not apply %T to %s series", method, v, s.field.Type.Name()))
+	case !isInt || t == arrow.PrimitiveTypes.Float64:
+		return arrow.PrimitiveTypes.Float64
+	case t == arrow.PrimitiveTypes.Uint64 && !isUint:
+		return arrow.PrimitiveTypes.Int64
+	}
+	return t
+}
+
+// arithFuncs holds the function of an arithmetic operation for each promoted
+// type. A function returns false to give a null.
+type arithFuncs struct {
+	i func(x, y int64) (int64, bool)
+	u func(x, y uint64) (uint64, bool)
+	f func(x, y float64) (float64, bool)
+}
+
+// arithOperand is the right side of an arithmetic operation: a Series, which
+// may be lagged, or a scalar used for every value of the left side.
+type arithOperand struct {
+	s      Series
+	v      interface{}
+	scalar bool
+	lag    int
+}
+
+func seriesOperand(ss Series) arithOperand {
+	return arithOperand{s: ss}
+}
+
+func scalarOperand(v interface{}) arithOperand {
+	return arithOperand{v: v, scalar: true}
+}
+
+// laggedOperand returns the ss Series with the value at position i-lag used
+// for position i, where positions outside of ss are null.
+func laggedOperand(ss Series, lag int) arithOperand {
+	return arithOperand{s: ss, lag: lag}
+}
+
+// isValid reports whether the value at position i is valid.
+func (o arithOperand) isValid(i int) bool {
+	if o.scalar {
+		return o.v != nil
+	}
+	j := i - o.lag
+	return j >= 0 && j < o.s.Len() && o.s.IsValid(j)
+}
+
+// index returns the index of the value at position i, which is 0 for a scalar
+// holding a single value.
+func (o arithOperand) index(i int) int {
+	if o.scalar {
+		return 0
+	}
+	return i - o.lag
+}
+
+func (o arithOperand) int64s() []int64 {
+	if !o.scalar {
+		return int64Values(o.s)
+	}
+	n, ok := scalarInt64(o.v)
+	if !ok {
+		u, _ := scalarUint64(o.v)
+		n = int64(u)
+	}
+	return []int64{n}
+}
+
+func (o arithOperand) uint64s() []uint64 {
+	if !o.scalar {
+		return uint64Values(o.s)
+	}
+	n, _ := scalarUint64(o.v)
+	return []uint64{n}
+}
+
+func (o arithOperand) float64s() []float64 {
+	if !o.scalar {
+		return float64Values(o.s)
+	}
+	f, _ := scalarFloat64(o.v)
+	return []float64{f}
+}
+
+// arith returns a Series of the type t, which is Int64, Uint64 or Float64,
+// holding the function of fns for t of every value of the s Series and the
+// matching value of the right side, both converted to t. A null on
+// either side gives a null.
+func arith(method string, s Series, right arithOperand, t arrow.DataType, fns arithFuncs) Series {
+	valid := make([]bool, s.Len())
+	for i := range valid {
+		valid[i] = s.IsValid(i) && right.isValid(i)
+	}
+
+	f := s.field
+	f.Type = t
+	switch t {
+	case arrow.PrimitiveTypes.Int64:
+		xs, ys := int64Values(s), right.int64s()
+		vals := make([]int64, len(xs))
+		for i := range vals {
+			if valid[i] {
+				vals[i], valid[i] = fns.i(xs[i], ys[right.index(i)])
+			}
+		}
+		return FromInt64(s.pool, f, vals, valid)
+	case arrow.PrimitiveTypes.Uint64:
+		xs, ys := uint64Values(s), right.uint64s()
+		vals := make([]uint64, len(xs))
+		for i := range vals {
+			if valid[i] {
+				vals[i], valid[i] = fns.u(xs[i], ys[right.index(i)])
+			}
+		}
+		return FromUint64(s.pool, f, vals, valid)
+	case arrow.PrimitiveTypes.Float64:
+		xs, ys := float64Values(s), right.float64s()
+		vals := make([]float64, len(xs))
+		for i := range vals {
+			if valid[i] {
+				vals[i], valid[i] = fns.f(xs[i], ys[right.index(i)])
+			}
+		}
+		return FromFloat64(s.pool, f, vals, valid)
+	default:
+		panic(fmt.Sprintf("series: %s: unsupported type", method))
+	}
+}
+
+// int64Values returns the values of an integer Series as int64 values.
+func int64Values(s Series) []int64 {
+	vals := make([]int64, s.Len())
+	switch a := s.Interface.(type) {
+	case *array.Int8:
+		for i, v := range a.Int8Values() {
+			vals[i] = int64(v)
+		}
+	case *array.Int16:
+		for i, v := range a.Int16Values() {
+			vals[i] = int64(v)
+		}
+	case *array.Int32:
+		for i, v := range a.Int32Values() {
+			vals[i] = int64(v)
+		}
+	case *array.Int64:
+		copy(vals, a.Int64Values())
+	default:
+		for i, v := range uint64Values(s) {
+			vals[i] = int64(v)
+		}
+	}
+	return vals
+}
+
+// uint64Values returns the values of an unsigned integer Series as uint64
+// values.
+func uint64Values(s Series) []uint64 {
+	vals := make([]uint64, s.Len())
+	switch a := s.Interface.(type) {
+	case *array.Uint8:
+		for i, v := range a.Uint8Values() {
+			vals[i] = uint64(v)
+		}
+	case *array.Uint16:
+		for i, v := range a.Uint16Values() {
+			vals[i] = uint64(v)
+		}
+	case *array.Uint32:
+		for i, v := range a.Uint32Values() {
+			vals[i] = uint64(v)
+		}
+	case *array.Uint64:
+		copy(vals, a.Uint64Values())
+	}
+	return vals
+}
+
+// float64Values returns the values of a numeric Series as float64 values.
+func float64Values(s Series) []float64 {
+	vals := make([]float64, s.Len())
+	switch a := s.Interface.(type) {
+	case *array.Float32:
+		for i, v := range a.Float32Values() {
+			vals[i] = float64(v)
+		}
+	case *array.Float64:
+		copy(vals, a.Float64Values())
+	default:
+		if promotedType(s.field.Type) == arrow.PrimitiveTypes.Uint64 {
+			for i, v := range uint64Values(s) {
+				vals[i] = float64(v)
+			}
+			break
+		}
+		for i, v := range int64Values(s) {
+			vals[i] = float64(v)
+		}
+	}
+	return vals
+}
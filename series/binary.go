@@ -0,0 +1,247 @@
+package series
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// BINARY
+
+// BinaryEncoding is the text encoding of binary values cast to and from
+// strings.
+type BinaryEncoding int
+
+const (
+	// HexEncoding encodes bytes as lower case hexadecimal digits.
+	HexEncoding BinaryEncoding = iota
+	// Base64Encoding encodes bytes as standard padded base64.
+	Base64Encoding
+)
+
+// Encode returns b as text.
+func (enc BinaryEncoding) Encode(b []byte) string {
+	if enc == Base64Encoding {
+		return base64.StdEncoding.EncodeToString(b)
+	}
+	return hex.EncodeToString(b)
+}
+
+// Decode returns the bytes encoded as text in v.
+func (enc BinaryEncoding) Decode(v string) ([]byte, error) {
+	if enc == Base64Encoding {
+		return base64.StdEncoding.DecodeString(v)
+	}
+	return hex.DecodeString(v)
+}
+
+// binaryArray is an Arrow array of Binary or FixedSizeBinary values.
+type binaryArray interface {
+	array.Interface
+	Value(i int) []byte
+}
+
+// FromBinary creates a Series from a slice of byte slices of any length.
+func FromBinary(pool memory.Allocator, field arrow.Field, vals [][]byte, valid []bool) Series {
+	return Series{
+		pool:      pool,
+		field:     field,
+		Interface: newBinaryArray(pool, arrow.BinaryTypes.Binary, vals, valid),
+	}
+}
+
+// FromFixedSizeBinary creates a Series from a slice of byte slices which all
+// have the byte width of the field type, which must be a FixedSizeBinary type.
+// Null values may be empty. A panic is triggered if a valid value does not have
+// the byte width.
+func FromFixedSizeBinary(pool memory.Allocator, field arrow.Field, vals [][]byte, valid []bool) Series {
+	t, ok := field.Type.(*arrow.FixedSizeBinaryType)
+	if !ok {
+		panic("series: from_fixed_size_binary: field type must be a fixed size binary type")
+	}
+	for i, v := range vals {
+		if (valid == nil || valid[i]) && len(v) != t.ByteWidth {
+			panic(fmt.Sprintf("series: from_fixed_size_binary: value %d has %d bytes, expected %d", i, len(v), t.ByteWidth))
+		}
+	}
+
+	return Series{
+		pool:      pool,
+		field:     field,
+		Interface: newBinaryArray(pool, t, vals, valid),
+	}
+}
+
+// newBinaryArray returns a Binary array, or a FixedSizeBinary array if t is a
+// FixedSizeBinary type, of the values.
+func newBinaryArray(pool memory.Allocator, t arrow.DataType, vals [][]byte, valid []bool) array.Interface {
+	ft, ok := t.(*arrow.FixedSizeBinaryType)
+	if !ok {
+		b := array.NewBinaryBuilder(pool, arrow.BinaryTypes.Binary)
+		defer b.Release()
+		b.AppendValues(vals, valid)
+		return b.NewArray()
+	}
+
+	b := array.NewFixedSizeBinaryBuilder(pool, ft)
+	defer b.Release()
+	b.Reserve(len(vals))
+	for i, v := range vals {
+		if valid != nil && !valid[i] {
+			b.AppendNull()
+			continue
+		}
+		b.Append(v)
+	}
+	return b.NewArray()
+}
+
+// isBinary reports whether t is a Binary or FixedSizeBinary type.
+func isBinary(t arrow.DataType) bool {
+	switch t.(type) {
+	case *arrow.BinaryType, *arrow.FixedSizeBinaryType:
+		return true
+	}
+	return false
+}
+
+// binaryValues returns the values of a Binary or FixedSizeBinary array, with
+// nil for nulls.
+func binaryValues(a binaryArray) [][]byte {
+	vals := make([][]byte, a.Len())
+	for i := range vals {
+		if a.IsValid(i) {
+			vals[i] = a.Value(i)
+		}
+	}
+	return vals
+}
+
+// CastBinary returns a new Series of the type t, which must be a Binary,
+// FixedSizeBinary or String type, using enc as the text encoding of binary
+// values. Strings are decoded into bytes and bytes are encoded into strings.
+// Cast uses HexEncoding. Null values stay null.
+func (s Series) CastBinary(t arrow.DataType, enc BinaryEncoding) Series {
+	s.Retain()
+	defer s.Release()
+
+	switch {
+	case isBinary(t):
+		return castToBinary(s, t, enc)
+	case t == arrow.BinaryTypes.String && isBinary(s.field.Type):
+		return encodeBinary(s, enc)
+	default:
+		panic(fmt.Sprintf("series: cast_binary: can not cast %s to %s", s.field.Type.Name(), t.Name()))
+	}
+}
+
+// castToBinary casts a String, Binary or FixedSizeBinary Series into the
+// Binary or FixedSizeBinary type t, decoding strings with enc. A panic is
+// triggered if a string can not be decoded or a value does not have the byte
+// width of t.
+func castToBinary(s Series, t arrow.DataType, enc BinaryEncoding) Series {
+	vals := make([][]byte, s.Len())
+	valid := make([]bool, s.Len())
+	switch a := s.Interface.(type) {
+	case *array.String:
+		for i := range vals {
+			if a.IsNull(i) {
+				continue
+			}
+			v, err := enc.Decode(a.Value(i))
+			if err != nil {
+				panic(fmt.Sprintf("series: cast: %s", err))
+			}
+			vals[i], valid[i] = v, true
+		}
+	case binaryArray:
+		for i := range vals {
+			vals[i], valid[i] = a.Value(i), a.IsValid(i)
+		}
+	default:
+		panic(fmt.Sprintf("series: cast: can not cast %s to %s", s.field.Type.Name(), t.Name()))
+	}
+
+	if ft, ok := t.(*arrow.FixedSizeBinaryType); ok {
+		for i, v := range vals {
+			if valid[i] && len(v) != ft.ByteWidth {
+				panic(fmt.Sprintf("series: cast: value %d has %d bytes, expected %d", i, len(v), ft.ByteWidth))
+			}
+		}
+	}
+
+	f := s.field
+	f.Type = t
+	return FromArrow(s.pool, f, newBinaryArray(s.pool, t, vals, valid))
+}
+
+// encodeBinary returns a String Series of the values of a Binary or
+// FixedSizeBinary Series encoded with enc.
+func encodeBinary(s Series, enc BinaryEncoding) Series {
+	a := s.Interface.(binaryArray)
+	vals := make([]string, s.Len())
+	valid := make([]bool, s.Len())
+	for i := range vals {
+		if a.IsNull(i) {
+			continue
+		}
+		vals[i], valid[i] = enc.Encode(a.Value(i)), true
+	}
+
+	f := s.field
+	f.Type = arrow.BinaryTypes.String
+	return FromString(s.pool, f, vals, valid)
+}
+
+// binaryUnique returns a Series of the distinct valid values in order of first
+// appearance.
+func binaryUnique(s Series) Series {
+	a := s.Interface.(binaryArray)
+
+	set := make(map[string]struct{})
+	indices := make([]int, 0, a.Len())
+	for i := 0; i < a.Len(); i++ {
+		if a.IsNull(i) {
+			continue
+		}
+		v := string(a.Value(i))
+		if _, ok := set[v]; ok {
+			continue
+		}
+		set[v] = struct{}{}
+		indices = append(indices, i)
+	}
+
+	return s.take("unique", indices)
+}
+
+// binaryScalar returns v as bytes if it is a byte slice, or a string of hex
+// digits as written by StringValues.
+func binaryScalar(method string, v interface{}) []byte {
+	switch v := v.(type) {
+	case []byte:
+		return v
+	case string:
+		b, err := HexEncoding.Decode(v)
+		if err != nil {
+			panic(fmt.Sprintf("series: %s: %s", method, err))
+		}
+		return b
+	}
+	panic(fmt.Sprintf("series: %s: can not compare binary series with %T", method, v))
+}
+
+// binarySortValues returns a Binary or FixedSizeBinary Series sorted in
+// ascending byte order with nulls last.
+func binarySortValues(s Series) Series {
+	a := s.Interface.(binaryArray)
+
+	return s.take("sort_values", sortedIndices(s, func(i, j int) bool {
+		return bytes.Compare(a.Value(i), a.Value(j)) < 0
+	}))
+}
@@ -34,6 +34,36 @@ func castToInt32(s Series) Series {
 		for i := 0; i < is.Len(); i++ {
 			vals[i] = int32(is.Value(i))
 		}
+	case arrow.PrimitiveTypes.Int8:
+		is := s.Interface.(*array.Int8)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = int32(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Int16:
+		is := s.Interface.(*array.Int16)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = int32(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Uint8:
+		is := s.Interface.(*array.Uint8)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = int32(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Uint16:
+		is := s.Interface.(*array.Uint16)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = int32(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Uint32:
+		is := s.Interface.(*array.Uint32)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = int32(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Uint64:
+		is := s.Interface.(*array.Uint64)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = int32(is.Value(i))
+		}
 	case arrow.BinaryTypes.String:
 		is := s.Interface.(*array.String)
 		for i := 0; i < is.Len(); i++ {
@@ -43,7 +73,6 @@ func castToInt32(s Series) Series {
 			}
 			vals[i] = int32(val)
 		}
-	// case arrow.PrimitiveTypes.Uint64:
 	default:
 		panic("series: cast: unsupported type")
 	}
@@ -75,6 +104,36 @@ func castToInt64(s Series) Series {
 		for i := 0; i < is.Len(); i++ {
 			vals[i] = int64(is.Value(i))
 		}
+	case arrow.PrimitiveTypes.Int8:
+		is := s.Interface.(*array.Int8)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = int64(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Int16:
+		is := s.Interface.(*array.Int16)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = int64(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Uint8:
+		is := s.Interface.(*array.Uint8)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = int64(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Uint16:
+		is := s.Interface.(*array.Uint16)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = int64(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Uint32:
+		is := s.Interface.(*array.Uint32)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = int64(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Uint64:
+		is := s.Interface.(*array.Uint64)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = int64(is.Value(i))
+		}
 	case arrow.BinaryTypes.String:
 		is := s.Interface.(*array.String)
 		for i := 0; i < is.Len(); i++ {
@@ -84,9 +143,14 @@ func castToInt64(s Series) Series {
 			}
 			vals[i] = val
 		}
-	// case arrow.PrimitiveTypes.Uint64:
 	default:
-		panic("series: cast: unsupported type")
+		raw := temporalRaw(s)
+		if raw == nil {
+			panic("series: cast: unsupported type")
+		}
+		for i := range vals {
+			vals[i] = raw(i)
+		}
 	}
 
 	return FromInt64(s.pool, f, vals, nil)
@@ -99,6 +163,7 @@ func castToFloat32(s Series) Series {
 	vals := make([]float32, s.Len())
 	f := s.field
 	f.Type = arrow.PrimitiveTypes.Float32
+	var valid []bool
 
 	switch s.field.Type {
 	case arrow.PrimitiveTypes.Int32:
@@ -116,6 +181,36 @@ func castToFloat32(s Series) Series {
 		for i := 0; i < is.Len(); i++ {
 			vals[i] = float32(is.Value(i))
 		}
+	case arrow.PrimitiveTypes.Int8:
+		is := s.Interface.(*array.Int8)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = float32(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Int16:
+		is := s.Interface.(*array.Int16)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = float32(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Uint8:
+		is := s.Interface.(*array.Uint8)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = float32(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Uint16:
+		is := s.Interface.(*array.Uint16)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = float32(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Uint32:
+		is := s.Interface.(*array.Uint32)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = float32(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Uint64:
+		is := s.Interface.(*array.Uint64)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = float32(is.Value(i))
+		}
 	case arrow.BinaryTypes.String:
 		is := s.Interface.(*array.String)
 		for i := 0; i < is.Len(); i++ {
@@ -125,12 +220,20 @@ func castToFloat32(s Series) Series {
 			}
 			vals[i] = float32(val)
 		}
-	// case arrow.PrimitiveTypes.Uint64:
 	default:
-		panic("series: cast: unsupported type")
+		is, ok := s.Interface.(*array.Decimal128)
+		if !ok {
+			panic("series: cast: unsupported type")
+		}
+		scale := s.field.Type.(*arrow.Decimal128Type).Scale
+		valid = make([]bool, is.Len())
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = float32(decimalFloat64(is.Value(i), scale))
+			valid[i] = is.IsValid(i)
+		}
 	}
 
-	return FromFloat32(s.pool, f, vals, nil)
+	return FromFloat32(s.pool, f, vals, valid)
 }
 func castToFloat64(s Series) Series {
 	if s.field.Type == arrow.PrimitiveTypes.Float64 {
@@ -140,6 +243,7 @@ func castToFloat64(s Series) Series {
 	vals := make([]float64, s.Len())
 	f := s.field
 	f.Type = arrow.PrimitiveTypes.Float64
+	var valid []bool
 
 	switch s.field.Type {
 	case arrow.PrimitiveTypes.Int32:
@@ -157,6 +261,36 @@ func castToFloat64(s Series) Series {
 		for i := 0; i < is.Len(); i++ {
 			vals[i] = float64(is.Value(i))
 		}
+	case arrow.PrimitiveTypes.Int8:
+		is := s.Interface.(*array.Int8)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = float64(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Int16:
+		is := s.Interface.(*array.Int16)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = float64(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Uint8:
+		is := s.Interface.(*array.Uint8)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = float64(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Uint16:
+		is := s.Interface.(*array.Uint16)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = float64(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Uint32:
+		is := s.Interface.(*array.Uint32)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = float64(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Uint64:
+		is := s.Interface.(*array.Uint64)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = float64(is.Value(i))
+		}
 	case arrow.BinaryTypes.String:
 		is := s.Interface.(*array.String)
 		for i := 0; i < is.Len(); i++ {
@@ -166,12 +300,440 @@ func castToFloat64(s Series) Series {
 			}
 			vals[i] = float64(val)
 		}
-	// case arrow.PrimitiveTypes.Uint64:
+	default:
+		is, ok := s.Interface.(*array.Decimal128)
+		if !ok {
+			panic("series: cast: unsupported type")
+		}
+		scale := s.field.Type.(*arrow.Decimal128Type).Scale
+		valid = make([]bool, is.Len())
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = decimalFloat64(is.Value(i), scale)
+			valid[i] = is.IsValid(i)
+		}
+	}
+
+	return FromFloat64(s.pool, f, vals, valid)
+}
+func castToInt8(s Series) Series {
+	if s.field.Type == arrow.PrimitiveTypes.Int8 {
+		return s
+	}
+
+	vals := make([]int8, s.Len())
+	f := s.field
+	f.Type = arrow.PrimitiveTypes.Int8
+
+	switch s.field.Type {
+	case arrow.PrimitiveTypes.Int32:
+		is := s.Interface.(*array.Int32)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = int8(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Int64:
+		is := s.Interface.(*array.Int64)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = int8(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Float32:
+		is := s.Interface.(*array.Float32)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = int8(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Float64:
+		is := s.Interface.(*array.Float64)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = int8(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Int16:
+		is := s.Interface.(*array.Int16)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = int8(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Uint8:
+		is := s.Interface.(*array.Uint8)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = int8(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Uint16:
+		is := s.Interface.(*array.Uint16)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = int8(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Uint32:
+		is := s.Interface.(*array.Uint32)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = int8(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Uint64:
+		is := s.Interface.(*array.Uint64)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = int8(is.Value(i))
+		}
+	case arrow.BinaryTypes.String:
+		is := s.Interface.(*array.String)
+		for i := 0; i < is.Len(); i++ {
+			val, err := strconv.ParseInt(is.Value(i), 10, 8)
+			if err != nil {
+				panic(fmt.Sprintf("series: cast: %s", err))
+			}
+			vals[i] = int8(val)
+		}
+	default:
+		panic("series: cast: unsupported type")
+	}
+
+	return FromInt8(s.pool, f, vals, nil)
+}
+func castToInt16(s Series) Series {
+	if s.field.Type == arrow.PrimitiveTypes.Int16 {
+		return s
+	}
+
+	vals := make([]int16, s.Len())
+	f := s.field
+	f.Type = arrow.PrimitiveTypes.Int16
+
+	switch s.field.Type {
+	case arrow.PrimitiveTypes.Int32:
+		is := s.Interface.(*array.Int32)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = int16(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Int64:
+		is := s.Interface.(*array.Int64)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = int16(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Float32:
+		is := s.Interface.(*array.Float32)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = int16(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Float64:
+		is := s.Interface.(*array.Float64)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = int16(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Int8:
+		is := s.Interface.(*array.Int8)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = int16(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Uint8:
+		is := s.Interface.(*array.Uint8)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = int16(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Uint16:
+		is := s.Interface.(*array.Uint16)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = int16(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Uint32:
+		is := s.Interface.(*array.Uint32)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = int16(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Uint64:
+		is := s.Interface.(*array.Uint64)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = int16(is.Value(i))
+		}
+	case arrow.BinaryTypes.String:
+		is := s.Interface.(*array.String)
+		for i := 0; i < is.Len(); i++ {
+			val, err := strconv.ParseInt(is.Value(i), 10, 16)
+			if err != nil {
+				panic(fmt.Sprintf("series: cast: %s", err))
+			}
+			vals[i] = int16(val)
+		}
 	default:
 		panic("series: cast: unsupported type")
 	}
 
-	return FromFloat64(s.pool, f, vals, nil)
+	return FromInt16(s.pool, f, vals, nil)
+}
+func castToUint8(s Series) Series {
+	if s.field.Type == arrow.PrimitiveTypes.Uint8 {
+		return s
+	}
+
+	vals := make([]uint8, s.Len())
+	f := s.field
+	f.Type = arrow.PrimitiveTypes.Uint8
+
+	switch s.field.Type {
+	case arrow.PrimitiveTypes.Int32:
+		is := s.Interface.(*array.Int32)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = uint8(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Int64:
+		is := s.Interface.(*array.Int64)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = uint8(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Float32:
+		is := s.Interface.(*array.Float32)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = uint8(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Float64:
+		is := s.Interface.(*array.Float64)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = uint8(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Int8:
+		is := s.Interface.(*array.Int8)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = uint8(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Int16:
+		is := s.Interface.(*array.Int16)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = uint8(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Uint16:
+		is := s.Interface.(*array.Uint16)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = uint8(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Uint32:
+		is := s.Interface.(*array.Uint32)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = uint8(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Uint64:
+		is := s.Interface.(*array.Uint64)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = uint8(is.Value(i))
+		}
+	case arrow.BinaryTypes.String:
+		is := s.Interface.(*array.String)
+		for i := 0; i < is.Len(); i++ {
+			val, err := strconv.ParseUint(is.Value(i), 10, 8)
+			if err != nil {
+				panic(fmt.Sprintf("series: cast: %s", err))
+			}
+			vals[i] = uint8(val)
+		}
+	default:
+		panic("series: cast: unsupported type")
+	}
+
+	return FromUint8(s.pool, f, vals, nil)
+}
+func castToUint16(s Series) Series {
+	if s.field.Type == arrow.PrimitiveTypes.Uint16 {
+		return s
+	}
+
+	vals := make([]uint16, s.Len())
+	f := s.field
+	f.Type = arrow.PrimitiveTypes.Uint16
+
+	switch s.field.Type {
+	case arrow.PrimitiveTypes.Int32:
+		is := s.Interface.(*array.Int32)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = uint16(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Int64:
+		is := s.Interface.(*array.Int64)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = uint16(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Float32:
+		is := s.Interface.(*array.Float32)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = uint16(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Float64:
+		is := s.Interface.(*array.Float64)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = uint16(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Int8:
+		is := s.Interface.(*array.Int8)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = uint16(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Int16:
+		is := s.Interface.(*array.Int16)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = uint16(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Uint8:
+		is := s.Interface.(*array.Uint8)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = uint16(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Uint32:
+		is := s.Interface.(*array.Uint32)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = uint16(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Uint64:
+		is := s.Interface.(*array.Uint64)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = uint16(is.Value(i))
+		}
+	case arrow.BinaryTypes.String:
+		is := s.Interface.(*array.String)
+		for i := 0; i < is.Len(); i++ {
+			val, err := strconv.ParseUint(is.Value(i), 10, 16)
+			if err != nil {
+				panic(fmt.Sprintf("series: cast: %s", err))
+			}
+			vals[i] = uint16(val)
+		}
+	default:
+		panic("series: cast: unsupported type")
+	}
+
+	return FromUint16(s.pool, f, vals, nil)
+}
+func castToUint32(s Series) Series {
+	if s.field.Type == arrow.PrimitiveTypes.Uint32 {
+		return s
+	}
+
+	vals := make([]uint32, s.Len())
+	f := s.field
+	f.Type = arrow.PrimitiveTypes.Uint32
+
+	switch s.field.Type {
+	case arrow.PrimitiveTypes.Int32:
+		is := s.Interface.(*array.Int32)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = uint32(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Int64:
+		is := s.Interface.(*array.Int64)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = uint32(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Float32:
+		is := s.Interface.(*array.Float32)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = uint32(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Float64:
+		is := s.Interface.(*array.Float64)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = uint32(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Int8:
+		is := s.Interface.(*array.Int8)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = uint32(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Int16:
+		is := s.Interface.(*array.Int16)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = uint32(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Uint8:
+		is := s.Interface.(*array.Uint8)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = uint32(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Uint16:
+		is := s.Interface.(*array.Uint16)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = uint32(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Uint64:
+		is := s.Interface.(*array.Uint64)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = uint32(is.Value(i))
+		}
+	case arrow.BinaryTypes.String:
+		is := s.Interface.(*array.String)
+		for i := 0; i < is.Len(); i++ {
+			val, err := strconv.ParseUint(is.Value(i), 10, 32)
+			if err != nil {
+				panic(fmt.Sprintf("series: cast: %s", err))
+			}
+			vals[i] = uint32(val)
+		}
+	default:
+		panic("series: cast: unsupported type")
+	}
+
+	return FromUint32(s.pool, f, vals, nil)
+}
+func castToUint64(s Series) Series {
+	if s.field.Type == arrow.PrimitiveTypes.Uint64 {
+		return s
+	}
+
+	vals := make([]uint64, s.Len())
+	f := s.field
+	f.Type = arrow.PrimitiveTypes.Uint64
+
+	switch s.field.Type {
+	case arrow.PrimitiveTypes.Int32:
+		is := s.Interface.(*array.Int32)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = uint64(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Int64:
+		is := s.Interface.(*array.Int64)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = uint64(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Float32:
+		is := s.Interface.(*array.Float32)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = uint64(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Float64:
+		is := s.Interface.(*array.Float64)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = uint64(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Int8:
+		is := s.Interface.(*array.Int8)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = uint64(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Int16:
+		is := s.Interface.(*array.Int16)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = uint64(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Uint8:
+		is := s.Interface.(*array.Uint8)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = uint64(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Uint16:
+		is := s.Interface.(*array.Uint16)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = uint64(is.Value(i))
+		}
+	case arrow.PrimitiveTypes.Uint32:
+		is := s.Interface.(*array.Uint32)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = uint64(is.Value(i))
+		}
+	case arrow.BinaryTypes.String:
+		is := s.Interface.(*array.String)
+		for i := 0; i < is.Len(); i++ {
+			val, err := strconv.ParseUint(is.Value(i), 10, 64)
+			if err != nil {
+				panic(fmt.Sprintf("series: cast: %s", err))
+			}
+			vals[i] = uint64(val)
+		}
+	default:
+		panic("series: cast: unsupported type")
+	}
+
+	return FromUint64(s.pool, f, vals, nil)
 }
 func castToString(s Series) Series {
 	if s.field.Type == arrow.BinaryTypes.String {
@@ -203,9 +765,59 @@ func castToString(s Series) Series {
 		for i := 0; i < is.Len(); i++ {
 			vals[i] = strconv.FormatFloat(is.Value(i), 'f', -1, 64)
 		}
-	// case arrow.PrimitiveTypes.Uint64:
+	case arrow.PrimitiveTypes.Int8:
+		is := s.Interface.(*array.Int8)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = strconv.FormatInt(int64(is.Value(i)), 10)
+		}
+	case arrow.PrimitiveTypes.Int16:
+		is := s.Interface.(*array.Int16)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = strconv.FormatInt(int64(is.Value(i)), 10)
+		}
+	case arrow.PrimitiveTypes.Uint8:
+		is := s.Interface.(*array.Uint8)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = strconv.FormatUint(uint64(is.Value(i)), 10)
+		}
+	case arrow.PrimitiveTypes.Uint16:
+		is := s.Interface.(*array.Uint16)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = strconv.FormatUint(uint64(is.Value(i)), 10)
+		}
+	case arrow.PrimitiveTypes.Uint32:
+		is := s.Interface.(*array.Uint32)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = strconv.FormatUint(uint64(is.Value(i)), 10)
+		}
+	case arrow.PrimitiveTypes.Uint64:
+		is := s.Interface.(*array.Uint64)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = strconv.FormatUint(uint64(is.Value(i)), 10)
+		}
+	case Categorical:
+		is := s.Interface.(*categoricalArray)
+		for i := 0; i < is.Len(); i++ {
+			vals[i] = is.value(i)
+		}
 	default:
-		panic("series: cast: unsupported type")
+		if isBinary(s.field.Type) {
+			return encodeBinary(s, HexEncoding)
+		}
+		if is, ok := s.Interface.(*array.Decimal128); ok {
+			scale := s.field.Type.(*arrow.Decimal128Type).Scale
+			for i := 0; i < is.Len(); i++ {
+				vals[i] = FormatDecimal(is.Value(i), scale)
+			}
+			break
+		}
+		format := temporalFormatter(s)
+		if format == nil {
+			panic("series: cast: unsupported type")
+		}
+		for i := range vals {
+			vals[i] = format(i)
+		}
 	}
 
 	return FromString(s.pool, f, vals, nil)
@@ -0,0 +1,276 @@
+package series
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// CATEGORICAL
+
+// CategoricalType is the data type of dictionary encoded String Series, which
+// hold an Int32 code per value into a dictionary of distinct categories.
+type CategoricalType struct{}
+
+// ID returns the Arrow type ID of dictionary encoded arrays.
+func (*CategoricalType) ID() arrow.Type { return arrow.DICTIONARY }
+
+// Name returns the name of the data type.
+func (*CategoricalType) Name() string { return "categorical" }
+
+// Categorical is the data type of categorical Series.
+var Categorical arrow.DataType = &CategoricalType{}
+
+// categoricalArray is an Arrow array of Int32 codes into a String array of
+// categories. A null value has no category.
+type categoricalArray struct {
+	*array.Int32
+	categories *array.String
+}
+
+func newCategoricalArray(codes *array.Int32, categories *array.String) *categoricalArray {
+	categories.Retain()
+	return &categoricalArray{Int32: codes, categories: categories}
+}
+
+// DataType returns the Categorical data type.
+func (a *categoricalArray) DataType() arrow.DataType { return Categorical }
+
+// Retain increases the reference count of the codes and the categories.
+func (a *categoricalArray) Retain() {
+	a.Int32.Retain()
+	a.categories.Retain()
+}
+
+// Release decreases the reference count of the codes and the categories.
+func (a *categoricalArray) Release() {
+	a.Int32.Release()
+	a.categories.Release()
+}
+
+// value returns the category at position i, or an empty string for nulls.
+func (a *categoricalArray) value(i int) string {
+	if a.IsNull(i) {
+		return ""
+	}
+	return a.categories.Value(int(a.Int32.Value(i)))
+}
+
+// String returns the categories of the values as a string.
+func (a *categoricalArray) String() string {
+	var sb strings.Builder
+	sb.WriteString("[")
+	for i := 0; i < a.Len(); i++ {
+		if i > 0 {
+			sb.WriteString(" ")
+		}
+		if a.IsNull(i) {
+			sb.WriteString("(null)")
+			continue
+		}
+		sb.WriteString(strconv.Quote(a.value(i)))
+	}
+	sb.WriteString("]")
+	return sb.String()
+}
+
+// code returns the code of the category v, or -1 if v is not a category.
+func (a *categoricalArray) code(v string) int32 {
+	for i := 0; i < a.categories.Len(); i++ {
+		if a.categories.Value(i) == v {
+			return int32(i)
+		}
+	}
+	return -1
+}
+
+// FromCategorical creates a categorical Series from Int32 codes into the
+// categories. A panic is triggered if a valid code is not the index of a
+// category.
+func FromCategorical(pool memory.Allocator, field arrow.Field, codes []int32, valid []bool, categories []string) Series {
+	for i, c := range codes {
+		if (valid == nil || valid[i]) && (c < 0 || int(c) >= len(categories)) {
+			panic(fmt.Sprintf("series: from_categorical: code %d out of range", c))
+		}
+	}
+
+	cb := array.NewStringBuilder(pool)
+	defer cb.Release()
+	cb.AppendValues(categories, nil)
+	cats := cb.NewStringArray()
+	defer cats.Release()
+
+	b := array.NewInt32Builder(pool)
+	defer b.Release()
+	b.AppendValues(codes, valid)
+
+	return Series{
+		pool:      pool,
+		field:     field,
+		Interface: newCategoricalArray(b.NewInt32Array(), cats),
+	}
+}
+
+// AsCategorical returns a categorical Series holding the values of a String
+// Series, with categories in order of first appearance. Null values stay null.
+// A categorical Series is returned as is. A panic is triggered for other
+// Series.
+func (s Series) AsCategorical() Series {
+	s.Retain()
+	defer s.Release()
+
+	switch s.field.Type {
+	case Categorical:
+		s.Retain()
+		return s
+	case arrow.BinaryTypes.String:
+	default:
+		panic("series: as_categorical: unsupported type")
+	}
+
+	a := s.Interface.(*array.String)
+	set := make(map[string]int32)
+	var categories []string
+	codes := make([]int32, a.Len())
+	valid := make([]bool, a.Len())
+	for i := range codes {
+		if a.IsNull(i) {
+			continue
+		}
+		v := a.Value(i)
+		code, ok := set[v]
+		if !ok {
+			code = int32(len(categories))
+			set[v] = code
+			categories = append(categories, v)
+		}
+		codes[i] = code
+		valid[i] = true
+	}
+
+	f := s.field
+	f.Type = Categorical
+	return FromCategorical(s.pool, f, codes, valid, categories)
+}
+
+// Categories returns a String Series of the categories of a categorical
+// Series. A panic is triggered for other Series.
+func (s Series) Categories() Series {
+	a := categorical("categories", s)
+	a.categories.Retain()
+
+	f := arrow.Field{Name: s.field.Name, Type: arrow.BinaryTypes.String}
+	return FromArrow(s.pool, f, a.categories)
+}
+
+// Codes returns an Int32 Series of the codes of a categorical Series, where
+// null values stay null. A panic is triggered for other Series.
+func (s Series) Codes() Series {
+	a := categorical("codes", s)
+	a.Int32.Retain()
+
+	f := arrow.Field{Name: s.field.Name, Type: arrow.PrimitiveTypes.Int32, Nullable: s.field.Nullable}
+	return FromArrow(s.pool, f, a.Int32)
+}
+
+// categorical returns the array of a categorical Series, triggering a panic
+// for other Series.
+func categorical(method string, s Series) *categoricalArray {
+	a, ok := s.Interface.(*categoricalArray)
+	if !ok {
+		panic(fmt.Sprintf("series: %s: expected categorical series", method))
+	}
+	return a
+}
+
+// CategoryMapping returns, for every category of the s categorical Series,
+// the code of the same category in the ss categorical Series, or -1 if ss does
+// not have it. It allows values of both Series to be compared by code.
+func (s Series) CategoryMapping(ss Series) []int32 {
+	a := categorical("category_mapping", s)
+	aa := categorical("category_mapping", ss)
+
+	codes := make(map[string]int32, aa.categories.Len())
+	for i := 0; i < aa.categories.Len(); i++ {
+		codes[aa.categories.Value(i)] = int32(i)
+	}
+	mapping := make([]int32, a.categories.Len())
+	for i := range mapping {
+		code, ok := codes[a.categories.Value(i)]
+		if !ok {
+			code = -1
+		}
+		mapping[i] = code
+	}
+	return mapping
+}
+
+// categoricalUnique returns a categorical Series of the distinct valid values
+// in order of first appearance, comparing codes.
+func categoricalUnique(s Series) Series {
+	a := s.Interface.(*categoricalArray)
+
+	seen := make([]bool, a.categories.Len())
+	indices := make([]int, 0, len(seen))
+	for i := 0; i < a.Len(); i++ {
+		if a.IsNull(i) {
+			continue
+		}
+		code := a.Int32.Value(i)
+		if seen[code] {
+			continue
+		}
+		seen[code] = true
+		indices = append(indices, i)
+	}
+
+	return s.take("unique", indices)
+}
+
+// categoricalFindIndices returns the indices of the values equal to the
+// category val, comparing codes.
+func categoricalFindIndices(s Series, val interface{}) []int {
+	a := s.Interface.(*categoricalArray)
+
+	v, ok := val.(string)
+	if !ok {
+		panic(fmt.Sprintf("series: find_indices: can not find %T in categorical series", val))
+	}
+	code := a.code(v)
+	if code < 0 {
+		return nil
+	}
+
+	var result []int
+	for i, c := range a.Int32Values() {
+		if c != code || a.IsNull(i) {
+			continue
+		}
+		result = append(result, i)
+	}
+	return result
+}
+
+// categoricalSortValues returns a categorical Series sorted by category with
+// nulls last.
+func categoricalSortValues(s Series) Series {
+	a := s.Interface.(*categoricalArray)
+
+	return s.take("sort_values", sortedIndices(s, func(i, j int) bool {
+		return a.value(i) < a.value(j)
+	}))
+}
+
+// newSlice returns a slice of the array a from i to j, keeping the categories
+// of a categorical array.
+func newSlice(a array.Interface, i, j int64) array.Interface {
+	if ca, ok := a.(*categoricalArray); ok {
+		codes := array.NewSlice(ca.Int32, i, j).(*array.Int32)
+		return newCategoricalArray(codes, ca.categories)
+	}
+	return array.NewSlice(a, i, j)
+}
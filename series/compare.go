@@ -0,0 +1,338 @@
+package series
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+)
+
+// COMPARE
+
+// compareOp is a comparison between a value of a Series and a scalar.
+type compareOp int
+
+const (
+	opEq compareOp = iota
+	opNe
+	opLt
+	opLe
+	opGt
+	opGe
+)
+
+func compareInt64(a, b int64, op compareOp) bool {
+	switch op {
+	case opEq:
+		return a == b
+	case opNe:
+		return a != b
+	case opLt:
+		return a < b
+	case opLe:
+		return a <= b
+	case opGt:
+		return a > b
+	default:
+		return a >= b
+	}
+}
+
+func compareUint64(a, b uint64, op compareOp) bool {
+	switch op {
+	case opEq:
+		return a == b
+	case opNe:
+		return a != b
+	case opLt:
+		return a < b
+	case opLe:
+		return a <= b
+	case opGt:
+		return a > b
+	default:
+		return a >= b
+	}
+}
+
+func compareFloat64(a, b float64, op compareOp) bool {
+	switch op {
+	case opEq:
+		return a == b
+	case opNe:
+		return a != b
+	case opLt:
+		return a < b
+	case opLe:
+		return a <= b
+	case opGt:
+		return a > b
+	default:
+		return a >= b
+	}
+}
+
+func compareString(a, b string, op compareOp) bool {
+	switch op {
+	case opEq:
+		return a == b
+	case opNe:
+		return a != b
+	case opLt:
+		return a < b
+	case opLe:
+		return a <= b
+	case opGt:
+		return a > b
+	default:
+		return a >= b
+	}
+}
+
+func compareBool(a, b bool, op compareOp) bool {
+	var x, y int64
+	if a {
+		x = 1
+	}
+	if b {
+		y = 1
+	}
+	return compareInt64(x, y, op)
+}
+
+// scalarInt64 returns v as an int64 if it is a Go integer.
+func scalarInt64(v interface{}) (int64, bool) {
+	switch v := v.(type) {
+	case int:
+		return int64(v), true
+	case int8:
+		return int64(v), true
+	case int16:
+		return int64(v), true
+	case int32:
+		return int64(v), true
+	case int64:
+		return v, true
+	case uint8:
+		return int64(v), true
+	case uint16:
+		return int64(v), true
+	case uint32:
+		return int64(v), true
+	}
+	return 0, false
+}
+
+// scalarUint64 returns v as a uint64 if it is a non negative Go integer.
+func scalarUint64(v interface{}) (uint64, bool) {
+	switch v := v.(type) {
+	case uint:
+		return uint64(v), true
+	case uint64:
+		return v, true
+	}
+	if n, ok := scalarInt64(v); ok && n >= 0 {
+		return uint64(n), true
+	}
+	return 0, false
+}
+
+// scalarFloat64 returns v as a float64 if it is a Go integer or float.
+func scalarFloat64(v interface{}) (float64, bool) {
+	switch v := v.(type) {
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	case uint:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	}
+	if n, ok := scalarInt64(v); ok {
+		return float64(n), true
+	}
+	return 0, false
+}
+
+// comparator returns a function which compares the value at position i of the
+// s Series with the scalar v. Integer Series are compared as integers unless v
+// is a float. A panic is triggered if v can not be compared with the values
+// of the Series.
+func (s Series) comparator(method string, v interface{}) func(i int, op compareOp) bool {
+	switch s.field.Type {
+	case arrow.PrimitiveTypes.Int32:
+		a := s.Interface.(*array.Int32)
+		if n, ok := scalarInt64(v); ok {
+			return func(i int, op compareOp) bool { return compareInt64(int64(a.Value(i)), n, op) }
+		}
+		if f, ok := scalarFloat64(v); ok {
+			return func(i int, op compareOp) bool { return compareFloat64(float64(a.Value(i)), f, op) }
+		}
+	case arrow.PrimitiveTypes.Int64:
+		a := s.Interface.(*array.Int64)
+		if n, ok := scalarInt64(v); ok {
+			return func(i int, op compareOp) bool { return compareInt64(a.Value(i), n, op) }
+		}
+		if f, ok := scalarFloat64(v); ok {
+			return func(i int, op compareOp) bool { return compareFloat64(float64(a.Value(i)), f, op) }
+		}
+	case arrow.PrimitiveTypes.Float32:
+		a := s.Interface.(*array.Float32)
+		if f, ok := scalarFloat64(v); ok {
+			return func(i int, op compareOp) bool { return compareFloat64(float64(a.Value(i)), f, op) }
+		}
+	case arrow.PrimitiveTypes.Float64:
+		a := s.Interface.(*array.Float64)
+		if f, ok := scalarFloat64(v); ok {
+			return func(i int, op compareOp) bool { return compareFloat64(a.Value(i), f, op) }
+		}
+	case arrow.PrimitiveTypes.Int8:
+		a := s.Interface.(*array.Int8)
+		if n, ok := scalarInt64(v); ok {
+			return func(i int, op compareOp) bool { return compareInt64(int64(a.Value(i)), n, op) }
+		}
+		if f, ok := scalarFloat64(v); ok {
+			return func(i int, op compareOp) bool { return compareFloat64(float64(a.Value(i)), f, op) }
+		}
+	case arrow.PrimitiveTypes.Int16:
+		a := s.Interface.(*array.Int16)
+		if n, ok := scalarInt64(v); ok {
+			return func(i int, op compareOp) bool { return compareInt64(int64(a.Value(i)), n, op) }
+		}
+		if f, ok := scalarFloat64(v); ok {
+			return func(i int, op compareOp) bool { return compareFloat64(float64(a.Value(i)), f, op) }
+		}
+	case arrow.PrimitiveTypes.Uint8:
+		a := s.Interface.(*array.Uint8)
+		if n, ok := scalarInt64(v); ok {
+			return func(i int, op compareOp) bool { return compareInt64(int64(a.Value(i)), n, op) }
+		}
+		if f, ok := scalarFloat64(v); ok {
+			return func(i int, op compareOp) bool { return compareFloat64(float64(a.Value(i)), f, op) }
+		}
+	case arrow.PrimitiveTypes.Uint16:
+		a := s.Interface.(*array.Uint16)
+		if n, ok := scalarInt64(v); ok {
+			return func(i int, op compareOp) bool { return compareInt64(int64(a.Value(i)), n, op) }
+		}
+		if f, ok := scalarFloat64(v); ok {
+			return func(i int, op compareOp) bool { return compareFloat64(float64(a.Value(i)), f, op) }
+		}
+	case arrow.PrimitiveTypes.Uint32:
+		a := s.Interface.(*array.Uint32)
+		if n, ok := scalarInt64(v); ok {
+			return func(i int, op compareOp) bool { return compareInt64(int64(a.Value(i)), n, op) }
+		}
+		if f, ok := scalarFloat64(v); ok {
+			return func(i int, op compareOp) bool { return compareFloat64(float64(a.Value(i)), f, op) }
+		}
+	case arrow.PrimitiveTypes.Uint64:
+		a := s.Interface.(*array.Uint64)
+		if n, ok := scalarUint64(v); ok {
+			return func(i int, op compareOp) bool { return compareUint64(a.Value(i), n, op) }
+		}
+		if f, ok := scalarFloat64(v); ok {
+			return func(i int, op compareOp) bool { return compareFloat64(float64(a.Value(i)), f, op) }
+		}
+	case arrow.BinaryTypes.String:
+		a := s.Interface.(*array.String)
+		if str, ok := v.(string); ok {
+			return func(i int, op compareOp) bool { return compareString(a.Value(i), str, op) }
+		}
+	case arrow.FixedWidthTypes.Boolean:
+		a := s.Interface.(*array.Boolean)
+		if b, ok := v.(bool); ok {
+			return func(i int, op compareOp) bool { return compareBool(a.Value(i), b, op) }
+		}
+	case Categorical:
+		a := s.Interface.(*categoricalArray)
+		if str, ok := v.(string); ok {
+			// NOTE: equality compares codes, and a string which is not a
+			// category has no code equal to any value.
+			code := a.code(str)
+			return func(i int, op compareOp) bool {
+				switch op {
+				case opEq:
+					return a.Int32.Value(i) == code
+				case opNe:
+					return a.Int32.Value(i) != code
+				default:
+					return compareString(a.value(i), str, op)
+				}
+			}
+		}
+	default:
+		if a, ok := s.Interface.(*array.Decimal128); ok {
+			if cmp := decimalScalar(s.field.Type.(*arrow.Decimal128Type), v); cmp != nil {
+				return func(i int, op compareOp) bool { return compareInt64(int64(cmp(a.Value(i))), 0, op) }
+			}
+			break
+		}
+		if a, ok := s.Interface.(binaryArray); ok {
+			if b, ok := v.([]byte); ok {
+				return func(i int, op compareOp) bool { return compareInt64(int64(bytes.Compare(a.Value(i), b)), 0, op) }
+			}
+			break
+		}
+		raw := temporalRaw(s)
+		if raw == nil {
+			panic(fmt.Sprintf("series: %s: unsupported type", method))
+		}
+		if n, ok := temporalScalar(s, v); ok {
+			return func(i int, op compareOp) bool { return compareInt64(raw(i), n, op) }
+		}
+	}
+
+	panic(fmt.Sprintf("series: %s: can not compare %s series with %T", method, s.field.Type.Name(), v))
+}
+
+// compare returns a Boolean Series holding the result of fn for every value of
+// the s Series. Null values stay null.
+func (s Series) compare(fn func(i int) bool) Series {
+	vals := make([]bool, s.Len())
+	valid := make([]bool, s.Len())
+	for i := range vals {
+		if s.IsNull(i) {
+			continue
+		}
+		vals[i] = fn(i)
+		valid[i] = true
+	}
+
+	f := arrow.Field{Name: s.field.Name, Type: arrow.FixedWidthTypes.Boolean, Nullable: s.field.Nullable}
+	return FromBool(s.pool, f, vals, valid)
+}
+
+// logical returns the Boolean arrays of the s and ss Series, triggering a panic
+// if they are not Boolean Series of equal length.
+func logical(method string, s, ss Series) (*array.Boolean, *array.Boolean) {
+	if s.field.Type != arrow.FixedWidthTypes.Boolean || ss.field.Type != arrow.FixedWidthTypes.Boolean {
+		panic(fmt.Sprintf("series: %s: expected boolean series", method))
+	}
+	if s.Len() != ss.Len() {
+		panic(fmt.Sprintf("series: %s: series lengths do not match", method))
+	}
+	return s.Interface.(*array.Boolean), ss.Interface.(*array.Boolean)
+}
+
+// maskIndices returns the indices where the Boolean mask is true. Null values
+// of the mask are treated as false.
+func maskIndices(method string, mask Series, n int) []int {
+	if mask.field.Type != arrow.FixedWidthTypes.Boolean {
+		panic(fmt.Sprintf("series: %s: expected boolean mask", method))
+	}
+	if mask.Len() != n {
+		panic(fmt.Sprintf("series: %s: mask length does not match", method))
+	}
+
+	a := mask.Interface.(*array.Boolean)
+	indices := make([]int, 0, n)
+	for i := 0; i < a.Len(); i++ {
+		if a.IsValid(i) && a.Value(i) {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
@@ -0,0 +1,282 @@
+package series
+
+import (
+	"fmt"
+
+	"github.com/apache/arrow/go/arrow"
+)
+
+// CUMULATIVE
+
+// CumOp is a cumulative operation.
+type CumOp int
+
+const (
+	// OpCumSum is the running sum of the values.
+	OpCumSum CumOp = iota
+	// OpCumProd is the running product of the values.
+	OpCumProd
+	// OpCumMin is the running minimum of the values.
+	OpCumMin
+	// OpCumMax is the running maximum of the values.
+	OpCumMax
+	// OpCumCount is the running number of non null values.
+	OpCumCount
+)
+
+// name returns the name of the operation used in panics.
+func (op CumOp) name() string {
+	switch op {
+	case OpCumSum:
+		return "cum_sum"
+	case OpCumProd:
+		return "cum_prod"
+	case OpCumMin:
+		return "cum_min"
+	case OpCumMax:
+		return "cum_max"
+	case OpCumCount:
+		return "cum_count"
+	}
+	panic(fmt.Sprintf("series: unknown cumulative operation %d", op))
+}
+
+// funcs returns the functions combining the running value with the next value
+// for each promoted type.
+func (op CumOp) funcs() arithFuncs {
+	switch op {
+	case OpCumSum:
+		return OpAdd.funcs(nil)
+	case OpCumProd:
+		return OpMultiply.funcs(nil)
+	case OpCumMin:
+		return arithFuncs{
+			i: func(x, y int64) (int64, bool) {
+				if y < x {
+					return y, true
+				}
+				return x, true
+			},
+			u: func(x, y uint64) (uint64, bool) {
+				if y < x {
+					return y, true
+				}
+				return x, true
+			},
+			f: func(x, y float64) (float64, bool) {
+				if y < x {
+					return y, true
+				}
+				return x, true
+			},
+		}
+	case OpCumMax:
+		return arithFuncs{
+			i: func(x, y int64) (int64, bool) {
+				if y > x {
+					return y, true
+				}
+				return x, true
+			},
+			u: func(x, y uint64) (uint64, bool) {
+				if y > x {
+					return y, true
+				}
+				return x, true
+			},
+			f: func(x, y float64) (float64, bool) {
+				if y > x {
+					return y, true
+				}
+				return x, true
+			},
+		}
+	}
+	panic(fmt.Sprintf("series: unknown cumulative operation %d", op))
+}
+
+// CumSum returns the running sum of a numeric Series, as in Cumulative.
+func (s Series) CumSum(opts ...AggOption) Series {
+	return s.Cumulative(OpCumSum, opts...)
+}
+
+// CumProd returns the running product of a numeric Series, as in Cumulative.
+func (s Series) CumProd(opts ...AggOption) Series {
+	return s.Cumulative(OpCumProd, opts...)
+}
+
+// CumMin returns the running minimum of a numeric Series, as in Cumulative.
+func (s Series) CumMin(opts ...AggOption) Series {
+	return s.Cumulative(OpCumMin, opts...)
+}
+
+// CumMax returns the running maximum of a numeric Series, as in Cumulative.
+func (s Series) CumMax(opts ...AggOption) Series {
+	return s.Cumulative(OpCumMax, opts...)
+}
+
+// CumCount returns an Int64 Series of the number of non null values up to and
+// including each position.
+func (s Series) CumCount() Series {
+	return s.Cumulative(OpCumCount)
+}
+
+// Cumulative returns a Series of the running result of the operation op over
+// the values of a numeric Series. Values are promoted as in Multiply. Null
+// values stay null and are skipped, unless set otherwise by WithSkipNA in
+// which case every value from the first null on is null. OpCumCount accepts a
+// Series of any type and is never null.
+func (s Series) Cumulative(op CumOp, opts ...AggOption) Series {
+	s.Retain()
+	defer s.Release()
+
+	return cumulative(op, s, nil, 1, newAggConfig(opts...))
+}
+
+// CumulativeBy returns a Series of the running result of the operation op as
+// in Cumulative, computed separately for each group of rows with equal values
+// in the keys Series. Rows with a null key are null.
+func (s Series) CumulativeBy(op CumOp, keys Series, opts ...AggOption) Series {
+	s.Retain()
+	defer s.Release()
+	keys.Retain()
+	defer keys.Release()
+
+	if s.Len() != keys.Len() {
+		panic(fmt.Sprintf("series: %s: series lengths do not match", op.name()))
+	}
+	groups, n := groupIDs(keys)
+	return cumulative(op, s, groups, n, newAggConfig(opts...))
+}
+
+// groupIDs returns the group of every value of the keys Series numbered in
+// order of first appearance, with -1 for nulls, and the number of groups.
+func groupIDs(keys Series) ([]int, int) {
+	ids := make(map[string]int)
+	groups := make([]int, keys.Len())
+	for i, k := range keys.StringValues() {
+		if keys.IsNull(i) {
+			groups[i] = -1
+			continue
+		}
+		id, ok := ids[k]
+		if !ok {
+			id = len(ids)
+			ids[k] = id
+		}
+		groups[i] = id
+	}
+	return groups, len(ids)
+}
+
+// cumulative returns the running result of the operation op over the values
+// of the s Series within each of the n groups, where groups holds the group
+// of every value with -1 for none, or is nil for a single group.
+func cumulative(op CumOp, s Series, groups []int, n int, cfg *aggConfig) Series {
+	group := func(i int) int {
+		if groups == nil {
+			return 0
+		}
+		return groups[i]
+	}
+
+	f := s.field
+	if op == OpCumCount {
+		counts := make([]int64, n)
+		vals := make([]int64, s.Len())
+		valid := make([]bool, s.Len())
+		for i := range vals {
+			g := group(i)
+			if g < 0 {
+				continue
+			}
+			if s.IsValid(i) {
+				counts[g]++
+			}
+			vals[i], valid[i] = counts[g], true
+		}
+		f.Type = arrow.PrimitiveTypes.Int64
+		return FromInt64(s.pool, f, vals, valid)
+	}
+
+	method := op.name()
+	t := promotedType(s.field.Type)
+	if t == nil {
+		panic(fmt.Sprintf("series: %s: unsupported type", method))
+	}
+	fns := op.funcs()
+
+	// NOTE: valid marks the positions holding a running value and first marks
+	// the positions starting their group.
+	valid := make([]bool, s.Len())
+	first := make([]bool, s.Len())
+	started := make([]bool, n)
+	broken := make([]bool, n)
+	for i := range valid {
+		g := group(i)
+		if g < 0 || broken[g] {
+			continue
+		}
+		if s.IsNull(i) {
+			broken[g] = !cfg.skipNA
+			continue
+		}
+		valid[i], first[i] = true, !started[g]
+		started[g] = true
+	}
+
+	f.Type = t
+	switch t {
+	case arrow.PrimitiveTypes.Int64:
+		xs := int64Values(s)
+		acc := make([]int64, n)
+		vals := make([]int64, len(xs))
+		for i, x := range xs {
+			if !valid[i] {
+				continue
+			}
+			g := group(i)
+			if first[i] {
+				acc[g] = x
+			} else {
+				acc[g], _ = fns.i(acc[g], x)
+			}
+			vals[i] = acc[g]
+		}
+		return FromInt64(s.pool, f, vals, valid)
+	case arrow.PrimitiveTypes.Uint64:
+		xs := uint64Values(s)
+		acc := make([]uint64, n)
+		vals := make([]uint64, len(xs))
+		for i, x := range xs {
+			if !valid[i] {
+				continue
+			}
+			g := group(i)
+			if first[i] {
+				acc[g] = x
+			} else {
+				acc[g], _ = fns.u(acc[g], x)
+			}
+			vals[i] = acc[g]
+		}
+		return FromUint64(s.pool, f, vals, valid)
+	default:
+		xs := float64Values(s)
+		acc := make([]float64, n)
+		vals := make([]float64, len(xs))
+		for i, x := range xs {
+			if !valid[i] {
+				continue
+			}
+			g := group(i)
+			if first[i] {
+				acc[g] = x
+			} else {
+				acc[g], _ = fns.f(acc[g], x)
+			}
+			vals[i] = acc[g]
+		}
+		return FromFloat64(s.pool, f, vals, valid)
+	}
+}
@@ -0,0 +1,216 @@
+package series
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+)
+
+// DATETIME
+
+// CalendarUnit is a calendar unit which Date and Timestamp values can be
+// truncated to.
+type CalendarUnit int
+
+const (
+	// UnitYear truncates to the first day of the year.
+	UnitYear CalendarUnit = iota
+	// UnitMonth truncates to the first day of the month.
+	UnitMonth
+	// UnitWeek truncates to the Monday of the week.
+	UnitWeek
+	// UnitDay truncates to midnight.
+	UnitDay
+	// UnitHour truncates to the start of the hour.
+	UnitHour
+	// UnitMinute truncates to the start of the minute.
+	UnitMinute
+	// UnitSecond truncates to the start of the second.
+	UnitSecond
+)
+
+// DatetimeAccessor exposes calendar fields and rounding of a Date or Timestamp
+// Series. Calendar fields are taken in the time zone of a Timestamp and in UTC
+// for Dates. Every method returns a new Series with the name of the Series,
+// where null values stay null.
+type DatetimeAccessor struct {
+	s Series
+}
+
+// Dt returns a DatetimeAccessor of a Date or Timestamp Series. A panic is
+// triggered for other Series.
+func (s Series) Dt() DatetimeAccessor {
+	switch s.field.Type.ID() {
+	case arrow.DATE32, arrow.DATE64, arrow.TIMESTAMP:
+		return DatetimeAccessor{s: s}
+	default:
+		panic("series: dt: unsupported type")
+	}
+}
+
+// Year returns an Int32 Series of the years.
+func (dt DatetimeAccessor) Year() Series {
+	return dt.field("year", func(v time.Time) int32 { return int32(v.Year()) })
+}
+
+// Month returns an Int32 Series of the months, from 1 for January to 12.
+func (dt DatetimeAccessor) Month() Series {
+	return dt.field("month", func(v time.Time) int32 { return int32(v.Month()) })
+}
+
+// Day returns an Int32 Series of the days of the month, from 1.
+func (dt DatetimeAccessor) Day() Series {
+	return dt.field("day", func(v time.Time) int32 { return int32(v.Day()) })
+}
+
+// Weekday returns an Int32 Series of the days of the week, from 0 for Sunday
+// to 6, as time.Weekday.
+func (dt DatetimeAccessor) Weekday() Series {
+	return dt.field("weekday", func(v time.Time) int32 { return int32(v.Weekday()) })
+}
+
+// Hour returns an Int32 Series of the hours, from 0 to 23.
+func (dt DatetimeAccessor) Hour() Series {
+	return dt.field("hour", func(v time.Time) int32 { return int32(v.Hour()) })
+}
+
+// Minute returns an Int32 Series of the minutes, from 0 to 59.
+func (dt DatetimeAccessor) Minute() Series {
+	return dt.field("minute", func(v time.Time) int32 { return int32(v.Minute()) })
+}
+
+// Second returns an Int32 Series of the seconds, from 0 to 59.
+func (dt DatetimeAccessor) Second() Series {
+	return dt.field("second", func(v time.Time) int32 { return int32(v.Second()) })
+}
+
+// field returns an Int32 Series holding fn of every value.
+func (dt DatetimeAccessor) field(method string, fn func(time.Time) int32) Series {
+	s := dt.s
+	s.Retain()
+	defer s.Release()
+
+	at := timeAccessor(method, s)
+	vals := make([]int32, s.Len())
+	valid := make([]bool, s.Len())
+	for i := range vals {
+		if s.IsNull(i) {
+			continue
+		}
+		vals[i] = fn(at(i))
+		valid[i] = true
+	}
+
+	f := arrow.Field{Name: s.field.Name, Type: arrow.PrimitiveTypes.Int32, Nullable: s.field.Nullable}
+	return FromInt32(s.pool, f, vals, valid)
+}
+
+// Truncate returns a Series of the same type with values truncated to the
+// start of the calendar unit, in the time zone of a Timestamp.
+func (dt DatetimeAccessor) Truncate(unit CalendarUnit) Series {
+	return dt.mapTime("truncate", func(v time.Time) time.Time {
+		y, m, d := v.Date()
+		loc := v.Location()
+		switch unit {
+		case UnitYear:
+			return time.Date(y, time.January, 1, 0, 0, 0, 0, loc)
+		case UnitMonth:
+			return time.Date(y, m, 1, 0, 0, 0, 0, loc)
+		case UnitWeek:
+			// NOTE: weeks start on Monday as in ISO 8601.
+			offset := (int(v.Weekday()) + 6) % 7
+			return time.Date(y, m, d-offset, 0, 0, 0, 0, loc)
+		case UnitDay:
+			return time.Date(y, m, d, 0, 0, 0, 0, loc)
+		case UnitHour:
+			return time.Date(y, m, d, v.Hour(), 0, 0, 0, loc)
+		case UnitMinute:
+			return time.Date(y, m, d, v.Hour(), v.Minute(), 0, 0, loc)
+		case UnitSecond:
+			return time.Date(y, m, d, v.Hour(), v.Minute(), v.Second(), 0, loc)
+		default:
+			panic(fmt.Sprintf("series: truncate: unknown calendar unit %d", unit))
+		}
+	})
+}
+
+// Floor returns a Series of the same type with values rounded down to a
+// multiple of d since the UNIX epoch. A panic is triggered if d is not
+// positive.
+func (dt DatetimeAccessor) Floor(d time.Duration) Series {
+	if d <= 0 {
+		panic("series: floor: duration must be positive")
+	}
+	return dt.mapTime("floor", func(v time.Time) time.Time {
+		return v.Add(-epochMod(v, d))
+	})
+}
+
+// Ceil returns a Series of the same type with values rounded up to a multiple
+// of d since the UNIX epoch. A panic is triggered if d is not positive.
+func (dt DatetimeAccessor) Ceil(d time.Duration) Series {
+	if d <= 0 {
+		panic("series: ceil: duration must be positive")
+	}
+	return dt.mapTime("ceil", func(v time.Time) time.Time {
+		if r := epochMod(v, d); r != 0 {
+			return v.Add(d - r)
+		}
+		return v
+	})
+}
+
+// epochMod returns the non negative remainder of the time elapsed between the
+// UNIX epoch and v divided by d.
+func epochMod(v time.Time, d time.Duration) time.Duration {
+	r := time.Duration(v.UnixNano()) % d
+	if r < 0 {
+		r += d
+	}
+	return r
+}
+
+// mapTime returns a Series of the same type holding fn of every value.
+func (dt DatetimeAccessor) mapTime(method string, fn func(time.Time) time.Time) Series {
+	s := dt.s
+	s.Retain()
+	defer s.Release()
+
+	at := timeAccessor(method, s)
+	b := newTemporalBuilder(s.pool, s.field.Type)
+	defer b.Release()
+	b.Reserve(s.Len())
+	for i := 0; i < s.Len(); i++ {
+		if s.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		appendTemporal(b, timeToTemporal(fn(at(i)), s.field.Type))
+	}
+
+	return FromArrow(s.pool, s.field, b.NewArray())
+}
+
+// ConvertTimeZone returns a Timestamp Series holding the same instants in the
+// time zone tz, which changes the calendar fields of the values. A panic is
+// triggered if the Series is not a Timestamp Series or tz is unknown.
+func (dt DatetimeAccessor) ConvertTimeZone(tz string) Series {
+	s := dt.s
+	s.Retain()
+	defer s.Release()
+
+	t, ok := s.field.Type.(*arrow.TimestampType)
+	if !ok {
+		panic("series: convert_time_zone: unsupported type")
+	}
+	timeLocation("convert_time_zone", tz)
+
+	f := s.field
+	f.Type = &arrow.TimestampType{Unit: t.Unit, TimeZone: tz}
+	data := array.NewData(f.Type, s.Len(), s.Data().Buffers(), nil, s.NullN(), s.Data().Offset())
+	defer data.Release()
+
+	return FromArrow(s.pool, f, array.MakeFromData(data))
+}
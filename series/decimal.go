@@ -0,0 +1,381 @@
+package series
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/decimal128"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// DECIMAL
+
+// MaxDecimalPrecision is the largest precision of a Decimal128 type.
+const MaxDecimalPrecision = 38
+
+// FromDecimal128 creates a Series from a slice of Decimal128 values, which are
+// integers scaled by the scale of the field type, which must be a Decimal128
+// type.
+func FromDecimal128(pool memory.Allocator, field arrow.Field, vals []decimal128.Num, valid []bool) Series {
+	t, ok := field.Type.(*arrow.Decimal128Type)
+	if !ok {
+		panic("series: from_decimal128: field type must be a decimal128 type")
+	}
+
+	b := array.NewDecimal128Builder(pool, t)
+	defer b.Release()
+	b.AppendValues(vals, valid)
+
+	return Series{
+		pool:      pool,
+		field:     field,
+		Interface: b.NewArray(),
+	}
+}
+
+// DecimalSum returns the exact sum of the valid values of a Decimal128 Series
+// in the scale of the Series. A panic is triggered for other Series or if the
+// sum does not fit in the precision of the Series.
+func (s Series) DecimalSum() decimal128.Num {
+	s.Retain()
+	defer s.Release()
+
+	a, ok := s.Interface.(*array.Decimal128)
+	if !ok {
+		panic("series: decimal_sum: unsupported type")
+	}
+	t := s.field.Type.(*arrow.Decimal128Type)
+
+	sum := decimalSum(a)
+	n, ok := bigToDecimal(sum, t.Precision)
+	if !ok {
+		panic("series: decimal_sum: sum overflows precision")
+	}
+	return n
+}
+
+// decimalSum returns the exact sum of the valid values of a Decimal128 array.
+func decimalSum(a *array.Decimal128) *big.Int {
+	sum := new(big.Int)
+	for i, v := range a.Values() {
+		if a.IsNull(i) {
+			continue
+		}
+		sum.Add(sum, decimalToBig(v))
+	}
+	return sum
+}
+
+// decimalToBig returns the Decimal128 value n as an integer.
+func decimalToBig(n decimal128.Num) *big.Int {
+	b := big.NewInt(n.HighBits())
+	b.Lsh(b, 64)
+	return b.Add(b, new(big.Int).SetUint64(n.LowBits()))
+}
+
+// bigToDecimal returns the integer b as a Decimal128 value, or false if b has
+// more digits than precision.
+func bigToDecimal(b *big.Int, precision int32) (decimal128.Num, bool) {
+	if new(big.Int).Abs(b).Cmp(pow10(precision)) >= 0 {
+		return decimal128.Num{}, false
+	}
+
+	lo := new(big.Int).And(b, new(big.Int).SetUint64(^uint64(0)))
+	hi := new(big.Int).Rsh(b, 64)
+	return decimal128.New(hi.Int64(), lo.Uint64()), true
+}
+
+// pow10 returns 10 to the power of n.
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// rescaleDecimal returns the integer b of scale from in scale to, or false if
+// digits would be lost.
+func rescaleDecimal(b *big.Int, from, to int32) (*big.Int, bool) {
+	switch {
+	case to > from:
+		return new(big.Int).Mul(b, pow10(to-from)), true
+	case to < from:
+		q, r := new(big.Int).QuoRem(b, pow10(from-to), new(big.Int))
+		return q, r.Sign() == 0
+	default:
+		return b, true
+	}
+}
+
+// decimalCmp compares the Decimal128 values a and b, returning -1, 0 or 1.
+func decimalCmp(a, b decimal128.Num) int {
+	switch {
+	case a.HighBits() < b.HighBits():
+		return -1
+	case a.HighBits() > b.HighBits():
+		return 1
+	case a.LowBits() < b.LowBits():
+		return -1
+	case a.LowBits() > b.LowBits():
+		return 1
+	default:
+		return 0
+	}
+}
+
+// decimalFloat64 returns the Decimal128 value n of the given scale as the
+// nearest float64.
+func decimalFloat64(n decimal128.Num, scale int32) float64 {
+	r := new(big.Rat).SetFrac(decimalToBig(n), pow10(scale))
+	f, _ := r.Float64()
+	return f
+}
+
+// FormatDecimal formats the Decimal128 value n of the given scale as a decimal
+// number with exactly scale fractional digits.
+func FormatDecimal(n decimal128.Num, scale int32) string {
+	digits := decimalToBig(n).String()
+	var sign string
+	if strings.HasPrefix(digits, "-") {
+		sign, digits = "-", digits[1:]
+	}
+	if scale <= 0 {
+		return sign + digits + strings.Repeat("0", int(-scale))
+	}
+	if pad := int(scale) + 1 - len(digits); pad > 0 {
+		digits = strings.Repeat("0", pad) + digits
+	}
+	cut := len(digits) - int(scale)
+	return sign + digits[:cut] + "." + digits[cut:]
+}
+
+// ParseDecimal parses a decimal number such as "-12.50" into a Decimal128
+// value of the type t. An error is returned if v is not a decimal number, or if
+// it has more significant fractional digits than the scale or more digits than
+// the precision of t.
+func ParseDecimal(v string, t *arrow.Decimal128Type) (decimal128.Num, error) {
+	s := v
+	var neg bool
+	switch {
+	case strings.HasPrefix(s, "-"):
+		neg, s = true, s[1:]
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	}
+	intPart, fracPart := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		intPart, fracPart = s[:i], s[i+1:]
+	}
+	if intPart == "" && fracPart == "" {
+		return decimal128.Num{}, fmt.Errorf("parsing %q: invalid decimal", v)
+	}
+	for _, r := range intPart + fracPart {
+		if r < '0' || r > '9' {
+			return decimal128.Num{}, fmt.Errorf("parsing %q: invalid decimal", v)
+		}
+	}
+
+	b, ok := new(big.Int).SetString("0"+intPart+fracPart, 10)
+	if !ok {
+		return decimal128.Num{}, fmt.Errorf("parsing %q: invalid decimal", v)
+	}
+	if neg {
+		b.Neg(b)
+	}
+	b, ok = rescaleDecimal(b, int32(len(fracPart)), t.Scale)
+	if !ok {
+		return decimal128.Num{}, fmt.Errorf("parsing %q: %w", v, errDecimalScale)
+	}
+	n, ok := bigToDecimal(b, t.Precision)
+	if !ok {
+		return decimal128.Num{}, fmt.Errorf("parsing %q: %w", v, errDecimalPrecision)
+	}
+	return n, nil
+}
+
+var (
+	errDecimalScale     = errors.New("value has more fractional digits than scale")
+	errDecimalPrecision = errors.New("value has more digits than precision")
+)
+
+// castToDecimal casts the s Series into the Decimal128 type t. Strings are
+// parsed with ParseDecimal and integers and other decimals are scaled to t. A
+// panic is triggered if a value can not be represented exactly in t. Null
+// values stay null.
+func castToDecimal(s Series, t *arrow.Decimal128Type) Series {
+	f := s.field
+	f.Type = t
+
+	var convert func(i int) (*big.Int, error)
+	switch a := s.Interface.(type) {
+	case *array.String:
+		convert = func(i int) (*big.Int, error) {
+			n, err := ParseDecimal(a.Value(i), t)
+			return decimalToBig(n), err
+		}
+	case *array.Decimal128:
+		from := s.field.Type.(*arrow.Decimal128Type).Scale
+		convert = func(i int) (*big.Int, error) {
+			b, ok := rescaleDecimal(decimalToBig(a.Value(i)), from, t.Scale)
+			if !ok {
+				return nil, fmt.Errorf("casting %s: %w", FormatDecimal(a.Value(i), from), errDecimalScale)
+			}
+			return b, nil
+		}
+	default:
+		if !isInteger(s.field.Type) {
+			panic(fmt.Sprintf("series: cast: can not cast %s to %s", s.field.Type.Name(), t.Name()))
+		}
+		convert = func(i int) (*big.Int, error) {
+			b := new(big.Int)
+			switch v := s.Value(i).(type) {
+			case uint64:
+				b.SetUint64(v)
+			default:
+				n, _ := scalarInt64(v)
+				b.SetInt64(n)
+			}
+			b, _ = rescaleDecimal(b, 0, t.Scale)
+			return b, nil
+		}
+	}
+
+	b := array.NewDecimal128Builder(s.pool, t)
+	defer b.Release()
+	b.Reserve(s.Len())
+	for i := 0; i < s.Len(); i++ {
+		if s.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		v, err := convert(i)
+		if err != nil {
+			panic(fmt.Sprintf("series: cast: %s", err))
+		}
+		n, ok := bigToDecimal(v, t.Precision)
+		if !ok {
+			panic(fmt.Sprintf("series: cast: %s", errDecimalPrecision))
+		}
+		b.Append(n)
+	}
+
+	return FromArrow(s.pool, f, b.NewArray())
+}
+
+// isInteger reports whether t is a signed or unsigned integer type.
+func isInteger(t arrow.DataType) bool {
+	switch t.ID() {
+	case arrow.INT8, arrow.INT16, arrow.INT32, arrow.INT64,
+		arrow.UINT8, arrow.UINT16, arrow.UINT32, arrow.UINT64:
+		return true
+	}
+	return false
+}
+
+// decimalArith returns a Decimal128 Series holding fn of the values of the s
+// and ss Decimal128 Series, aligned on the larger of their scales. The
+// precision of the result has one more integer digit than the larger of the
+// inputs. A null on either side gives a null.
+func decimalArith(method string, s, ss Series, fn func(x, y *big.Int) *big.Int) Series {
+	t, ok := s.field.Type.(*arrow.Decimal128Type)
+	tt, ok2 := ss.field.Type.(*arrow.Decimal128Type)
+	if !ok || !ok2 {
+		panic(fmt.Sprintf("series: %s: series types do not match", method))
+	}
+	a := s.Interface.(*array.Decimal128)
+	aa := ss.Interface.(*array.Decimal128)
+
+	scale := t.Scale
+	if tt.Scale > scale {
+		scale = tt.Scale
+	}
+	digits := t.Precision - t.Scale
+	if tt.Precision-tt.Scale > digits {
+		digits = tt.Precision - tt.Scale
+	}
+	precision := digits + scale + 1
+	if precision > MaxDecimalPrecision {
+		precision = MaxDecimalPrecision
+	}
+	rt := &arrow.Decimal128Type{Precision: precision, Scale: scale}
+
+	b := array.NewDecimal128Builder(s.pool, rt)
+	defer b.Release()
+	b.Reserve(s.Len())
+	for i := 0; i < s.Len(); i++ {
+		if a.IsNull(i) || aa.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		x, _ := rescaleDecimal(decimalToBig(a.Value(i)), t.Scale, scale)
+		y, _ := rescaleDecimal(decimalToBig(aa.Value(i)), tt.Scale, scale)
+		n, ok := bigToDecimal(fn(x, y), precision)
+		if !ok {
+			panic(fmt.Sprintf("series: %s: %s", method, errDecimalPrecision))
+		}
+		b.Append(n)
+	}
+
+	f := s.field
+	f.Type = rt
+	return FromArrow(s.pool, f, b.NewArray())
+}
+
+// decimalScalar returns a function which compares a Decimal128 value of the
+// type t with the scalar v, returning -1, 0 or 1. Decimal128 scalars are taken
+// in the scale of t and strings are parsed as decimal numbers. Nil is returned
+// if v can not be compared with decimals.
+func decimalScalar(t *arrow.Decimal128Type, v interface{}) func(decimal128.Num) int {
+	r := new(big.Rat)
+	switch sv := v.(type) {
+	case decimal128.Num:
+		r.SetFrac(decimalToBig(sv), pow10(t.Scale))
+	case string:
+		if _, ok := r.SetString(sv); !ok {
+			return nil
+		}
+	default:
+		if n, ok := scalarUint64(v); ok {
+			r.SetInt(new(big.Int).SetUint64(n))
+			break
+		}
+		if n, ok := scalarInt64(v); ok {
+			r.SetInt64(n)
+			break
+		}
+		f, ok := scalarFloat64(v)
+		if !ok || r.SetFloat64(f) == nil {
+			return nil
+		}
+	}
+
+	// NOTE: values are integers in the scale of t, so they compare with the
+	// scaled scalar as with its floor, unless it has a fractional part.
+	r.Mul(r, new(big.Rat).SetInt(pow10(t.Scale)))
+	exact := r.IsInt()
+	floor := new(big.Int).Div(r.Num(), r.Denom())
+	n, ok := bigToDecimal(floor, MaxDecimalPrecision)
+	if !ok {
+		sign := floor.Sign()
+		return func(decimal128.Num) int { return -sign }
+	}
+
+	return func(x decimal128.Num) int {
+		c := decimalCmp(x, n)
+		if exact || c > 0 {
+			return c
+		}
+		return -1
+	}
+}
+
+// decimalSortValues returns a Decimal128 Series sorted in ascending order with
+// nulls last.
+func decimalSortValues(s Series) Series {
+	a := s.Interface.(*array.Decimal128)
+
+	return s.take("sort_values", sortedIndices(s, func(i, j int) bool {
+		return decimalCmp(a.Value(i), a.Value(j)) < 0
+	}))
+}
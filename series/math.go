@@ -4,7 +4,6 @@ import (
 	gomath "math"
 
 	"github.com/apache/arrow/go/arrow/array"
-	"github.com/apache/arrow/go/arrow/math"
 	"gonum.org/v1/gonum/floats"
 )
 
@@ -84,61 +83,244 @@ func float64Add(a1, a2 *array.Float64) []float64 {
 	}
 	return res
 }
+func int8Add(a1, a2 *array.Int8) []int8 {
+	res := make([]int8, a1.Len())
+	vals1 := a1.Int8Values()
+	vals2 := a2.Int8Values()
+	for i := 0; i < a1.Len(); i++ {
+		res[i] = vals1[i] + vals2[i]
+	}
+	return res
+}
+func int16Add(a1, a2 *array.Int16) []int16 {
+	res := make([]int16, a1.Len())
+	vals1 := a1.Int16Values()
+	vals2 := a2.Int16Values()
+	for i := 0; i < a1.Len(); i++ {
+		res[i] = vals1[i] + vals2[i]
+	}
+	return res
+}
+func uint8Add(a1, a2 *array.Uint8) []uint8 {
+	res := make([]uint8, a1.Len())
+	vals1 := a1.Uint8Values()
+	vals2 := a2.Uint8Values()
+	for i := 0; i < a1.Len(); i++ {
+		res[i] = vals1[i] + vals2[i]
+	}
+	return res
+}
+func uint16Add(a1, a2 *array.Uint16) []uint16 {
+	res := make([]uint16, a1.Len())
+	vals1 := a1.Uint16Values()
+	vals2 := a2.Uint16Values()
+	for i := 0; i < a1.Len(); i++ {
+		res[i] = vals1[i] + vals2[i]
+	}
+	return res
+}
+func uint32Add(a1, a2 *array.Uint32) []uint32 {
+	res := make([]uint32, a1.Len())
+	vals1 := a1.Uint32Values()
+	vals2 := a2.Uint32Values()
+	for i := 0; i < a1.Len(); i++ {
+		res[i] = vals1[i] + vals2[i]
+	}
+	return res
+}
+func uint64Add(a1, a2 *array.Uint64) []uint64 {
+	res := make([]uint64, a1.Len())
+	vals1 := a1.Uint64Values()
+	vals2 := a2.Uint64Values()
+	for i := 0; i < a1.Len(); i++ {
+		res[i] = vals1[i] + vals2[i]
+	}
+	return res
+}
 
 // SUM
 func int32Sum(a *array.Int32) int32 {
 	var sum int32
-	for _, val := range a.Int32Values() {
-		sum += val
+	for i, val := range a.Int32Values() {
+		if a.IsValid(i) {
+			sum += val
+		}
 	}
 	return sum
 }
 func int64Sum(a *array.Int64) int64 {
-	return math.Int64.Sum(a)
+	var sum int64
+	for i, val := range a.Int64Values() {
+		if a.IsValid(i) {
+			sum += val
+		}
+	}
+	return sum
 }
 func float32Sum(a *array.Float32) float32 {
 	var sum float32
-	for _, val := range a.Float32Values() {
-		sum += val
+	for i, val := range a.Float32Values() {
+		if a.IsValid(i) {
+			sum += val
+		}
 	}
 	return sum
 }
 func float64Sum(a *array.Float64) float64 {
-	// return math.Float64.Sum(a)
-	return floats.Sum(a.Float64Values())
+	if a.NullN() == 0 {
+		return floats.Sum(a.Float64Values())
+	}
+	var sum float64
+	for i, val := range a.Float64Values() {
+		if a.IsValid(i) {
+			sum += val
+		}
+	}
+	return sum
+}
+func int8Sum(a *array.Int8) int64 {
+	var sum int64
+	for i, val := range a.Int8Values() {
+		if a.IsValid(i) {
+			sum += int64(val)
+		}
+	}
+	return sum
+}
+func int16Sum(a *array.Int16) int64 {
+	var sum int64
+	for i, val := range a.Int16Values() {
+		if a.IsValid(i) {
+			sum += int64(val)
+		}
+	}
+	return sum
+}
+func uint8Sum(a *array.Uint8) uint64 {
+	var sum uint64
+	for i, val := range a.Uint8Values() {
+		if a.IsValid(i) {
+			sum += uint64(val)
+		}
+	}
+	return sum
+}
+func uint16Sum(a *array.Uint16) uint64 {
+	var sum uint64
+	for i, val := range a.Uint16Values() {
+		if a.IsValid(i) {
+			sum += uint64(val)
+		}
+	}
+	return sum
+}
+func uint32Sum(a *array.Uint32) uint64 {
+	var sum uint64
+	for i, val := range a.Uint32Values() {
+		if a.IsValid(i) {
+			sum += uint64(val)
+		}
+	}
+	return sum
+}
+func uint64Sum(a *array.Uint64) uint64 {
+	var sum uint64
+	for i, val := range a.Uint64Values() {
+		if a.IsValid(i) {
+			sum += val
+		}
+	}
+	return sum
 }
-
-// func uint64Sum(a *array.Uint64) uint64 {
-// 	return math.Uint64.Sum(a)
-// }
 
 // MAGNITUDE
 func int32Magnitude(a *array.Int32) float64 {
 	var sum float64
-	for _, val := range a.Int32Values() {
-		sum += float64(val) * float64(val)
+	for i, val := range a.Int32Values() {
+		if a.IsValid(i) {
+			sum += float64(val) * float64(val)
+		}
 	}
 	return gomath.Sqrt(sum)
 }
 func int64Magnitude(a *array.Int64) float64 {
 	var sum float64
-	for _, val := range a.Int64Values() {
-		sum += float64(val) * float64(val)
+	for i, val := range a.Int64Values() {
+		if a.IsValid(i) {
+			sum += float64(val) * float64(val)
+		}
 	}
 	return gomath.Sqrt(sum)
 }
 func float32Magnitude(a *array.Float32) float64 {
 	var sum float64
-	for _, val := range a.Float32Values() {
-		sum += float64(val) * float64(val)
+	for i, val := range a.Float32Values() {
+		if a.IsValid(i) {
+			sum += float64(val) * float64(val)
+		}
 	}
 	return gomath.Sqrt(sum)
 }
 func float64Magnitude(a *array.Float64) float64 {
-	// return floats.Norm(a.Float64Values(), 2)
 	var sum float64
-	for _, val := range a.Float64Values() {
-		sum += val * val
+	for i, val := range a.Float64Values() {
+		if a.IsValid(i) {
+			sum += float64(val) * float64(val)
+		}
+	}
+	return gomath.Sqrt(sum)
+}
+func int8Magnitude(a *array.Int8) float64 {
+	var sum float64
+	for i, val := range a.Int8Values() {
+		if a.IsValid(i) {
+			sum += float64(val) * float64(val)
+		}
+	}
+	return gomath.Sqrt(sum)
+}
+func int16Magnitude(a *array.Int16) float64 {
+	var sum float64
+	for i, val := range a.Int16Values() {
+		if a.IsValid(i) {
+			sum += float64(val) * float64(val)
+		}
+	}
+	return gomath.Sqrt(sum)
+}
+func uint8Magnitude(a *array.Uint8) float64 {
+	var sum float64
+	for i, val := range a.Uint8Values() {
+		if a.IsValid(i) {
+			sum += float64(val) * float64(val)
+		}
+	}
+	return gomath.Sqrt(sum)
+}
+func uint16Magnitude(a *array.Uint16) float64 {
+	var sum float64
+	for i, val := range a.Uint16Values() {
+		if a.IsValid(i) {
+			sum += float64(val) * float64(val)
+		}
+	}
+	return gomath.Sqrt(sum)
+}
+func uint32Magnitude(a *array.Uint32) float64 {
+	var sum float64
+	for i, val := range a.Uint32Values() {
+		if a.IsValid(i) {
+			sum += float64(val) * float64(val)
+		}
+	}
+	return gomath.Sqrt(sum)
+}
+func uint64Magnitude(a *array.Uint64) float64 {
+	var sum float64
+	for i, val := range a.Uint64Values() {
+		if a.IsValid(i) {
+			sum += float64(val) * float64(val)
+		}
 	}
 	return gomath.Sqrt(sum)
 }
@@ -147,7 +329,10 @@ func float64Magnitude(a *array.Float64) float64 {
 func int32Unique(a *array.Int32) []int32 {
 	set := make(map[int32]struct{})
 	result := make([]int32, 0, a.Len())
-	for _, val := range a.Int32Values() {
+	for i, val := range a.Int32Values() {
+		if a.IsNull(i) {
+			continue
+		}
 		if _, ok := set[val]; !ok {
 			set[val] = struct{}{}
 			result = append(result, val)
@@ -158,7 +343,10 @@ func int32Unique(a *array.Int32) []int32 {
 func int64Unique(a *array.Int64) []int64 {
 	set := make(map[int64]struct{})
 	result := make([]int64, 0, a.Len())
-	for _, val := range a.Int64Values() {
+	for i, val := range a.Int64Values() {
+		if a.IsNull(i) {
+			continue
+		}
 		if _, ok := set[val]; !ok {
 			set[val] = struct{}{}
 			result = append(result, val)
@@ -169,7 +357,10 @@ func int64Unique(a *array.Int64) []int64 {
 func float32Unique(a *array.Float32) []float32 {
 	set := make(map[float32]struct{})
 	result := make([]float32, 0, a.Len())
-	for _, val := range a.Float32Values() {
+	for i, val := range a.Float32Values() {
+		if a.IsNull(i) {
+			continue
+		}
 		if _, ok := set[val]; !ok {
 			set[val] = struct{}{}
 			result = append(result, val)
@@ -180,7 +371,10 @@ func float32Unique(a *array.Float32) []float32 {
 func float64Unique(a *array.Float64) []float64 {
 	set := make(map[float64]struct{})
 	result := make([]float64, 0, a.Len())
-	for _, val := range a.Float64Values() {
+	for i, val := range a.Float64Values() {
+		if a.IsNull(i) {
+			continue
+		}
 		if _, ok := set[val]; !ok {
 			set[val] = struct{}{}
 			result = append(result, val)
@@ -192,6 +386,9 @@ func stringUnique(a *array.String) []string {
 	set := make(map[string]struct{})
 	result := make([]string, 0, a.Len())
 	for i := 0; i < a.Len(); i++ {
+		if a.IsNull(i) {
+			continue
+		}
 		val := a.Value(i)
 		if _, ok := set[val]; !ok {
 			set[val] = struct{}{}
@@ -200,99 +397,87 @@ func stringUnique(a *array.String) []string {
 	}
 	return result
 }
-
-// DROPINDICES
-func int32DropIndices(a *array.Int32, indices []int) []int32 {
-	result := make([]int32, a.Len()-len(indices))
-	var rc int
-	var ic int
-	vals := a.Int32Values()
-	for i, v := range vals {
-		if ic < len(indices) && i == indices[ic] {
-			ic++
+func int8Unique(a *array.Int8) []int8 {
+	set := make(map[int8]struct{})
+	result := make([]int8, 0, a.Len())
+	for i, val := range a.Int8Values() {
+		if a.IsNull(i) {
 			continue
 		}
-		result[rc] = v
-		rc++
+		if _, ok := set[val]; !ok {
+			set[val] = struct{}{}
+			result = append(result, val)
+		}
 	}
 	return result
 }
-func int64DropIndices(a *array.Int64, indices []int) []int64 {
-	result := make([]int64, a.Len()-len(indices))
-	var rc int
-	var ic int
-	vals := a.Int64Values()
-	for i, v := range vals {
-		if ic < len(indices) && i == indices[ic] {
-			ic++
+func int16Unique(a *array.Int16) []int16 {
+	set := make(map[int16]struct{})
+	result := make([]int16, 0, a.Len())
+	for i, val := range a.Int16Values() {
+		if a.IsNull(i) {
 			continue
 		}
-		result[rc] = v
-		rc++
+		if _, ok := set[val]; !ok {
+			set[val] = struct{}{}
+			result = append(result, val)
+		}
 	}
 	return result
 }
-func float32DropIndices(a *array.Float32, indices []int) []float32 {
-	result := make([]float32, a.Len()-len(indices))
-	var rc int
-	var ic int
-	vals := a.Float32Values()
-	for i, v := range vals {
-		if ic < len(indices) && i == indices[ic] {
-			ic++
+func uint8Unique(a *array.Uint8) []uint8 {
+	set := make(map[uint8]struct{})
+	result := make([]uint8, 0, a.Len())
+	for i, val := range a.Uint8Values() {
+		if a.IsNull(i) {
 			continue
 		}
-		result[rc] = v
-		rc++
+		if _, ok := set[val]; !ok {
+			set[val] = struct{}{}
+			result = append(result, val)
+		}
 	}
 	return result
 }
-func float64DropIndices(a *array.Float64, indices []int) []float64 {
-	result := make([]float64, a.Len()-len(indices))
-	var rc int
-	var ic int
-	vals := a.Float64Values()
-	for i, v := range vals {
-		if ic < len(indices) && i == indices[ic] {
-			ic++
+func uint16Unique(a *array.Uint16) []uint16 {
+	set := make(map[uint16]struct{})
+	result := make([]uint16, 0, a.Len())
+	for i, val := range a.Uint16Values() {
+		if a.IsNull(i) {
 			continue
 		}
-		result[rc] = v
-		rc++
-	}
-	return result
-}
-
-// SELECTINDICES
-func int32SelectIndices(a *array.Int32, indices []int) []int32 {
-	result := make([]int32, len(indices))
-	vals := a.Int32Values()
-	for i, j := range indices {
-		result[i] = vals[j]
-	}
-	return result
-}
-func int64SelectIndices(a *array.Int64, indices []int) []int64 {
-	result := make([]int64, len(indices))
-	vals := a.Int64Values()
-	for i, j := range indices {
-		result[i] = vals[j]
+		if _, ok := set[val]; !ok {
+			set[val] = struct{}{}
+			result = append(result, val)
+		}
 	}
 	return result
 }
-func float32SelectIndices(a *array.Float32, indices []int) []float32 {
-	result := make([]float32, len(indices))
-	vals := a.Float32Values()
-	for i, j := range indices {
-		result[i] = vals[j]
+func uint32Unique(a *array.Uint32) []uint32 {
+	set := make(map[uint32]struct{})
+	result := make([]uint32, 0, a.Len())
+	for i, val := range a.Uint32Values() {
+		if a.IsNull(i) {
+			continue
+		}
+		if _, ok := set[val]; !ok {
+			set[val] = struct{}{}
+			result = append(result, val)
+		}
 	}
 	return result
 }
-func float64SelectIndices(a *array.Float64, indices []int) []float64 {
-	result := make([]float64, len(indices))
-	vals := a.Float64Values()
-	for i, j := range indices {
-		result[i] = vals[j]
+func uint64Unique(a *array.Uint64) []uint64 {
+	set := make(map[uint64]struct{})
+	result := make([]uint64, 0, a.Len())
+	for i, val := range a.Uint64Values() {
+		if a.IsNull(i) {
+			continue
+		}
+		if _, ok := set[val]; !ok {
+			set[val] = struct{}{}
+			result = append(result, val)
+		}
 	}
 	return result
 }
@@ -325,6 +510,60 @@ func float32Subtract(a1, a2 *array.Float32) []float32 {
 	}
 	return res
 }
+func int8Subtract(a1, a2 *array.Int8) []int8 {
+	res := make([]int8, a1.Len())
+	vals1 := a1.Int8Values()
+	vals2 := a2.Int8Values()
+	for i := 0; i < a1.Len(); i++ {
+		res[i] = vals1[i] - vals2[i]
+	}
+	return res
+}
+func int16Subtract(a1, a2 *array.Int16) []int16 {
+	res := make([]int16, a1.Len())
+	vals1 := a1.Int16Values()
+	vals2 := a2.Int16Values()
+	for i := 0; i < a1.Len(); i++ {
+		res[i] = vals1[i] - vals2[i]
+	}
+	return res
+}
+func uint8Subtract(a1, a2 *array.Uint8) []uint8 {
+	res := make([]uint8, a1.Len())
+	vals1 := a1.Uint8Values()
+	vals2 := a2.Uint8Values()
+	for i := 0; i < a1.Len(); i++ {
+		res[i] = vals1[i] - vals2[i]
+	}
+	return res
+}
+func uint16Subtract(a1, a2 *array.Uint16) []uint16 {
+	res := make([]uint16, a1.Len())
+	vals1 := a1.Uint16Values()
+	vals2 := a2.Uint16Values()
+	for i := 0; i < a1.Len(); i++ {
+		res[i] = vals1[i] - vals2[i]
+	}
+	return res
+}
+func uint32Subtract(a1, a2 *array.Uint32) []uint32 {
+	res := make([]uint32, a1.Len())
+	vals1 := a1.Uint32Values()
+	vals2 := a2.Uint32Values()
+	for i := 0; i < a1.Len(); i++ {
+		res[i] = vals1[i] - vals2[i]
+	}
+	return res
+}
+func uint64Subtract(a1, a2 *array.Uint64) []uint64 {
+	res := make([]uint64, a1.Len())
+	vals1 := a1.Uint64Values()
+	vals2 := a2.Uint64Values()
+	for i := 0; i < a1.Len(); i++ {
+		res[i] = vals1[i] - vals2[i]
+	}
+	return res
+}
 
 // DOT
 func int32Dot(a1, a2 *array.Int32) int32 {
@@ -332,7 +571,9 @@ func int32Dot(a1, a2 *array.Int32) int32 {
 	vals1 := a1.Int32Values()
 	vals2 := a2.Int32Values()
 	for i := 0; i < a1.Len(); i++ {
-		res += vals1[i] * vals2[i]
+		if a1.IsValid(i) && a2.IsValid(i) {
+			res += vals1[i] * vals2[i]
+		}
 	}
 	return res
 }
@@ -341,7 +582,9 @@ func int64Dot(a1, a2 *array.Int64) int64 {
 	vals1 := a1.Int64Values()
 	vals2 := a2.Int64Values()
 	for i := 0; i < a1.Len(); i++ {
-		res += vals1[i] * vals2[i]
+		if a1.IsValid(i) && a2.IsValid(i) {
+			res += vals1[i] * vals2[i]
+		}
 	}
 	return res
 }
@@ -350,7 +593,89 @@ func float32Dot(a1, a2 *array.Float32) float32 {
 	vals1 := a1.Float32Values()
 	vals2 := a2.Float32Values()
 	for i := 0; i < a1.Len(); i++ {
-		res += vals1[i] * vals2[i]
+		if a1.IsValid(i) && a2.IsValid(i) {
+			res += vals1[i] * vals2[i]
+		}
+	}
+	return res
+}
+func float64Dot(a1, a2 *array.Float64) float64 {
+	if a1.NullN() == 0 && a2.NullN() == 0 {
+		return floats.Dot(a1.Float64Values(), a2.Float64Values())
+	}
+	var res float64
+	vals1 := a1.Float64Values()
+	vals2 := a2.Float64Values()
+	for i := 0; i < a1.Len(); i++ {
+		if a1.IsValid(i) && a2.IsValid(i) {
+			res += vals1[i] * vals2[i]
+		}
+	}
+	return res
+}
+func int8Dot(a1, a2 *array.Int8) int64 {
+	var res int64
+	vals1 := a1.Int8Values()
+	vals2 := a2.Int8Values()
+	for i := 0; i < a1.Len(); i++ {
+		if a1.IsValid(i) && a2.IsValid(i) {
+			res += int64(vals1[i]) * int64(vals2[i])
+		}
+	}
+	return res
+}
+func int16Dot(a1, a2 *array.Int16) int64 {
+	var res int64
+	vals1 := a1.Int16Values()
+	vals2 := a2.Int16Values()
+	for i := 0; i < a1.Len(); i++ {
+		if a1.IsValid(i) && a2.IsValid(i) {
+			res += int64(vals1[i]) * int64(vals2[i])
+		}
+	}
+	return res
+}
+func uint8Dot(a1, a2 *array.Uint8) uint64 {
+	var res uint64
+	vals1 := a1.Uint8Values()
+	vals2 := a2.Uint8Values()
+	for i := 0; i < a1.Len(); i++ {
+		if a1.IsValid(i) && a2.IsValid(i) {
+			res += uint64(vals1[i]) * uint64(vals2[i])
+		}
+	}
+	return res
+}
+func uint16Dot(a1, a2 *array.Uint16) uint64 {
+	var res uint64
+	vals1 := a1.Uint16Values()
+	vals2 := a2.Uint16Values()
+	for i := 0; i < a1.Len(); i++ {
+		if a1.IsValid(i) && a2.IsValid(i) {
+			res += uint64(vals1[i]) * uint64(vals2[i])
+		}
+	}
+	return res
+}
+func uint32Dot(a1, a2 *array.Uint32) uint64 {
+	var res uint64
+	vals1 := a1.Uint32Values()
+	vals2 := a2.Uint32Values()
+	for i := 0; i < a1.Len(); i++ {
+		if a1.IsValid(i) && a2.IsValid(i) {
+			res += uint64(vals1[i]) * uint64(vals2[i])
+		}
+	}
+	return res
+}
+func uint64Dot(a1, a2 *array.Uint64) uint64 {
+	var res uint64
+	vals1 := a1.Uint64Values()
+	vals2 := a2.Uint64Values()
+	for i := 0; i < a1.Len(); i++ {
+		if a1.IsValid(i) && a2.IsValid(i) {
+			res += vals1[i] * vals2[i]
+		}
 	}
 	return res
 }
@@ -384,6 +709,48 @@ func float64Square(a1 *array.Float64) []float64 {
 	}
 	return res
 }
+func int8Square(a1 *array.Int8) []int64 {
+	res := make([]int64, a1.Len())
+	for i, val := range a1.Int8Values() {
+		res[i] = int64(val) * int64(val)
+	}
+	return res
+}
+func int16Square(a1 *array.Int16) []int64 {
+	res := make([]int64, a1.Len())
+	for i, val := range a1.Int16Values() {
+		res[i] = int64(val) * int64(val)
+	}
+	return res
+}
+func uint8Square(a1 *array.Uint8) []uint64 {
+	res := make([]uint64, a1.Len())
+	for i, val := range a1.Uint8Values() {
+		res[i] = uint64(val) * uint64(val)
+	}
+	return res
+}
+func uint16Square(a1 *array.Uint16) []uint64 {
+	res := make([]uint64, a1.Len())
+	for i, val := range a1.Uint16Values() {
+		res[i] = uint64(val) * uint64(val)
+	}
+	return res
+}
+func uint32Square(a1 *array.Uint32) []uint64 {
+	res := make([]uint64, a1.Len())
+	for i, val := range a1.Uint32Values() {
+		res[i] = uint64(val) * uint64(val)
+	}
+	return res
+}
+func uint64Square(a1 *array.Uint64) []uint64 {
+	res := make([]uint64, a1.Len())
+	for i, val := range a1.Uint64Values() {
+		res[i] = uint64(val) * uint64(val)
+	}
+	return res
+}
 
 // SQRT
 func int32Sqrt(a1 *array.Int32) []float64 {
@@ -414,6 +781,48 @@ func float64Sqrt(a1 *array.Float64) []float64 {
 	}
 	return res
 }
+func int8Sqrt(a1 *array.Int8) []float64 {
+	res := make([]float64, a1.Len())
+	for i, val := range a1.Int8Values() {
+		res[i] = gomath.Sqrt(float64(val))
+	}
+	return res
+}
+func int16Sqrt(a1 *array.Int16) []float64 {
+	res := make([]float64, a1.Len())
+	for i, val := range a1.Int16Values() {
+		res[i] = gomath.Sqrt(float64(val))
+	}
+	return res
+}
+func uint8Sqrt(a1 *array.Uint8) []float64 {
+	res := make([]float64, a1.Len())
+	for i, val := range a1.Uint8Values() {
+		res[i] = gomath.Sqrt(float64(val))
+	}
+	return res
+}
+func uint16Sqrt(a1 *array.Uint16) []float64 {
+	res := make([]float64, a1.Len())
+	for i, val := range a1.Uint16Values() {
+		res[i] = gomath.Sqrt(float64(val))
+	}
+	return res
+}
+func uint32Sqrt(a1 *array.Uint32) []float64 {
+	res := make([]float64, a1.Len())
+	for i, val := range a1.Uint32Values() {
+		res[i] = gomath.Sqrt(float64(val))
+	}
+	return res
+}
+func uint64Sqrt(a1 *array.Uint64) []float64 {
+	res := make([]float64, a1.Len())
+	for i, val := range a1.Uint64Values() {
+		res[i] = gomath.Sqrt(float64(val))
+	}
+	return res
+}
 
 // ABS
 func int32Abs(a1 *array.Int32) []int32 {
@@ -444,95 +853,261 @@ func float64Abs(a1 *array.Float64) []float64 {
 	}
 	return res
 }
+func int8Abs(a1 *array.Int8) []int8 {
+	res := make([]int8, a1.Len())
+	for i, val := range a1.Int8Values() {
+		res[i] = int8(gomath.Abs(float64(val)))
+	}
+	return res
+}
+func int16Abs(a1 *array.Int16) []int16 {
+	res := make([]int16, a1.Len())
+	for i, val := range a1.Int16Values() {
+		res[i] = int16(gomath.Abs(float64(val)))
+	}
+	return res
+}
+func uint8Abs(a1 *array.Uint8) []uint8 {
+	res := make([]uint8, a1.Len())
+	copy(res, a1.Uint8Values())
+	return res
+}
+func uint16Abs(a1 *array.Uint16) []uint16 {
+	res := make([]uint16, a1.Len())
+	copy(res, a1.Uint16Values())
+	return res
+}
+func uint32Abs(a1 *array.Uint32) []uint32 {
+	res := make([]uint32, a1.Len())
+	copy(res, a1.Uint32Values())
+	return res
+}
+func uint64Abs(a1 *array.Uint64) []uint64 {
+	res := make([]uint64, a1.Len())
+	copy(res, a1.Uint64Values())
+	return res
+}
 
 // MIN
 func int32Min(a1 *array.Int32) float64 {
-	var min float64
+	min, seen := gomath.NaN(), false
 	for i, val := range a1.Int32Values() {
 		fval := float64(val)
-		if min <= fval && i != 0 {
+		if a1.IsNull(i) || (seen && min <= fval) {
 			continue
 		}
-		min = fval
+		min, seen = fval, true
 	}
 	return min
 }
 func int64Min(a1 *array.Int64) float64 {
-	var min float64
+	min, seen := gomath.NaN(), false
 	for i, val := range a1.Int64Values() {
 		fval := float64(val)
-		if min <= fval && i != 0 {
+		if a1.IsNull(i) || (seen && min <= fval) {
 			continue
 		}
-		min = fval
+		min, seen = fval, true
 	}
 	return min
 }
 func float32Min(a1 *array.Float32) float64 {
-	var min float64
+	min, seen := gomath.NaN(), false
 	for i, val := range a1.Float32Values() {
 		fval := float64(val)
-		if min <= fval && i != 0 {
+		if a1.IsNull(i) || gomath.IsNaN(fval) || (seen && min <= fval) {
 			continue
 		}
-		min = fval
+		min, seen = fval, true
 	}
 	return min
 }
 func float64Min(a1 *array.Float64) float64 {
-	var min float64
+	min, seen := gomath.NaN(), false
 	for i, val := range a1.Float64Values() {
 		fval := float64(val)
-		if min <= fval && i != 0 {
+		if a1.IsNull(i) || gomath.IsNaN(fval) || (seen && min <= fval) {
+			continue
+		}
+		min, seen = fval, true
+	}
+	return min
+}
+func int8Min(a1 *array.Int8) float64 {
+	min, seen := gomath.NaN(), false
+	for i, val := range a1.Int8Values() {
+		fval := float64(val)
+		if a1.IsNull(i) || (seen && min <= fval) {
+			continue
+		}
+		min, seen = fval, true
+	}
+	return min
+}
+func int16Min(a1 *array.Int16) float64 {
+	min, seen := gomath.NaN(), false
+	for i, val := range a1.Int16Values() {
+		fval := float64(val)
+		if a1.IsNull(i) || (seen && min <= fval) {
 			continue
 		}
-		min = fval
+		min, seen = fval, true
+	}
+	return min
+}
+func uint8Min(a1 *array.Uint8) float64 {
+	min, seen := gomath.NaN(), false
+	for i, val := range a1.Uint8Values() {
+		fval := float64(val)
+		if a1.IsNull(i) || (seen && min <= fval) {
+			continue
+		}
+		min, seen = fval, true
+	}
+	return min
+}
+func uint16Min(a1 *array.Uint16) float64 {
+	min, seen := gomath.NaN(), false
+	for i, val := range a1.Uint16Values() {
+		fval := float64(val)
+		if a1.IsNull(i) || (seen && min <= fval) {
+			continue
+		}
+		min, seen = fval, true
+	}
+	return min
+}
+func uint32Min(a1 *array.Uint32) float64 {
+	min, seen := gomath.NaN(), false
+	for i, val := range a1.Uint32Values() {
+		fval := float64(val)
+		if a1.IsNull(i) || (seen && min <= fval) {
+			continue
+		}
+		min, seen = fval, true
+	}
+	return min
+}
+func uint64Min(a1 *array.Uint64) float64 {
+	min, seen := gomath.NaN(), false
+	for i, val := range a1.Uint64Values() {
+		fval := float64(val)
+		if a1.IsNull(i) || (seen && min <= fval) {
+			continue
+		}
+		min, seen = fval, true
 	}
 	return min
 }
 
 // MAX
 func int32Max(a1 *array.Int32) float64 {
-	var max float64
+	max, seen := gomath.NaN(), false
 	for i, val := range a1.Int32Values() {
 		fval := float64(val)
-		if max >= fval && i != 0 {
+		if a1.IsNull(i) || (seen && max >= fval) {
 			continue
 		}
-		max = fval
+		max, seen = fval, true
 	}
 	return max
 }
 func int64Max(a1 *array.Int64) float64 {
-	var max float64
+	max, seen := gomath.NaN(), false
 	for i, val := range a1.Int64Values() {
 		fval := float64(val)
-		if max >= fval && i != 0 {
+		if a1.IsNull(i) || (seen && max >= fval) {
 			continue
 		}
-		max = fval
+		max, seen = fval, true
 	}
 	return max
 }
 func float32Max(a1 *array.Float32) float64 {
-	var max float64
+	max, seen := gomath.NaN(), false
 	for i, val := range a1.Float32Values() {
 		fval := float64(val)
-		if max >= fval && i != 0 {
+		if a1.IsNull(i) || gomath.IsNaN(fval) || (seen && max >= fval) {
 			continue
 		}
-		max = fval
+		max, seen = fval, true
 	}
 	return max
 }
 func float64Max(a1 *array.Float64) float64 {
-	var max float64
+	max, seen := gomath.NaN(), false
 	for i, val := range a1.Float64Values() {
 		fval := float64(val)
-		if max >= fval && i != 0 {
+		if a1.IsNull(i) || gomath.IsNaN(fval) || (seen && max >= fval) {
+			continue
+		}
+		max, seen = fval, true
+	}
+	return max
+}
+func int8Max(a1 *array.Int8) float64 {
+	max, seen := gomath.NaN(), false
+	for i, val := range a1.Int8Values() {
+		fval := float64(val)
+		if a1.IsNull(i) || (seen && max >= fval) {
+			continue
+		}
+		max, seen = fval, true
+	}
+	return max
+}
+func int16Max(a1 *array.Int16) float64 {
+	max, seen := gomath.NaN(), false
+	for i, val := range a1.Int16Values() {
+		fval := float64(val)
+		if a1.IsNull(i) || (seen && max >= fval) {
+			continue
+		}
+		max, seen = fval, true
+	}
+	return max
+}
+func uint8Max(a1 *array.Uint8) float64 {
+	max, seen := gomath.NaN(), false
+	for i, val := range a1.Uint8Values() {
+		fval := float64(val)
+		if a1.IsNull(i) || (seen && max >= fval) {
 			continue
 		}
-		max = fval
+		max, seen = fval, true
+	}
+	return max
+}
+func uint16Max(a1 *array.Uint16) float64 {
+	max, seen := gomath.NaN(), false
+	for i, val := range a1.Uint16Values() {
+		fval := float64(val)
+		if a1.IsNull(i) || (seen && max >= fval) {
+			continue
+		}
+		max, seen = fval, true
+	}
+	return max
+}
+func uint32Max(a1 *array.Uint32) float64 {
+	max, seen := gomath.NaN(), false
+	for i, val := range a1.Uint32Values() {
+		fval := float64(val)
+		if a1.IsNull(i) || (seen && max >= fval) {
+			continue
+		}
+		max, seen = fval, true
+	}
+	return max
+}
+func uint64Max(a1 *array.Uint64) float64 {
+	max, seen := gomath.NaN(), false
+	for i, val := range a1.Uint64Values() {
+		fval := float64(val)
+		if a1.IsNull(i) || (seen && max >= fval) {
+			continue
+		}
+		max, seen = fval, true
 	}
 	return max
 }
@@ -540,29 +1115,91 @@ func float64Max(a1 *array.Float64) float64 {
 // MEAN
 func int32Mean(a1 *array.Int32) float64 {
 	var total float64
-	for _, val := range a1.Int32Values() {
-		total += float64(val)
+	for i, val := range a1.Int32Values() {
+		if a1.IsValid(i) {
+			total += float64(val)
+		}
 	}
-	return total / float64(a1.Len())
+	return total / float64(a1.Len()-a1.NullN())
 }
 func int64Mean(a1 *array.Int64) float64 {
 	var total float64
-	for _, val := range a1.Int64Values() {
-		total += float64(val)
+	for i, val := range a1.Int64Values() {
+		if a1.IsValid(i) {
+			total += float64(val)
+		}
 	}
-	return total / float64(a1.Len())
+	return total / float64(a1.Len()-a1.NullN())
 }
 func float32Mean(a1 *array.Float32) float64 {
 	var total float64
-	for _, val := range a1.Float32Values() {
-		total += float64(val)
+	for i, val := range a1.Float32Values() {
+		if a1.IsValid(i) {
+			total += float64(val)
+		}
 	}
-	return total / float64(a1.Len())
+	return total / float64(a1.Len()-a1.NullN())
 }
 func float64Mean(a1 *array.Float64) float64 {
 	var total float64
-	for _, val := range a1.Float64Values() {
-		total += float64(val)
+	for i, val := range a1.Float64Values() {
+		if a1.IsValid(i) {
+			total += float64(val)
+		}
+	}
+	return total / float64(a1.Len()-a1.NullN())
+}
+func int8Mean(a1 *array.Int8) float64 {
+	var total float64
+	for i, val := range a1.Int8Values() {
+		if a1.IsValid(i) {
+			total += float64(val)
+		}
+	}
+	return total / float64(a1.Len()-a1.NullN())
+}
+func int16Mean(a1 *array.Int16) float64 {
+	var total float64
+	for i, val := range a1.Int16Values() {
+		if a1.IsValid(i) {
+			total += float64(val)
+		}
+	}
+	return total / float64(a1.Len()-a1.NullN())
+}
+func uint8Mean(a1 *array.Uint8) float64 {
+	var total float64
+	for i, val := range a1.Uint8Values() {
+		if a1.IsValid(i) {
+			total += float64(val)
+		}
+	}
+	return total / float64(a1.Len()-a1.NullN())
+}
+func uint16Mean(a1 *array.Uint16) float64 {
+	var total float64
+	for i, val := range a1.Uint16Values() {
+		if a1.IsValid(i) {
+			total += float64(val)
+		}
+	}
+	return total / float64(a1.Len()-a1.NullN())
+}
+func uint32Mean(a1 *array.Uint32) float64 {
+	var total float64
+	for i, val := range a1.Uint32Values() {
+		if a1.IsValid(i) {
+			total += float64(val)
+		}
+	}
+	return total / float64(a1.Len()-a1.NullN())
+}
+func uint64Mean(a1 *array.Uint64) float64 {
+	var total float64
+	for i, val := range a1.Uint64Values() {
+		if a1.IsValid(i) {
+			total += float64(val)
+		}
 	}
-	return total / float64(a1.Len())
+	return total / float64(a1.Len()-a1.NullN())
 }
@@ -0,0 +1,330 @@
+package series
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/bitutil"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// NESTED
+
+// FromList creates a List Series whose value at position i holds the elements
+// of the values Series from offsets[i] to offsets[i+1]. The field type is set
+// to a List type of the type of values. A panic is triggered if offsets do not
+// hold one more entry than valid, or are not increasing within values.
+func FromList(pool memory.Allocator, field arrow.Field, values Series, offsets []int32, valid []bool) Series {
+	if len(offsets) == 0 || (valid != nil && len(valid) != len(offsets)-1) {
+		panic("series: from_list: offsets do not match valid")
+	}
+	for i, o := range offsets {
+		if o < 0 || int(o) > values.Len() || (i > 0 && o < offsets[i-1]) {
+			panic("series: from_list: offsets out of range")
+		}
+	}
+
+	field.Type = arrow.ListOf(values.DataType())
+	return FromArrow(pool, field, newListArray(pool, field.Type.(*arrow.ListType), values, offsets, valid))
+}
+
+// FromStruct creates a Struct Series with a child per Series in children,
+// which must all have the same length. The field type is set to a Struct type
+// of the fields of the children.
+func FromStruct(pool memory.Allocator, field arrow.Field, children []Series, valid []bool) Series {
+	fields := make([]arrow.Field, len(children))
+	for i, c := range children {
+		if c.Len() != children[0].Len() || (valid != nil && len(valid) != c.Len()) {
+			panic("series: from_struct: series lengths do not match")
+		}
+		fields[i] = c.Field()
+	}
+
+	field.Type = arrow.StructOf(fields...)
+	return FromArrow(pool, field, newStructArray(pool, field.Type.(*arrow.StructType), children, valid))
+}
+
+// newValidityBitmap returns a validity bitmap of valid and its number of
+// nulls, or a nil bitmap if valid is nil.
+func newValidityBitmap(pool memory.Allocator, valid []bool) (*memory.Buffer, int) {
+	if valid == nil {
+		return nil, 0
+	}
+
+	buf := memory.NewResizableBuffer(pool)
+	buf.Resize(int(bitutil.CeilByte(len(valid)) / 8))
+	var nullN int
+	for i, v := range valid {
+		if v {
+			bitutil.SetBit(buf.Bytes(), i)
+			continue
+		}
+		nullN++
+	}
+	return buf, nullN
+}
+
+// newListArray returns a List array of the values at offsets.
+func newListArray(pool memory.Allocator, t *arrow.ListType, values Series, offsets []int32, valid []bool) array.Interface {
+	bitmap, nullN := newValidityBitmap(pool, valid)
+	if bitmap != nil {
+		defer bitmap.Release()
+	}
+	offsetsBuf := memory.NewResizableBuffer(pool)
+	defer offsetsBuf.Release()
+	offsetsBuf.Resize(arrow.Int32Traits.BytesRequired(len(offsets)))
+	copy(arrow.Int32Traits.CastFromBytes(offsetsBuf.Bytes()), offsets)
+
+	data := array.NewData(t, len(offsets)-1, []*memory.Buffer{bitmap, offsetsBuf}, []*array.Data{values.Data()}, nullN, 0)
+	defer data.Release()
+
+	return array.MakeFromData(data)
+}
+
+// newStructArray returns a Struct array of the children.
+func newStructArray(pool memory.Allocator, t *arrow.StructType, children []Series, valid []bool) array.Interface {
+	bitmap, nullN := newValidityBitmap(pool, valid)
+	if bitmap != nil {
+		defer bitmap.Release()
+	}
+	childData := make([]*array.Data, len(children))
+	for i, c := range children {
+		childData[i] = c.Data()
+	}
+	var n int
+	if len(children) > 0 {
+		n = children[0].Len()
+	} else if valid != nil {
+		n = len(valid)
+	}
+
+	data := array.NewData(t, n, []*memory.Buffer{bitmap}, childData, nullN, 0)
+	defer data.Release()
+
+	return array.MakeFromData(data)
+}
+
+// list returns the array of a List Series, triggering a panic for other
+// Series.
+func list(method string, s Series) *array.List {
+	a, ok := s.Interface.(*array.List)
+	if !ok {
+		panic(fmt.Sprintf("series: %s: expected list series", method))
+	}
+	return a
+}
+
+// listBounds returns the start and end of the elements of the list at
+// position i in the values of the List array.
+func listBounds(a *array.List, i int) (int64, int64) {
+	j := i + a.Data().Offset()
+	return int64(a.Offsets()[j]), int64(a.Offsets()[j+1])
+}
+
+// ListLen returns an Int32 Series of the number of elements of every list of
+// a List Series, where null lists stay null. A panic is triggered for other
+// Series.
+func (s Series) ListLen() Series {
+	s.Retain()
+	defer s.Release()
+
+	a := list("list_len", s)
+	vals := make([]int32, s.Len())
+	valid := make([]bool, s.Len())
+	for i := range vals {
+		if a.IsNull(i) {
+			continue
+		}
+		beg, end := listBounds(a, i)
+		vals[i] = int32(end - beg)
+		valid[i] = true
+	}
+
+	f := arrow.Field{Name: s.field.Name, Type: arrow.PrimitiveTypes.Int32, Nullable: s.field.Nullable}
+	return FromInt32(s.pool, f, vals, valid)
+}
+
+// ListValue returns a Series of the elements of the list at position i of a
+// List Series, which is empty for a null list. A panic is triggered for other
+// Series.
+func (s Series) ListValue(i int) Series {
+	a := list("list_value", s)
+	beg, end := listBounds(a, i)
+	if a.IsNull(i) {
+		end = beg
+	}
+
+	f := arrow.Field{Name: s.field.Name, Type: a.ListValues().DataType(), Nullable: true}
+	return FromArrow(s.pool, f, array.NewSlice(a.ListValues(), beg, end))
+}
+
+// ListValues returns a Series of the elements of every list of a List Series
+// in order, so that the elements of a list follow those of the previous list.
+// A panic is triggered for other Series.
+func (s Series) ListValues() Series {
+	a := list("list_values", s)
+	var beg, end int64
+	if s.Len() > 0 {
+		beg, _ = listBounds(a, 0)
+		_, end = listBounds(a, s.Len()-1)
+	}
+
+	f := arrow.Field{Name: s.field.Name, Type: a.ListValues().DataType(), Nullable: true}
+	return FromArrow(s.pool, f, array.NewSlice(a.ListValues(), beg, end))
+}
+
+// StructField returns the child Series called name of a Struct Series, where
+// values of null structs are null. A panic is triggered for other Series or if
+// there is no child called name.
+func (s Series) StructField(name string) Series {
+	s.Retain()
+	defer s.Release()
+
+	a, ok := s.Interface.(*array.Struct)
+	if !ok {
+		panic("series: struct_field: expected struct series")
+	}
+	t := s.field.Type.(*arrow.StructType)
+	k := -1
+	for i, f := range t.Fields() {
+		if f.Name == name {
+			k = i
+			break
+		}
+	}
+	if k < 0 {
+		panic(fmt.Sprintf("series: struct_field: unknown field %q", name))
+	}
+
+	child := a.Field(k)
+	child.Retain()
+	cs := FromArrow(s.pool, t.Field(k), child)
+	if a.NullN() == 0 {
+		return cs
+	}
+	defer cs.Release()
+
+	indices := make([]int, s.Len())
+	for i := range indices {
+		indices[i] = i
+		if a.IsNull(i) {
+			indices[i] = -1
+		}
+	}
+	return cs.take("struct_field", indices)
+}
+
+// nestedValue returns the value at position i of a List or Struct Series, as a
+// []interface{} of the elements of a list or a map[string]interface{} of the
+// fields of a struct, with nil for nulls.
+func nestedValue(s Series, i int) (interface{}, bool) {
+	if s.IsNull(i) {
+		switch s.Interface.(type) {
+		case *array.List, *array.Struct:
+			return nil, true
+		}
+		return nil, false
+	}
+
+	switch a := s.Interface.(type) {
+	case *array.List:
+		elems := s.ListValue(i)
+		defer elems.Release()
+		v := make([]interface{}, elems.Len())
+		for j := range v {
+			if elems.IsValid(j) {
+				v[j] = elems.Value(j)
+			}
+		}
+		return v, true
+	case *array.Struct:
+		t := s.field.Type.(*arrow.StructType)
+		v := make(map[string]interface{}, a.NumField())
+		for k, f := range t.Fields() {
+			child := FromArrow(s.pool, f, a.Field(k))
+			if child.IsValid(i) {
+				v[f.Name] = child.Value(i)
+			}
+		}
+		return v, true
+	}
+	return nil, false
+}
+
+// nestedValues returns the values of a List or Struct Series.
+func nestedValues(s Series) (interface{}, bool) {
+	switch s.Interface.(type) {
+	case *array.List:
+		vals := make([][]interface{}, s.Len())
+		for i := range vals {
+			v, _ := nestedValue(s, i)
+			vals[i], _ = v.([]interface{})
+		}
+		return vals, true
+	case *array.Struct:
+		vals := make([]map[string]interface{}, s.Len())
+		for i := range vals {
+			v, _ := nestedValue(s, i)
+			vals[i], _ = v.(map[string]interface{})
+		}
+		return vals, true
+	}
+	return nil, false
+}
+
+// nestedFormatter returns a function which formats the value at position i of
+// a List or Struct Series as JSON, or nil for other Series.
+func nestedFormatter(s Series) func(i int) string {
+	switch s.Interface.(type) {
+	case *array.List, *array.Struct:
+		return func(i int) string {
+			v, _ := nestedValue(s, i)
+			b, err := json.Marshal(v)
+			if err != nil {
+				return fmt.Sprint(v)
+			}
+			return string(b)
+		}
+	}
+	return nil
+}
+
+// nestedTake returns an array of the values of a List or Struct Series at
+// indices, or nil for other Series.
+func nestedTake(s Series, method string, indices []int) array.Interface {
+	valid := make([]bool, len(indices))
+	for k, i := range indices {
+		valid[k] = i >= 0 && s.IsValid(i)
+	}
+
+	switch a := s.Interface.(type) {
+	case *array.List:
+		elems := FromArrow(s.pool, arrow.Field{Name: "item", Type: a.ListValues().DataType(), Nullable: true}, a.ListValues())
+		offsets := make([]int32, 1, len(indices)+1)
+		var elemIndices []int
+		for k, i := range indices {
+			if valid[k] {
+				beg, end := listBounds(a, i)
+				for j := beg; j < end; j++ {
+					elemIndices = append(elemIndices, int(j))
+				}
+			}
+			offsets = append(offsets, int32(len(elemIndices)))
+		}
+		taken := elems.take(method, elemIndices)
+		defer taken.Release()
+		return newListArray(s.pool, s.field.Type.(*arrow.ListType), taken, offsets, valid)
+	case *array.Struct:
+		t := s.field.Type.(*arrow.StructType)
+		children := make([]Series, a.NumField())
+		for k, f := range t.Fields() {
+			child := FromArrow(s.pool, f, a.Field(k))
+			children[k] = child.take(method, indices)
+			defer children[k].Release()
+		}
+		return newStructArray(s.pool, t, children, valid)
+	}
+	return nil
+}
@@ -0,0 +1,529 @@
+package series
+
+import (
+	"container/heap"
+	"math"
+	"time"
+
+	"github.com/apache/arrow/go/arrow"
+)
+
+// ROLLING
+
+// Rolling is a moving window over the values of a numeric Series, created by
+// Series.Rolling or Series.RollingTime. Its aggregations return a Float64
+// Series holding, at every position, the aggregation of the valid values of
+// the window ending at that position. A window with fewer valid values than
+// the minimum number of periods gives null. A Rolling uses the Series it was
+// created from, which must not be released before the Rolling is used.
+type Rolling struct {
+	s          Series
+	window     int
+	minPeriods int
+
+	// NOTE: times holds the time of every value in nanoseconds for windows
+	// spanning a duration, and is nil for windows of a number of values.
+	times []int64
+	span  int64
+}
+
+// Rolling returns a moving window over the last window values of a numeric
+// Series. Windows with fewer than minPeriods valid values give null, where
+// minPeriods is at least 1. A panic is triggered if window is not positive or
+// the Series is not numeric.
+func (s Series) Rolling(window, minPeriods int) Rolling {
+	if window <= 0 {
+		panic("series: rolling: window must be positive")
+	}
+	if promotedType(s.field.Type) == nil {
+		panic("series: rolling: unsupported type")
+	}
+
+	return Rolling{
+		s:          s,
+		window:     window,
+		minPeriods: rollingMinPeriods(minPeriods),
+	}
+}
+
+// RollingTime returns a moving window over the values of a numeric Series
+// whose time in the times Date or Timestamp Series is within the window
+// duration up to and including the time of each value, as in (t-window, t].
+// Windows with fewer than minPeriods valid values give null, where minPeriods
+// is at least 1. A panic is triggered if window is not positive, the Series is
+// not numeric, or times has a different length, nulls or times out of
+// ascending order.
+func (s Series) RollingTime(times Series, window time.Duration, minPeriods int) Rolling {
+	if window <= 0 {
+		panic("series: rolling_time: window must be positive")
+	}
+	if promotedType(s.field.Type) == nil {
+		panic("series: rolling_time: unsupported type")
+	}
+	if times.Len() != s.Len() {
+		panic("series: rolling_time: series lengths do not match")
+	}
+	if times.NullN() > 0 {
+		panic("series: rolling_time: times must not have nulls")
+	}
+
+	at := timeAccessor("rolling_time", times)
+	nanos := make([]int64, times.Len())
+	for i := range nanos {
+		nanos[i] = at(i).UnixNano()
+		if i > 0 && nanos[i] < nanos[i-1] {
+			panic("series: rolling_time: times must be in ascending order")
+		}
+	}
+
+	return Rolling{
+		s:          s,
+		minPeriods: rollingMinPeriods(minPeriods),
+		times:      nanos,
+		span:       int64(window),
+	}
+}
+
+// rollingMinPeriods returns minPeriods, with a window needing at least one
+// valid value.
+func rollingMinPeriods(minPeriods int) int {
+	if minPeriods < 1 {
+		return 1
+	}
+	return minPeriods
+}
+
+// Sum returns the sums of the windows.
+func (r Rolling) Sum() Series {
+	return r.moments(func(m *windowMoments) (float64, bool) {
+		if v, ok := m.nonFinite(); ok {
+			return v, true
+		}
+		return m.sum, true
+	})
+}
+
+// Mean returns the means of the windows.
+func (r Rolling) Mean() Series {
+	return r.moments(func(m *windowMoments) (float64, bool) {
+		if v, ok := m.nonFinite(); ok {
+			return v, true
+		}
+		return m.mean, true
+	})
+}
+
+// STD returns the sample standard deviations of the windows, which are null for
+// windows with fewer than two valid values and NaN for windows holding NaN or
+// infinite values.
+func (r Rolling) STD() Series {
+	return r.moments(func(m *windowMoments) (float64, bool) {
+		if m.n < 2 {
+			return 0, false
+		}
+		if _, ok := m.nonFinite(); ok {
+			return math.NaN(), true
+		}
+		return math.Sqrt(m.m2 / float64(m.n-1)), true
+	})
+}
+
+// Min returns the minimums of the windows, which are NaN for windows holding
+// NaN values.
+func (r Rolling) Min() Series {
+	return r.extreme(func(x, y float64) bool { return x <= y })
+}
+
+// Max returns the maximums of the windows, which are NaN for windows holding
+// NaN values.
+func (r Rolling) Max() Series {
+	return r.extreme(func(x, y float64) bool { return x >= y })
+}
+
+// Median returns the medians of the windows, which are NaN for windows holding
+// NaN values.
+func (r Rolling) Median() Series {
+	r.s.Retain()
+	defer r.s.Release()
+
+	xs, valid, starts, counts := r.prepare()
+	nans := nanCounts(xs, valid, starts)
+
+	// NOTE: the valid values of the window other than NaN are kept in two
+	// heaps with lazy deletion, so every step costs O(log window) while
+	// removed values are popped promptly and O(log n) at worst.
+	var m slidingMedian
+	res := make([]float64, len(xs))
+	ok := make([]bool, len(xs))
+	start := 0
+	for i, x := range xs {
+		if valid[i] && !math.IsNaN(x) {
+			m.add(x)
+		}
+		for ; start < starts[i]; start++ {
+			if valid[start] && !math.IsNaN(xs[start]) {
+				m.remove(xs[start])
+			}
+		}
+		if counts[i] < r.minPeriods {
+			continue
+		}
+		if nans[i] > 0 {
+			res[i], ok[i] = math.NaN(), true
+			continue
+		}
+		res[i], ok[i] = m.median(), true
+	}
+
+	return r.result(res, ok)
+}
+
+// slidingMedian keeps the values of a window in two heaps, the lower half in a
+// max heap and the upper half in a min heap, so that the median is at their
+// tops. Removed values are counted in delayed and only popped once they reach
+// the top of a heap.
+type slidingMedian struct {
+	// NOTE: lo holds the negated values of the lower half to be a max heap.
+	lo, hi         float64Heap
+	loSize, hiSize int
+	delayed        map[float64]int
+}
+
+func (m *slidingMedian) add(x float64) {
+	if m.loSize == 0 || x <= -m.lo[0] {
+		heap.Push(&m.lo, -x)
+		m.loSize++
+	} else {
+		heap.Push(&m.hi, x)
+		m.hiSize++
+	}
+	m.balance()
+}
+
+func (m *slidingMedian) remove(x float64) {
+	if m.delayed == nil {
+		m.delayed = make(map[float64]int)
+	}
+	m.delayed[x]++
+	if x <= -m.lo[0] {
+		m.loSize--
+		if x == -m.lo[0] {
+			m.prune(&m.lo, -1)
+		}
+	} else {
+		m.hiSize--
+		if x == m.hi[0] {
+			m.prune(&m.hi, 1)
+		}
+	}
+	m.balance()
+}
+
+// median returns the median of the values, of which there must be at least
+// one.
+func (m *slidingMedian) median() float64 {
+	if m.loSize > m.hiSize {
+		return -m.lo[0]
+	}
+	return (-m.lo[0] + m.hi[0]) / float64(2)
+}
+
+// balance moves a value between the heaps so that the lower half holds as
+// many values as the upper half or one more.
+func (m *slidingMedian) balance() {
+	switch {
+	case m.loSize > m.hiSize+1:
+		heap.Push(&m.hi, -heap.Pop(&m.lo).(float64))
+		m.loSize--
+		m.hiSize++
+		m.prune(&m.lo, -1)
+	case m.loSize < m.hiSize:
+		heap.Push(&m.lo, -heap.Pop(&m.hi).(float64))
+		m.hiSize--
+		m.loSize++
+		m.prune(&m.hi, 1)
+	}
+}
+
+// prune pops the removed values from the top of h, whose values are the
+// window values multiplied by sign.
+func (m *slidingMedian) prune(h *float64Heap, sign float64) {
+	for h.Len() > 0 {
+		x := sign * (*h)[0]
+		n := m.delayed[x]
+		if n == 0 {
+			return
+		}
+		if n == 1 {
+			delete(m.delayed, x)
+		} else {
+			m.delayed[x] = n - 1
+		}
+		heap.Pop(h)
+	}
+}
+
+// float64Heap is a min heap of float64 values for container/heap.
+type float64Heap []float64
+
+func (h float64Heap) Len() int            { return len(h) }
+func (h float64Heap) Less(i, j int) bool  { return h[i] < h[j] }
+func (h float64Heap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *float64Heap) Push(x interface{}) { *h = append(*h, x.(float64)) }
+
+func (h *float64Heap) Pop() interface{} {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
+
+// Apply returns the results of fn for the valid values of the windows, in
+// order. The slice passed to fn is reused between windows and must not be
+// kept.
+func (r Rolling) Apply(fn func(vals []float64) float64) Series {
+	r.s.Retain()
+	defer r.s.Release()
+
+	xs, valid, starts, counts := r.prepare()
+
+	buf := make([]float64, 0, r.window)
+	res := make([]float64, len(xs))
+	ok := make([]bool, len(xs))
+	for i := range xs {
+		if counts[i] < r.minPeriods {
+			continue
+		}
+		buf = buf[:0]
+		for j := starts[i]; j <= i; j++ {
+			if valid[j] {
+				buf = append(buf, xs[j])
+			}
+		}
+		res[i], ok[i] = fn(buf), true
+	}
+
+	return r.result(res, ok)
+}
+
+// prepare returns the values of the Series as float64 values, their validity,
+// the first position of the window ending at every position and the number of
+// valid values of every window.
+func (r Rolling) prepare() ([]float64, []bool, []int, []int) {
+	xs := float64Values(r.s)
+	valid := make([]bool, len(xs))
+	for i := range valid {
+		valid[i] = r.s.IsValid(i)
+	}
+
+	starts := make([]int, len(xs))
+	start := 0
+	for i := range starts {
+		if r.times == nil {
+			start = i - r.window + 1
+			if start < 0 {
+				start = 0
+			}
+		} else {
+			for r.times[start] <= r.times[i]-r.span {
+				start++
+			}
+		}
+		starts[i] = start
+	}
+
+	// NOTE: prefix holds the number of valid values before every position.
+	prefix := make([]int, len(xs)+1)
+	for i, v := range valid {
+		prefix[i+1] = prefix[i]
+		if v {
+			prefix[i+1]++
+		}
+	}
+	counts := make([]int, len(xs))
+	for i := range counts {
+		counts[i] = prefix[i+1] - prefix[starts[i]]
+	}
+
+	return xs, valid, starts, counts
+}
+
+// nanCounts returns the number of valid NaN values of the window ending at
+// every position.
+func nanCounts(xs []float64, valid []bool, starts []int) []int {
+	// NOTE: prefix holds the number of valid NaN values before every position.
+	prefix := make([]int, len(xs)+1)
+	for i, x := range xs {
+		prefix[i+1] = prefix[i]
+		if valid[i] && math.IsNaN(x) {
+			prefix[i+1]++
+		}
+	}
+	nans := make([]int, len(xs))
+	for i := range nans {
+		nans[i] = prefix[i+1] - prefix[starts[i]]
+	}
+	return nans
+}
+
+// windowMoments holds the moments of the valid values of a window. NaN and
+// infinite values are only counted, so they leave no trace in the moments of
+// the finite values once they leave the window.
+type windowMoments struct {
+	n       int
+	nans    int
+	posInfs int
+	negInfs int
+
+	// NOTE: k, sum, mean and m2 are the number, sum, mean and sum of squared
+	// differences from the mean of the finite values, where mean and m2 are
+	// kept by a sliding Welford update.
+	k    int
+	sum  float64
+	mean float64
+	m2   float64
+}
+
+// add adds the value x to the window.
+func (m *windowMoments) add(x float64) {
+	m.n++
+	switch {
+	case math.IsNaN(x):
+		m.nans++
+		return
+	case math.IsInf(x, 1):
+		m.posInfs++
+		return
+	case math.IsInf(x, -1):
+		m.negInfs++
+		return
+	}
+
+	m.k++
+	m.sum += x
+	d := x - m.mean
+	m.mean += d / float64(m.k)
+	m.m2 += d * (x - m.mean)
+}
+
+// remove removes the value x, added before, from the window.
+func (m *windowMoments) remove(x float64) {
+	m.n--
+	switch {
+	case math.IsNaN(x):
+		m.nans--
+		return
+	case math.IsInf(x, 1):
+		m.posInfs--
+		return
+	case math.IsInf(x, -1):
+		m.negInfs--
+		return
+	}
+
+	m.k--
+	if m.k == 0 {
+		m.sum, m.mean, m.m2 = 0, 0, 0
+		return
+	}
+	m.sum -= x
+	d := x - m.mean
+	m.mean -= d / float64(m.k)
+	m.m2 -= d * (x - m.mean)
+	if m.m2 < 0 {
+		// NOTE: rounding can leave a tiny negative m2 for windows of equal
+		// values.
+		m.m2 = 0
+	}
+}
+
+// nonFinite returns the sum of the window if it holds NaN or infinite values,
+// which is NaN for NaN values or infinities of both signs and the infinity
+// otherwise.
+func (m *windowMoments) nonFinite() (float64, bool) {
+	switch {
+	case m.nans > 0 || (m.posInfs > 0 && m.negInfs > 0):
+		return math.NaN(), true
+	case m.posInfs > 0:
+		return math.Inf(1), true
+	case m.negInfs > 0:
+		return math.Inf(-1), true
+	}
+	return 0, false
+}
+
+// moments slides the windows over the values keeping the moments of their
+// valid values, and returns the results of fn for every window.
+func (r Rolling) moments(fn func(m *windowMoments) (float64, bool)) Series {
+	r.s.Retain()
+	defer r.s.Release()
+
+	xs, valid, starts, counts := r.prepare()
+
+	var m windowMoments
+	res := make([]float64, len(xs))
+	ok := make([]bool, len(xs))
+	start := 0
+	for i, x := range xs {
+		if valid[i] {
+			m.add(x)
+		}
+		for ; start < starts[i]; start++ {
+			if valid[start] {
+				m.remove(xs[start])
+			}
+		}
+		if counts[i] >= r.minPeriods {
+			res[i], ok[i] = fn(&m)
+		}
+	}
+
+	return r.result(res, ok)
+}
+
+// extreme slides the windows over the values keeping a monotonic deque of the
+// positions of the valid values which can still be the extreme of a window,
+// where keep reports whether a value x ahead of the new value y stays in the
+// deque, and returns the extreme of every window.
+func (r Rolling) extreme(keep func(x, y float64) bool) Series {
+	r.s.Retain()
+	defer r.s.Release()
+
+	xs, valid, starts, counts := r.prepare()
+	nans := nanCounts(xs, valid, starts)
+
+	// NOTE: the deque is deque[head:], with the extreme at its head. NaN
+	// values are left out as they do not compare with other values.
+	deque := make([]int, 0, len(xs))
+	head := 0
+	res := make([]float64, len(xs))
+	ok := make([]bool, len(xs))
+	for i, x := range xs {
+		if valid[i] && !math.IsNaN(x) {
+			for len(deque) > head && !keep(xs[deque[len(deque)-1]], x) {
+				deque = deque[:len(deque)-1]
+			}
+			deque = append(deque, i)
+		}
+		for head < len(deque) && deque[head] < starts[i] {
+			head++
+		}
+		switch {
+		case counts[i] < r.minPeriods:
+		case nans[i] > 0:
+			res[i], ok[i] = math.NaN(), true
+		default:
+			res[i], ok[i] = xs[deque[head]], true
+		}
+	}
+
+	return r.result(res, ok)
+}
+
+// result returns a Float64 Series of the results of the windows named as the
+// Series.
+func (r Rolling) result(vals []float64, valid []bool) Series {
+	f := r.s.field
+	f.Type = arrow.PrimitiveTypes.Float64
+	return FromFloat64(r.s.pool, f, vals, valid)
+}
@@ -1,12 +1,17 @@
 package series
 
 import (
+	"bytes"
 	"fmt"
+	"math"
+	"math/big"
 	"sort"
 	"strconv"
+	"time"
 
 	"github.com/apache/arrow/go/arrow"
 	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/decimal128"
 	"github.com/apache/arrow/go/arrow/memory"
 	"gonum.org/v1/gonum/floats"
 	"gonum.org/v1/gonum/mat"
@@ -40,10 +45,43 @@ func FromInterface(pool memory.Allocator, field arrow.Field, vals interface{}, v
 		return FromInt32(pool, field, vs, valid)
 	case []int64:
 		return FromInt64(pool, field, vs, valid)
+	case []int8:
+		return FromInt8(pool, field, vs, valid)
+	case []int16:
+		return FromInt16(pool, field, vs, valid)
+	case []uint8:
+		return FromUint8(pool, field, vs, valid)
+	case []uint16:
+		return FromUint16(pool, field, vs, valid)
+	case []uint32:
+		return FromUint32(pool, field, vs, valid)
+	case []uint64:
+		return FromUint64(pool, field, vs, valid)
 	case []float32:
 		return FromFloat32(pool, field, vs, valid)
 	case []float64:
 		return FromFloat64(pool, field, vs, valid)
+	case []bool:
+		return FromBool(pool, field, vs, valid)
+	case []string:
+		return FromString(pool, field, vs, valid)
+	case []arrow.Date32:
+		return FromDate32(pool, field, vs, valid)
+	case []arrow.Date64:
+		return FromDate64(pool, field, vs, valid)
+	case []arrow.Timestamp:
+		return FromTimestamp(pool, field, vs, valid)
+	case []arrow.Duration:
+		return FromDuration(pool, field, vs, valid)
+	case []time.Time:
+		return FromTime(pool, field, vs, valid)
+	case []decimal128.Num:
+		return FromDecimal128(pool, field, vs, valid)
+	case [][]byte:
+		if _, ok := field.Type.(*arrow.FixedSizeBinaryType); ok {
+			return FromFixedSizeBinary(pool, field, vs, valid)
+		}
+		return FromBinary(pool, field, vs, valid)
 	case []interface{}:
 		switch field.Type {
 		case arrow.PrimitiveTypes.Int32:
@@ -66,6 +104,66 @@ func FromInterface(pool memory.Allocator, field arrow.Field, vals interface{}, v
 				int64s[i] = val
 			}
 			return FromInt64(pool, field, int64s, valid)
+		case arrow.PrimitiveTypes.Int8:
+			int8s := make([]int8, len(vs))
+			for i, v := range vs {
+				val, ok := v.(int8)
+				if !ok {
+					val = 0
+				}
+				int8s[i] = val
+			}
+			return FromInt8(pool, field, int8s, valid)
+		case arrow.PrimitiveTypes.Int16:
+			int16s := make([]int16, len(vs))
+			for i, v := range vs {
+				val, ok := v.(int16)
+				if !ok {
+					val = 0
+				}
+				int16s[i] = val
+			}
+			return FromInt16(pool, field, int16s, valid)
+		case arrow.PrimitiveTypes.Uint8:
+			uint8s := make([]uint8, len(vs))
+			for i, v := range vs {
+				val, ok := v.(uint8)
+				if !ok {
+					val = 0
+				}
+				uint8s[i] = val
+			}
+			return FromUint8(pool, field, uint8s, valid)
+		case arrow.PrimitiveTypes.Uint16:
+			uint16s := make([]uint16, len(vs))
+			for i, v := range vs {
+				val, ok := v.(uint16)
+				if !ok {
+					val = 0
+				}
+				uint16s[i] = val
+			}
+			return FromUint16(pool, field, uint16s, valid)
+		case arrow.PrimitiveTypes.Uint32:
+			uint32s := make([]uint32, len(vs))
+			for i, v := range vs {
+				val, ok := v.(uint32)
+				if !ok {
+					val = 0
+				}
+				uint32s[i] = val
+			}
+			return FromUint32(pool, field, uint32s, valid)
+		case arrow.PrimitiveTypes.Uint64:
+			uint64s := make([]uint64, len(vs))
+			for i, v := range vs {
+				val, ok := v.(uint64)
+				if !ok {
+					val = 0
+				}
+				uint64s[i] = val
+			}
+			return FromUint64(pool, field, uint64s, valid)
 		case arrow.PrimitiveTypes.Float32:
 			float32s := make([]float32, len(vs))
 			for i, v := range vs {
@@ -96,14 +194,38 @@ func FromInterface(pool memory.Allocator, field arrow.Field, vals interface{}, v
 				strings[i] = val
 			}
 			return FromString(pool, field, strings, valid)
+		case arrow.FixedWidthTypes.Boolean:
+			bools := make([]bool, len(vs))
+			for i, v := range vs {
+				val, ok := v.(bool)
+				if !ok {
+					val = false
+				}
+				bools[i] = val
+			}
+			return FromBool(pool, field, bools, valid)
+		case Categorical:
+			// NOTE: values which are not strings are null rather than an
+			// empty category.
+			strings := make([]string, len(vs))
+			strValid := make([]bool, len(vs))
+			for i, v := range vs {
+				strings[i], strValid[i] = v.(string)
+				if valid != nil && !valid[i] {
+					strValid[i] = false
+				}
+			}
+			f := field
+			f.Type = arrow.BinaryTypes.String
+			str := FromString(pool, f, strings, strValid)
+			defer str.Release()
+			return str.AsCategorical()
 		default:
 			panic(fmt.Sprintf("series: from_interface: unsupported type: %T", field.Type))
 		}
 	default:
 		panic(fmt.Sprintf("series: from_interface: unsupported type: %T", vs))
 	}
-
-	return Series{}
 }
 
 // FromInt32 creates a Series from a slice of int32 values.
@@ -137,6 +259,84 @@ func FromInt64(pool memory.Allocator, field arrow.Field, vals []int64, valid []b
 	}
 }
 
+// FromInt8 creates a Series from a slice of int8 values.
+func FromInt8(pool memory.Allocator, field arrow.Field, vals []int8, valid []bool) Series {
+	b := array.NewInt8Builder(pool)
+	defer b.Release()
+	b.AppendValues(vals, valid)
+
+	return Series{
+		pool:      pool,
+		field:     field,
+		Interface: b.NewArray(),
+	}
+}
+
+// FromInt16 creates a Series from a slice of int16 values.
+func FromInt16(pool memory.Allocator, field arrow.Field, vals []int16, valid []bool) Series {
+	b := array.NewInt16Builder(pool)
+	defer b.Release()
+	b.AppendValues(vals, valid)
+
+	return Series{
+		pool:      pool,
+		field:     field,
+		Interface: b.NewArray(),
+	}
+}
+
+// FromUint8 creates a Series from a slice of uint8 values.
+func FromUint8(pool memory.Allocator, field arrow.Field, vals []uint8, valid []bool) Series {
+	b := array.NewUint8Builder(pool)
+	defer b.Release()
+	b.AppendValues(vals, valid)
+
+	return Series{
+		pool:      pool,
+		field:     field,
+		Interface: b.NewArray(),
+	}
+}
+
+// FromUint16 creates a Series from a slice of uint16 values.
+func FromUint16(pool memory.Allocator, field arrow.Field, vals []uint16, valid []bool) Series {
+	b := array.NewUint16Builder(pool)
+	defer b.Release()
+	b.AppendValues(vals, valid)
+
+	return Series{
+		pool:      pool,
+		field:     field,
+		Interface: b.NewArray(),
+	}
+}
+
+// FromUint32 creates a Series from a slice of uint32 values.
+func FromUint32(pool memory.Allocator, field arrow.Field, vals []uint32, valid []bool) Series {
+	b := array.NewUint32Builder(pool)
+	defer b.Release()
+	b.AppendValues(vals, valid)
+
+	return Series{
+		pool:      pool,
+		field:     field,
+		Interface: b.NewArray(),
+	}
+}
+
+// FromUint64 creates a Series from a slice of uint64 values.
+func FromUint64(pool memory.Allocator, field arrow.Field, vals []uint64, valid []bool) Series {
+	b := array.NewUint64Builder(pool)
+	defer b.Release()
+	b.AppendValues(vals, valid)
+
+	return Series{
+		pool:      pool,
+		field:     field,
+		Interface: b.NewArray(),
+	}
+}
+
 // FromFloat32 creates a Series from a slice of float32 values.
 func FromFloat32(pool memory.Allocator, field arrow.Field, vals []float32, valid []bool) Series {
 	b := array.NewFloat32Builder(pool)
@@ -176,6 +376,19 @@ func FromString(pool memory.Allocator, field arrow.Field, vals []string, valid [
 	}
 }
 
+// FromBool creates a Series from a slice of bool values.
+func FromBool(pool memory.Allocator, field arrow.Field, vals []bool, valid []bool) Series {
+	b := array.NewBooleanBuilder(pool)
+	defer b.Release()
+	b.AppendValues(vals, valid)
+
+	return Series{
+		pool:      pool,
+		field:     field,
+		Interface: b.NewArray(),
+	}
+}
+
 // Empty creates an empty Series with n elements.
 func (s Series) Empty(n int) Series {
 	s.Retain()
@@ -207,6 +420,55 @@ func (s Series) Empty(n int) Series {
 		defer b.Release()
 		b.AppendValues(vals, valid)
 		col = b.NewArray()
+	case arrow.FixedWidthTypes.Boolean:
+		vals := make([]bool, n)
+		b := array.NewBooleanBuilder(s.pool)
+		defer b.Release()
+		b.AppendValues(vals, valid)
+		col = b.NewArray()
+	case arrow.PrimitiveTypes.Int8:
+		vals := make([]int8, n)
+		b := array.NewInt8Builder(s.pool)
+		defer b.Release()
+		b.AppendValues(vals, valid)
+		col = b.NewArray()
+	case arrow.PrimitiveTypes.Int16:
+		vals := make([]int16, n)
+		b := array.NewInt16Builder(s.pool)
+		defer b.Release()
+		b.AppendValues(vals, valid)
+		col = b.NewArray()
+	case arrow.PrimitiveTypes.Uint8:
+		vals := make([]uint8, n)
+		b := array.NewUint8Builder(s.pool)
+		defer b.Release()
+		b.AppendValues(vals, valid)
+		col = b.NewArray()
+	case arrow.PrimitiveTypes.Uint16:
+		vals := make([]uint16, n)
+		b := array.NewUint16Builder(s.pool)
+		defer b.Release()
+		b.AppendValues(vals, valid)
+		col = b.NewArray()
+	case arrow.PrimitiveTypes.Uint32:
+		vals := make([]uint32, n)
+		b := array.NewUint32Builder(s.pool)
+		defer b.Release()
+		b.AppendValues(vals, valid)
+		col = b.NewArray()
+	case arrow.PrimitiveTypes.Uint64:
+		vals := make([]uint64, n)
+		b := array.NewUint64Builder(s.pool)
+		defer b.Release()
+		b.AppendValues(vals, valid)
+		col = b.NewArray()
+	case Categorical:
+		a := s.Interface.(*categoricalArray)
+		b := array.NewInt32Builder(s.pool)
+		defer b.Release()
+		b.AppendValues(make([]int32, n), valid)
+		codes := b.NewInt32Array()
+		col = newCategoricalArray(codes, a.categories)
 	default:
 		panic("series.Empty: unknown type")
 	}
@@ -242,8 +504,38 @@ func (s Series) Value(i int) interface{} {
 		v = s.Interface.(*array.Float64).Value(i)
 	case arrow.BinaryTypes.String:
 		v = s.Interface.(*array.String).Value(i)
+	case arrow.FixedWidthTypes.Boolean:
+		v = s.Interface.(*array.Boolean).Value(i)
+	case arrow.PrimitiveTypes.Int8:
+		v = s.Interface.(*array.Int8).Value(i)
+	case arrow.PrimitiveTypes.Int16:
+		v = s.Interface.(*array.Int16).Value(i)
+	case arrow.PrimitiveTypes.Uint8:
+		v = s.Interface.(*array.Uint8).Value(i)
+	case arrow.PrimitiveTypes.Uint16:
+		v = s.Interface.(*array.Uint16).Value(i)
+	case arrow.PrimitiveTypes.Uint32:
+		v = s.Interface.(*array.Uint32).Value(i)
+	case arrow.PrimitiveTypes.Uint64:
+		v = s.Interface.(*array.Uint64).Value(i)
+	case Categorical:
+		v = s.Interface.(*categoricalArray).value(i)
 	default:
-		panic("series.Value: unknown type")
+		if a, ok := s.Interface.(*array.Decimal128); ok {
+			v = a.Value(i)
+			break
+		}
+		if a, ok := s.Interface.(binaryArray); ok {
+			v = a.Value(i)
+			break
+		}
+		var ok bool
+		if v, ok = nestedValue(s, i); ok {
+			break
+		}
+		if v, ok = temporalValue(s, i); !ok {
+			panic("series.Value: unknown type")
+		}
 	}
 
 	s.Release()
@@ -264,6 +556,18 @@ func (s Series) Int32(i int) int32 {
 		v = int32(s.Interface.(*array.Float32).Value(i))
 	case arrow.PrimitiveTypes.Float64:
 		v = int32(s.Interface.(*array.Float64).Value(i))
+	case arrow.PrimitiveTypes.Int8:
+		v = int32(s.Interface.(*array.Int8).Value(i))
+	case arrow.PrimitiveTypes.Int16:
+		v = int32(s.Interface.(*array.Int16).Value(i))
+	case arrow.PrimitiveTypes.Uint8:
+		v = int32(s.Interface.(*array.Uint8).Value(i))
+	case arrow.PrimitiveTypes.Uint16:
+		v = int32(s.Interface.(*array.Uint16).Value(i))
+	case arrow.PrimitiveTypes.Uint32:
+		v = int32(s.Interface.(*array.Uint32).Value(i))
+	case arrow.PrimitiveTypes.Uint64:
+		v = int32(s.Interface.(*array.Uint64).Value(i))
 	default:
 		panic("series.Int32: unknown type")
 	}
@@ -286,6 +590,18 @@ func (s Series) Int64(i int) int64 {
 		v = int64(s.Interface.(*array.Float32).Value(i))
 	case arrow.PrimitiveTypes.Float64:
 		v = int64(s.Interface.(*array.Float64).Value(i))
+	case arrow.PrimitiveTypes.Int8:
+		v = int64(s.Interface.(*array.Int8).Value(i))
+	case arrow.PrimitiveTypes.Int16:
+		v = int64(s.Interface.(*array.Int16).Value(i))
+	case arrow.PrimitiveTypes.Uint8:
+		v = int64(s.Interface.(*array.Uint8).Value(i))
+	case arrow.PrimitiveTypes.Uint16:
+		v = int64(s.Interface.(*array.Uint16).Value(i))
+	case arrow.PrimitiveTypes.Uint32:
+		v = int64(s.Interface.(*array.Uint32).Value(i))
+	case arrow.PrimitiveTypes.Uint64:
+		v = int64(s.Interface.(*array.Uint64).Value(i))
 	default:
 		panic("series: unknown type")
 	}
@@ -308,6 +624,18 @@ func (s Series) Float32(i int) float32 {
 		v = s.Interface.(*array.Float32).Value(i)
 	case arrow.PrimitiveTypes.Float64:
 		v = float32(s.Interface.(*array.Float64).Value(i))
+	case arrow.PrimitiveTypes.Int8:
+		v = float32(s.Interface.(*array.Int8).Value(i))
+	case arrow.PrimitiveTypes.Int16:
+		v = float32(s.Interface.(*array.Int16).Value(i))
+	case arrow.PrimitiveTypes.Uint8:
+		v = float32(s.Interface.(*array.Uint8).Value(i))
+	case arrow.PrimitiveTypes.Uint16:
+		v = float32(s.Interface.(*array.Uint16).Value(i))
+	case arrow.PrimitiveTypes.Uint32:
+		v = float32(s.Interface.(*array.Uint32).Value(i))
+	case arrow.PrimitiveTypes.Uint64:
+		v = float32(s.Interface.(*array.Uint64).Value(i))
 	default:
 		panic("series: unknown type")
 	}
@@ -330,6 +658,18 @@ func (s Series) Float64(i int) float64 {
 		v = float64(s.Interface.(*array.Float32).Value(i))
 	case arrow.PrimitiveTypes.Float64:
 		v = s.Interface.(*array.Float64).Value(i)
+	case arrow.PrimitiveTypes.Int8:
+		v = float64(s.Interface.(*array.Int8).Value(i))
+	case arrow.PrimitiveTypes.Int16:
+		v = float64(s.Interface.(*array.Int16).Value(i))
+	case arrow.PrimitiveTypes.Uint8:
+		v = float64(s.Interface.(*array.Uint8).Value(i))
+	case arrow.PrimitiveTypes.Uint16:
+		v = float64(s.Interface.(*array.Uint16).Value(i))
+	case arrow.PrimitiveTypes.Uint32:
+		v = float64(s.Interface.(*array.Uint32).Value(i))
+	case arrow.PrimitiveTypes.Uint64:
+		v = float64(s.Interface.(*array.Uint64).Value(i))
 	default:
 		panic("series: unknown type")
 	}
@@ -358,7 +698,44 @@ func (s Series) Values() interface{} {
 			vals[i] = s.Interface.(*array.String).Value(i)
 		}
 		return vals
+	case arrow.FixedWidthTypes.Boolean:
+		vals := make([]bool, s.Len())
+		for i := 0; i < s.Len(); i++ {
+			vals[i] = s.Interface.(*array.Boolean).Value(i)
+		}
+		return vals
+	case arrow.PrimitiveTypes.Int8:
+		return s.Interface.(*array.Int8).Int8Values()
+	case arrow.PrimitiveTypes.Int16:
+		return s.Interface.(*array.Int16).Int16Values()
+	case arrow.PrimitiveTypes.Uint8:
+		return s.Interface.(*array.Uint8).Uint8Values()
+	case arrow.PrimitiveTypes.Uint16:
+		return s.Interface.(*array.Uint16).Uint16Values()
+	case arrow.PrimitiveTypes.Uint32:
+		return s.Interface.(*array.Uint32).Uint32Values()
+	case arrow.PrimitiveTypes.Uint64:
+		return s.Interface.(*array.Uint64).Uint64Values()
+	case Categorical:
+		a := s.Interface.(*categoricalArray)
+		vals := make([]string, s.Len())
+		for i := range vals {
+			vals[i] = a.value(i)
+		}
+		return vals
 	default:
+		if a, ok := s.Interface.(*array.Decimal128); ok {
+			return a.Values()
+		}
+		if a, ok := s.Interface.(binaryArray); ok {
+			return binaryValues(a)
+		}
+		if vals, ok := nestedValues(s); ok {
+			return vals
+		}
+		if vals, ok := temporalValues(s); ok {
+			return vals
+		}
 		panic("series: unknown type")
 	}
 }
@@ -394,8 +771,72 @@ func (s Series) StringValues() []string {
 		for i := 0; i < s.Len(); i++ {
 			res = append(res, s.Interface.(*array.String).Value(i))
 		}
+	case arrow.FixedWidthTypes.Boolean:
+		for i := 0; i < s.Len(); i++ {
+			res = append(res, strconv.FormatBool(s.Interface.(*array.Boolean).Value(i)))
+		}
+	case arrow.PrimitiveTypes.Int8:
+		vs := s.Interface.(*array.Int8).Int8Values()
+		for _, v := range vs {
+			res = append(res, strconv.FormatInt(int64(v), 10))
+		}
+	case arrow.PrimitiveTypes.Int16:
+		vs := s.Interface.(*array.Int16).Int16Values()
+		for _, v := range vs {
+			res = append(res, strconv.FormatInt(int64(v), 10))
+		}
+	case arrow.PrimitiveTypes.Uint8:
+		vs := s.Interface.(*array.Uint8).Uint8Values()
+		for _, v := range vs {
+			res = append(res, strconv.FormatUint(uint64(v), 10))
+		}
+	case arrow.PrimitiveTypes.Uint16:
+		vs := s.Interface.(*array.Uint16).Uint16Values()
+		for _, v := range vs {
+			res = append(res, strconv.FormatUint(uint64(v), 10))
+		}
+	case arrow.PrimitiveTypes.Uint32:
+		vs := s.Interface.(*array.Uint32).Uint32Values()
+		for _, v := range vs {
+			res = append(res, strconv.FormatUint(uint64(v), 10))
+		}
+	case arrow.PrimitiveTypes.Uint64:
+		vs := s.Interface.(*array.Uint64).Uint64Values()
+		for _, v := range vs {
+			res = append(res, strconv.FormatUint(uint64(v), 10))
+		}
+	case Categorical:
+		a := s.Interface.(*categoricalArray)
+		for i := 0; i < s.Len(); i++ {
+			res = append(res, a.value(i))
+		}
 	default:
-		panic("series: unknown type")
+		if a, ok := s.Interface.(*array.Decimal128); ok {
+			scale := s.field.Type.(*arrow.Decimal128Type).Scale
+			for _, v := range a.Values() {
+				res = append(res, FormatDecimal(v, scale))
+			}
+			break
+		}
+		if a, ok := s.Interface.(binaryArray); ok {
+			for _, v := range binaryValues(a) {
+				res = append(res, HexEncoding.Encode(v))
+			}
+			break
+		}
+		if format := nestedFormatter(s); format != nil {
+			for i := 0; i < s.Len(); i++ {
+				res = append(res, format(i))
+			}
+			break
+		}
+		format := temporalFormatter(s)
+		if format == nil {
+			panic("series: unknown type")
+		}
+		for i := 0; i < s.Len(); i++ {
+			res = append(res, format(i))
+		}
 	}
 	return res
 }
@@ -465,7 +906,31 @@ func (s Series) AtVec(i int) float64 {
 			panic(fmt.Sprintf("series: at_vec: %s", err))
 		}
 		return v
+	case arrow.PrimitiveTypes.Int8:
+		val := s.Interface.(*array.Int8).Value(i)
+		return float64(val)
+	case arrow.PrimitiveTypes.Int16:
+		val := s.Interface.(*array.Int16).Value(i)
+		return float64(val)
+	case arrow.PrimitiveTypes.Uint8:
+		val := s.Interface.(*array.Uint8).Value(i)
+		return float64(val)
+	case arrow.PrimitiveTypes.Uint16:
+		val := s.Interface.(*array.Uint16).Value(i)
+		return float64(val)
+	case arrow.PrimitiveTypes.Uint32:
+		val := s.Interface.(*array.Uint32).Value(i)
+		return float64(val)
+	case arrow.PrimitiveTypes.Uint64:
+		val := s.Interface.(*array.Uint64).Value(i)
+		return float64(val)
 	default:
+		if a, ok := s.Interface.(*array.Decimal128); ok {
+			return decimalFloat64(a.Value(i), s.field.Type.(*arrow.Decimal128Type).Scale)
+		}
+		if raw := temporalRaw(s); raw != nil {
+			return float64(raw(i))
+		}
 		panic("series: at_vec: unknown type")
 	}
 }
@@ -498,15 +963,43 @@ func (s Series) Cast(t arrow.DataType) Series {
 		return castToFloat64(s)
 	case arrow.BinaryTypes.String:
 		return castToString(s)
-	// case arrow.PrimitiveTypes.Uint64:
+	case arrow.PrimitiveTypes.Int8:
+		return castToInt8(s)
+	case arrow.PrimitiveTypes.Int16:
+		return castToInt16(s)
+	case arrow.PrimitiveTypes.Uint8:
+		return castToUint8(s)
+	case arrow.PrimitiveTypes.Uint16:
+		return castToUint16(s)
+	case arrow.PrimitiveTypes.Uint32:
+		return castToUint32(s)
+	case arrow.PrimitiveTypes.Uint64:
+		return castToUint64(s)
+	case arrow.BinaryTypes.Binary:
+		return castToBinary(s, t, HexEncoding)
+	case Categorical:
+		if s.field.Type == arrow.BinaryTypes.String || s.field.Type == Categorical {
+			return s.AsCategorical()
+		}
+		str := castToString(s)
+		defer str.Release()
+		return str.AsCategorical()
 	default:
+		if dt, ok := t.(*arrow.Decimal128Type); ok {
+			return castToDecimal(s, dt)
+		}
+		if _, ok := t.(*arrow.FixedSizeBinaryType); ok {
+			return castToBinary(s, t, HexEncoding)
+		}
+		if isTemporal(t) {
+			return castToTemporal(s, t, DefaultTimeLayouts)
+		}
 		panic("series: cast: unsupported type")
 	}
-
-	return s
 }
 
-// Unique returns a new series with only unique values.
+// Unique returns a new series with only unique values. Null values are
+// skipped.
 func (s Series) Unique() Series {
 	s.Retain()
 	defer s.Release()
@@ -527,8 +1020,30 @@ func (s Series) Unique() Series {
 	case arrow.PrimitiveTypes.Float64:
 		vals := float64Unique(s.Interface.(*array.Float64))
 		return FromFloat64(s.pool, s.field, vals, nil)
-	// case arrow.PrimitiveTypes.Uint64:
+	case arrow.PrimitiveTypes.Int8:
+		vals := int8Unique(s.Interface.(*array.Int8))
+		return FromInt8(s.pool, s.field, vals, nil)
+	case arrow.PrimitiveTypes.Int16:
+		vals := int16Unique(s.Interface.(*array.Int16))
+		return FromInt16(s.pool, s.field, vals, nil)
+	case arrow.PrimitiveTypes.Uint8:
+		vals := uint8Unique(s.Interface.(*array.Uint8))
+		return FromUint8(s.pool, s.field, vals, nil)
+	case arrow.PrimitiveTypes.Uint16:
+		vals := uint16Unique(s.Interface.(*array.Uint16))
+		return FromUint16(s.pool, s.field, vals, nil)
+	case arrow.PrimitiveTypes.Uint32:
+		vals := uint32Unique(s.Interface.(*array.Uint32))
+		return FromUint32(s.pool, s.field, vals, nil)
+	case arrow.PrimitiveTypes.Uint64:
+		vals := uint64Unique(s.Interface.(*array.Uint64))
+		return FromUint64(s.pool, s.field, vals, nil)
+	case Categorical:
+		return categoricalUnique(s)
 	default:
+		if isBinary(s.field.Type) {
+			return binaryUnique(s)
+		}
 		panic("series: unique: unsupported type")
 	}
 }
@@ -538,6 +1053,10 @@ func (s Series) FindIndices(val interface{}) []int {
 	s.Retain()
 	defer s.Release()
 
+	if s.field.Type == Categorical {
+		return categoricalFindIndices(s, val)
+	}
+
 	var result []int
 	switch vals := s.Values().(type) {
 	case []int32:
@@ -572,14 +1091,14 @@ func (s Series) FindIndices(val interface{}) []int {
 			}
 			result = append(result, i)
 		}
-	case []float32:
+	case []int8:
 		switch v := val.(type) {
 		case string:
-			b, err := strconv.ParseFloat(v, 32)
+			b, err := strconv.ParseInt(v, 10, 8)
 			if err != nil {
 				panic(fmt.Sprintf("series: find_indices: %s", err))
 			}
-			val = float32(b)
+			val = int8(b)
 		}
 
 		for i, v := range vals {
@@ -588,14 +1107,14 @@ func (s Series) FindIndices(val interface{}) []int {
 			}
 			result = append(result, i)
 		}
-	case []float64:
+	case []int16:
 		switch v := val.(type) {
 		case string:
-			b, err := strconv.ParseFloat(v, 64)
+			b, err := strconv.ParseInt(v, 10, 16)
 			if err != nil {
 				panic(fmt.Sprintf("series: find_indices: %s", err))
 			}
-			val = b
+			val = int16(b)
 		}
 
 		for i, v := range vals {
@@ -604,37 +1123,141 @@ func (s Series) FindIndices(val interface{}) []int {
 			}
 			result = append(result, i)
 		}
-	case []string:
+	case []uint8:
+		switch v := val.(type) {
+		case string:
+			b, err := strconv.ParseUint(v, 10, 8)
+			if err != nil {
+				panic(fmt.Sprintf("series: find_indices: %s", err))
+			}
+			val = uint8(b)
+		}
+
 		for i, v := range vals {
 			if v != val || s.IsNull(i) {
 				continue
 			}
 			result = append(result, i)
 		}
-	default:
-		panic("series: find_indices: unknown type")
-	}
-
-	return result
-}
+	case []uint16:
+		switch v := val.(type) {
+		case string:
+			b, err := strconv.ParseUint(v, 10, 16)
+			if err != nil {
+				panic(fmt.Sprintf("series: find_indices: %s", err))
+			}
+			val = uint16(b)
+		}
 
-// NAIndices returns the indices where values are null.
-//
-// TODO{poopoothegorilla): COULD BE IMPROVED PERFORMANCE WISE... MAYBE USE NULL BITMASK
-func (s Series) NAIndices() []int {
-	s.Retain()
+		for i, v := range vals {
+			if v != val || s.IsNull(i) {
+				continue
+			}
+			result = append(result, i)
+		}
+	case []uint32:
+		switch v := val.(type) {
+		case string:
+			b, err := strconv.ParseUint(v, 10, 32)
+			if err != nil {
+				panic(fmt.Sprintf("series: find_indices: %s", err))
+			}
+			val = uint32(b)
+		}
 
-	var j int
-	result := make([]int, s.NullN())
-	for i := 0; i < s.Len(); i++ {
-		if s.IsNull(i) {
-			result[j] = i
-			j++
+		for i, v := range vals {
+			if v != val || s.IsNull(i) {
+				continue
+			}
+			result = append(result, i)
+		}
+	case []uint64:
+		switch v := val.(type) {
+		case string:
+			b, err := strconv.ParseUint(v, 10, 64)
+			if err != nil {
+				panic(fmt.Sprintf("series: find_indices: %s", err))
+			}
+			val = uint64(b)
 		}
-	}
 
-	s.Release()
-	return result
+		for i, v := range vals {
+			if v != val || s.IsNull(i) {
+				continue
+			}
+			result = append(result, i)
+		}
+	case []float32:
+		switch v := val.(type) {
+		case string:
+			b, err := strconv.ParseFloat(v, 32)
+			if err != nil {
+				panic(fmt.Sprintf("series: find_indices: %s", err))
+			}
+			val = float32(b)
+		}
+
+		for i, v := range vals {
+			if v != val || s.IsNull(i) {
+				continue
+			}
+			result = append(result, i)
+		}
+	case []float64:
+		switch v := val.(type) {
+		case string:
+			b, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				panic(fmt.Sprintf("series: find_indices: %s", err))
+			}
+			val = b
+		}
+
+		for i, v := range vals {
+			if v != val || s.IsNull(i) {
+				continue
+			}
+			result = append(result, i)
+		}
+	case []string:
+		for i, v := range vals {
+			if v != val || s.IsNull(i) {
+				continue
+			}
+			result = append(result, i)
+		}
+	case [][]byte:
+		b := binaryScalar("find_indices", val)
+		for i, v := range vals {
+			if !bytes.Equal(v, b) || s.IsNull(i) {
+				continue
+			}
+			result = append(result, i)
+		}
+	default:
+		panic("series: find_indices: unknown type")
+	}
+
+	return result
+}
+
+// NAIndices returns the indices where values are null.
+//
+// TODO{poopoothegorilla): COULD BE IMPROVED PERFORMANCE WISE... MAYBE USE NULL BITMASK
+func (s Series) NAIndices() []int {
+	s.Retain()
+
+	var j int
+	result := make([]int, s.NullN())
+	for i := 0; i < s.Len(); i++ {
+		if s.IsNull(i) {
+			result[j] = i
+			j++
+		}
+	}
+
+	s.Release()
+	return result
 }
 
 // IsNA returns a slice of bools indicating positions where values are null.
@@ -662,23 +1285,23 @@ func (s Series) DropIndices(indices []int) Series {
 		sort.Ints(indices)
 	}
 
-	switch s.field.Type {
-	case arrow.PrimitiveTypes.Int32:
-		vals := int32DropIndices(s.Interface.(*array.Int32), indices)
-		return FromInt32(s.pool, s.field, vals, nil)
-	case arrow.PrimitiveTypes.Int64:
-		vals := int64DropIndices(s.Interface.(*array.Int64), indices)
-		return FromInt64(s.pool, s.field, vals, nil)
-	case arrow.PrimitiveTypes.Float32:
-		vals := float32DropIndices(s.Interface.(*array.Float32), indices)
-		return FromFloat32(s.pool, s.field, vals, nil)
-	case arrow.PrimitiveTypes.Float64:
-		vals := float64DropIndices(s.Interface.(*array.Float64), indices)
-		return FromFloat64(s.pool, s.field, vals, nil)
-	// case arrow.PrimitiveTypes.Uint64:
-	default:
-		panic("series: drop_indices: unsupported type")
+	return s.take("drop_indices", keepIndices(s.Len(), indices))
+}
+
+// keepIndices returns the indices of the n values which are not in the sorted
+// indices.
+func keepIndices(n int, indices []int) []int {
+	keep := make([]int, 0, n)
+	for i := 0; i < n; i++ {
+		for len(indices) > 0 && indices[0] < i {
+			indices = indices[1:]
+		}
+		if len(indices) > 0 && indices[0] == i {
+			continue
+		}
+		keep = append(keep, i)
 	}
+	return keep
 }
 
 // SelectIndices returns a Series with values only present in the provided
@@ -691,23 +1314,7 @@ func (s Series) SelectIndices(indices []int) Series {
 		sort.Ints(indices)
 	}
 
-	switch s.field.Type {
-	case arrow.PrimitiveTypes.Int32:
-		vals := int32SelectIndices(s.Interface.(*array.Int32), indices)
-		return FromInt32(s.pool, s.field, vals, nil)
-	case arrow.PrimitiveTypes.Int64:
-		vals := int64SelectIndices(s.Interface.(*array.Int64), indices)
-		return FromInt64(s.pool, s.field, vals, nil)
-	case arrow.PrimitiveTypes.Float32:
-		vals := float32SelectIndices(s.Interface.(*array.Float32), indices)
-		return FromFloat32(s.pool, s.field, vals, nil)
-	case arrow.PrimitiveTypes.Float64:
-		vals := float64SelectIndices(s.Interface.(*array.Float64), indices)
-		return FromFloat64(s.pool, s.field, vals, nil)
-	// case arrow.PrimitiveTypes.Uint64:
-	default:
-		panic("series: select: unsupported type")
-	}
+	return s.take("select", indices)
 }
 
 // Truncate returns a truncated Series.
@@ -718,17 +1325,22 @@ func (s Series) Truncate(i, j int64) Series {
 	ss := Series{
 		pool:      s.pool,
 		field:     s.field,
-		Interface: array.NewSlice(s.Interface, i, j),
+		Interface: newSlice(s.Interface, i, j),
 	}
 
 	return ss
 }
 
-// Sum returns the sum of all values in the Series as a float64 value.
-func (s Series) Sum() float64 {
+// Sum returns the sum of all values in the Series as a float64 value. Null
+// values are skipped unless set otherwise by WithSkipNA.
+func (s Series) Sum(opts ...AggOption) float64 {
 	s.Retain()
 	defer s.Release()
 
+	if newAggConfig(opts...).propagatesNA(s) {
+		return math.NaN()
+	}
+
 	switch s.field.Type {
 	case arrow.PrimitiveTypes.Int32:
 		val := int32Sum(s.Interface.(*array.Int32))
@@ -741,19 +1353,45 @@ func (s Series) Sum() float64 {
 		return float64(val)
 	case arrow.PrimitiveTypes.Float64:
 		return float64Sum(s.Interface.(*array.Float64))
-	// case arrow.PrimitiveTypes.Uint64:
-	// 	val := uint64Sum(s.Interface.(*array.Uint64))
-	// 	return float64(val)
+	case arrow.PrimitiveTypes.Int8:
+		val := int8Sum(s.Interface.(*array.Int8))
+		return float64(val)
+	case arrow.PrimitiveTypes.Int16:
+		val := int16Sum(s.Interface.(*array.Int16))
+		return float64(val)
+	case arrow.PrimitiveTypes.Uint8:
+		val := uint8Sum(s.Interface.(*array.Uint8))
+		return float64(val)
+	case arrow.PrimitiveTypes.Uint16:
+		val := uint16Sum(s.Interface.(*array.Uint16))
+		return float64(val)
+	case arrow.PrimitiveTypes.Uint32:
+		val := uint32Sum(s.Interface.(*array.Uint32))
+		return float64(val)
+	case arrow.PrimitiveTypes.Uint64:
+		val := uint64Sum(s.Interface.(*array.Uint64))
+		return float64(val)
 	default:
+		if a, ok := s.Interface.(*array.Decimal128); ok {
+			// NOTE: the sum is exact and only rounded once into a float64.
+			sum := new(big.Rat).SetFrac(decimalSum(a), pow10(s.field.Type.(*arrow.Decimal128Type).Scale))
+			val, _ := sum.Float64()
+			return val
+		}
 		return mat.Sum(s)
 	}
 }
 
-// Magnitude returns the magnitude of the Series as a float64 value.
-func (s Series) Magnitude() float64 {
+// Magnitude returns the magnitude of the Series as a float64 value. Null values
+// are skipped unless set otherwise by WithSkipNA.
+func (s Series) Magnitude(opts ...AggOption) float64 {
 	s.Retain()
 	defer s.Release()
 
+	if newAggConfig(opts...).propagatesNA(s) {
+		return math.NaN()
+	}
+
 	switch s.field.Type {
 	case arrow.PrimitiveTypes.Int32:
 		return int32Magnitude(s.Interface.(*array.Int32))
@@ -763,47 +1401,37 @@ func (s Series) Magnitude() float64 {
 		return float32Magnitude(s.Interface.(*array.Float32))
 	case arrow.PrimitiveTypes.Float64:
 		return float64Magnitude(s.Interface.(*array.Float64))
-	// case arrow.PrimitiveTypes.Uint64:
-	// 	val := uint64Magnitude(s.Interface.(*array.Uint64))
-	// 	return float64(val)
+	case arrow.PrimitiveTypes.Int8:
+		return int8Magnitude(s.Interface.(*array.Int8))
+	case arrow.PrimitiveTypes.Int16:
+		return int16Magnitude(s.Interface.(*array.Int16))
+	case arrow.PrimitiveTypes.Uint8:
+		return uint8Magnitude(s.Interface.(*array.Uint8))
+	case arrow.PrimitiveTypes.Uint16:
+		return uint16Magnitude(s.Interface.(*array.Uint16))
+	case arrow.PrimitiveTypes.Uint32:
+		return uint32Magnitude(s.Interface.(*array.Uint32))
+	case arrow.PrimitiveTypes.Uint64:
+		return uint64Magnitude(s.Interface.(*array.Uint64))
 	default:
 		return mat.Norm(s, 2)
 	}
 }
 
-// STD returns the standard deviation of the Series as a float64 value.
-func (s Series) STD() float64 {
+// STD returns the standard deviation of the Series as a float64 value. Null
+// values are skipped unless set otherwise by WithSkipNA.
+func (s Series) STD(opts ...AggOption) float64 {
 	s.Retain()
 	defer s.Release()
 
-	switch s.field.Type {
-	case arrow.PrimitiveTypes.Int32:
-		vals := make([]float64, s.Len())
-		for i, v := range s.Interface.(*array.Int32).Int32Values() {
-			vals[i] = float64(v)
-		}
-		return stat.StdDev(vals, nil)
-	case arrow.PrimitiveTypes.Int64:
-		vals := make([]float64, s.Len())
-		for i, v := range s.Interface.(*array.Int64).Int64Values() {
-			vals[i] = float64(v)
-		}
-		return stat.StdDev(vals, nil)
-	case arrow.PrimitiveTypes.Float32:
-		vals := make([]float64, s.Len())
-		for i, v := range s.Interface.(*array.Float32).Float32Values() {
-			vals[i] = float64(v)
-		}
-		return stat.StdDev(vals, nil)
-	case arrow.PrimitiveTypes.Float64:
-		vals := s.Interface.(*array.Float64).Float64Values()
-		return stat.StdDev(vals, nil)
-	// case arrow.PrimitiveTypes.Uint64:
-	// 	v := s.Interface.(*array.Uint64).Uint64Values()
-	// 	vv := ss.Interface.(*array.Uint64).Uint64Values()
-	default:
+	if newAggConfig(opts...).propagatesNA(s) {
+		return math.NaN()
+	}
+
+	if promotedType(s.field.Type) == nil {
 		panic("series: std: unsupported type")
 	}
+	return stat.StdDev(validFloat64s(s), nil)
 }
 
 // Rename returns a Series with a new name.
@@ -829,6 +1457,42 @@ func (s Series) Map(fn func(interface{}) interface{}) Series {
 			result[i] = fn(v).(int64)
 		}
 		return FromInt64(s.pool, s.field, result, nil)
+	case []int8:
+		result := make([]int8, s.Len())
+		for i, v := range vs {
+			result[i] = fn(v).(int8)
+		}
+		return FromInt8(s.pool, s.field, result, nil)
+	case []int16:
+		result := make([]int16, s.Len())
+		for i, v := range vs {
+			result[i] = fn(v).(int16)
+		}
+		return FromInt16(s.pool, s.field, result, nil)
+	case []uint8:
+		result := make([]uint8, s.Len())
+		for i, v := range vs {
+			result[i] = fn(v).(uint8)
+		}
+		return FromUint8(s.pool, s.field, result, nil)
+	case []uint16:
+		result := make([]uint16, s.Len())
+		for i, v := range vs {
+			result[i] = fn(v).(uint16)
+		}
+		return FromUint16(s.pool, s.field, result, nil)
+	case []uint32:
+		result := make([]uint32, s.Len())
+		for i, v := range vs {
+			result[i] = fn(v).(uint32)
+		}
+		return FromUint32(s.pool, s.field, result, nil)
+	case []uint64:
+		result := make([]uint64, s.Len())
+		for i, v := range vs {
+			result[i] = fn(v).(uint64)
+		}
+		return FromUint64(s.pool, s.field, result, nil)
 	case []float32:
 		result := make([]float32, s.Len())
 		for i, v := range vs {
@@ -900,14 +1564,477 @@ func (s Series) Where(cs ...Condition) Series {
 			}
 		}
 		return FromFloat64(s.pool, s.field, vals, nil)
-	// // case arrow.PrimitiveTypes.Uint64:
-	// // 	v := s.Interface.(*array.Uint64).Uint64Values()
-	// // 	vv := ss.Interface.(*array.Uint64).Uint64Values()
+	case arrow.PrimitiveTypes.Int8:
+		vals := make([]int8, 0, s.Len())
+		for _, v := range s.Interface.(*array.Int8).Int8Values() {
+			for _, conditionFunc := range cs {
+				if conditionFunc(v) {
+					vals = append(vals, v)
+					break
+				}
+			}
+		}
+		return FromInt8(s.pool, s.field, vals, nil)
+	case arrow.PrimitiveTypes.Int16:
+		vals := make([]int16, 0, s.Len())
+		for _, v := range s.Interface.(*array.Int16).Int16Values() {
+			for _, conditionFunc := range cs {
+				if conditionFunc(v) {
+					vals = append(vals, v)
+					break
+				}
+			}
+		}
+		return FromInt16(s.pool, s.field, vals, nil)
+	case arrow.PrimitiveTypes.Uint8:
+		vals := make([]uint8, 0, s.Len())
+		for _, v := range s.Interface.(*array.Uint8).Uint8Values() {
+			for _, conditionFunc := range cs {
+				if conditionFunc(v) {
+					vals = append(vals, v)
+					break
+				}
+			}
+		}
+		return FromUint8(s.pool, s.field, vals, nil)
+	case arrow.PrimitiveTypes.Uint16:
+		vals := make([]uint16, 0, s.Len())
+		for _, v := range s.Interface.(*array.Uint16).Uint16Values() {
+			for _, conditionFunc := range cs {
+				if conditionFunc(v) {
+					vals = append(vals, v)
+					break
+				}
+			}
+		}
+		return FromUint16(s.pool, s.field, vals, nil)
+	case arrow.PrimitiveTypes.Uint32:
+		vals := make([]uint32, 0, s.Len())
+		for _, v := range s.Interface.(*array.Uint32).Uint32Values() {
+			for _, conditionFunc := range cs {
+				if conditionFunc(v) {
+					vals = append(vals, v)
+					break
+				}
+			}
+		}
+		return FromUint32(s.pool, s.field, vals, nil)
+	case arrow.PrimitiveTypes.Uint64:
+		vals := make([]uint64, 0, s.Len())
+		for _, v := range s.Interface.(*array.Uint64).Uint64Values() {
+			for _, conditionFunc := range cs {
+				if conditionFunc(v) {
+					vals = append(vals, v)
+					break
+				}
+			}
+		}
+		return FromUint64(s.pool, s.field, vals, nil)
 	default:
 		panic("series: where: unsupported type")
 	}
 }
 
+// Eq returns a Boolean Series which is true where values are equal to v. Null
+// values stay null.
+func (s Series) Eq(v interface{}) Series {
+	s.Retain()
+	defer s.Release()
+
+	cmp := s.comparator("eq", v)
+	return s.compare(func(i int) bool { return cmp(i, opEq) })
+}
+
+// Ne returns a Boolean Series which is true where values are not equal to v.
+// Null values stay null.
+func (s Series) Ne(v interface{}) Series {
+	s.Retain()
+	defer s.Release()
+
+	cmp := s.comparator("ne", v)
+	return s.compare(func(i int) bool { return cmp(i, opNe) })
+}
+
+// Lt returns a Boolean Series which is true where values are less than v. Null
+// values stay null.
+func (s Series) Lt(v interface{}) Series {
+	s.Retain()
+	defer s.Release()
+
+	cmp := s.comparator("lt", v)
+	return s.compare(func(i int) bool { return cmp(i, opLt) })
+}
+
+// Le returns a Boolean Series which is true where values are less than or
+// equal to v. Null values stay null.
+func (s Series) Le(v interface{}) Series {
+	s.Retain()
+	defer s.Release()
+
+	cmp := s.comparator("le", v)
+	return s.compare(func(i int) bool { return cmp(i, opLe) })
+}
+
+// Gt returns a Boolean Series which is true where values are greater than v.
+// Null values stay null.
+func (s Series) Gt(v interface{}) Series {
+	s.Retain()
+	defer s.Release()
+
+	cmp := s.comparator("gt", v)
+	return s.compare(func(i int) bool { return cmp(i, opGt) })
+}
+
+// Ge returns a Boolean Series which is true where values are greater than or
+// equal to v. Null values stay null.
+func (s Series) Ge(v interface{}) Series {
+	s.Retain()
+	defer s.Release()
+
+	cmp := s.comparator("ge", v)
+	return s.compare(func(i int) bool { return cmp(i, opGe) })
+}
+
+// Between returns a Boolean Series which is true where values are within the
+// inclusive range [lo, hi]. Null values stay null.
+func (s Series) Between(lo, hi interface{}) Series {
+	s.Retain()
+	defer s.Release()
+
+	cmpLo := s.comparator("between", lo)
+	cmpHi := s.comparator("between", hi)
+	return s.compare(func(i int) bool { return cmpLo(i, opGe) && cmpHi(i, opLe) })
+}
+
+// IsIn returns a Boolean Series which is true where values are equal to any of
+// vals. Null values stay null.
+func (s Series) IsIn(vals ...interface{}) Series {
+	s.Retain()
+	defer s.Release()
+
+	cmps := make([]func(int, compareOp) bool, len(vals))
+	for i, v := range vals {
+		cmps[i] = s.comparator("is_in", v)
+	}
+	return s.compare(func(i int) bool {
+		for _, cmp := range cmps {
+			if cmp(i, opEq) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// And returns the element-wise logical AND of two equal length Boolean Series.
+// Nulls follow three-valued logic: false AND null is false, otherwise a null
+// operand gives null.
+func (s Series) And(ss Series) Series {
+	s.Retain()
+	defer s.Release()
+	ss.Retain()
+	defer ss.Release()
+
+	a, aa := logical("and", s, ss)
+	vals := make([]bool, s.Len())
+	valid := make([]bool, s.Len())
+	for i := range vals {
+		falseA := a.IsValid(i) && !a.Value(i)
+		falseAA := aa.IsValid(i) && !aa.Value(i)
+		switch {
+		case falseA || falseAA:
+			valid[i] = true
+		case a.IsValid(i) && aa.IsValid(i):
+			vals[i] = true
+			valid[i] = true
+		}
+	}
+
+	return FromBool(s.pool, s.field, vals, valid)
+}
+
+// Or returns the element-wise logical OR of two equal length Boolean Series.
+// Nulls follow three-valued logic: true OR null is true, otherwise a null
+// operand gives null.
+func (s Series) Or(ss Series) Series {
+	s.Retain()
+	defer s.Release()
+	ss.Retain()
+	defer ss.Release()
+
+	a, aa := logical("or", s, ss)
+	vals := make([]bool, s.Len())
+	valid := make([]bool, s.Len())
+	for i := range vals {
+		trueA := a.IsValid(i) && a.Value(i)
+		trueAA := aa.IsValid(i) && aa.Value(i)
+		switch {
+		case trueA || trueAA:
+			vals[i] = true
+			valid[i] = true
+		case a.IsValid(i) && aa.IsValid(i):
+			valid[i] = true
+		}
+	}
+
+	return FromBool(s.pool, s.field, vals, valid)
+}
+
+// Not returns the element-wise logical NOT of a Boolean Series. Null values
+// stay null.
+func (s Series) Not() Series {
+	s.Retain()
+	defer s.Release()
+
+	a, _ := logical("not", s, s)
+	return s.compare(func(i int) bool { return !a.Value(i) })
+}
+
+// Filter returns a Series with the values where the Boolean mask is true.
+// Positions where the mask is null are dropped, and null values which are kept
+// stay null.
+func (s Series) Filter(mask Series) Series {
+	s.Retain()
+	defer s.Release()
+	mask.Retain()
+	defer mask.Release()
+
+	return s.take("filter", maskIndices("filter", mask, s.Len()))
+}
+
+// Take returns a Series with the values at the provided indices, in order and
+// keeping nulls. Negative indices produce nulls.
+func (s Series) Take(indices []int) Series {
+	s.Retain()
+	defer s.Release()
+
+	return s.take("take", indices)
+}
+
+// take returns a Series with the values at the provided indices, keeping
+// nulls. Negative indices produce nulls.
+func (s Series) take(method string, indices []int) Series {
+	var col array.Interface
+	switch s.field.Type {
+	case arrow.PrimitiveTypes.Int32:
+		a := s.Interface.(*array.Int32)
+		b := array.NewInt32Builder(s.pool)
+		defer b.Release()
+		b.Reserve(len(indices))
+		for _, i := range indices {
+			if i < 0 || a.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			b.Append(a.Value(i))
+		}
+		col = b.NewArray()
+	case arrow.PrimitiveTypes.Int64:
+		a := s.Interface.(*array.Int64)
+		b := array.NewInt64Builder(s.pool)
+		defer b.Release()
+		b.Reserve(len(indices))
+		for _, i := range indices {
+			if i < 0 || a.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			b.Append(a.Value(i))
+		}
+		col = b.NewArray()
+	case arrow.PrimitiveTypes.Float32:
+		a := s.Interface.(*array.Float32)
+		b := array.NewFloat32Builder(s.pool)
+		defer b.Release()
+		b.Reserve(len(indices))
+		for _, i := range indices {
+			if i < 0 || a.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			b.Append(a.Value(i))
+		}
+		col = b.NewArray()
+	case arrow.PrimitiveTypes.Float64:
+		a := s.Interface.(*array.Float64)
+		b := array.NewFloat64Builder(s.pool)
+		defer b.Release()
+		b.Reserve(len(indices))
+		for _, i := range indices {
+			if i < 0 || a.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			b.Append(a.Value(i))
+		}
+		col = b.NewArray()
+	case arrow.BinaryTypes.String:
+		a := s.Interface.(*array.String)
+		b := array.NewStringBuilder(s.pool)
+		defer b.Release()
+		b.Reserve(len(indices))
+		for _, i := range indices {
+			if i < 0 || a.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			b.Append(a.Value(i))
+		}
+		col = b.NewArray()
+	case arrow.FixedWidthTypes.Boolean:
+		a := s.Interface.(*array.Boolean)
+		b := array.NewBooleanBuilder(s.pool)
+		defer b.Release()
+		b.Reserve(len(indices))
+		for _, i := range indices {
+			if i < 0 || a.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			b.Append(a.Value(i))
+		}
+		col = b.NewArray()
+	case arrow.PrimitiveTypes.Int8:
+		a := s.Interface.(*array.Int8)
+		b := array.NewInt8Builder(s.pool)
+		defer b.Release()
+		b.Reserve(len(indices))
+		for _, i := range indices {
+			if i < 0 || a.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			b.Append(a.Value(i))
+		}
+		col = b.NewArray()
+	case arrow.PrimitiveTypes.Int16:
+		a := s.Interface.(*array.Int16)
+		b := array.NewInt16Builder(s.pool)
+		defer b.Release()
+		b.Reserve(len(indices))
+		for _, i := range indices {
+			if i < 0 || a.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			b.Append(a.Value(i))
+		}
+		col = b.NewArray()
+	case arrow.PrimitiveTypes.Uint8:
+		a := s.Interface.(*array.Uint8)
+		b := array.NewUint8Builder(s.pool)
+		defer b.Release()
+		b.Reserve(len(indices))
+		for _, i := range indices {
+			if i < 0 || a.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			b.Append(a.Value(i))
+		}
+		col = b.NewArray()
+	case arrow.PrimitiveTypes.Uint16:
+		a := s.Interface.(*array.Uint16)
+		b := array.NewUint16Builder(s.pool)
+		defer b.Release()
+		b.Reserve(len(indices))
+		for _, i := range indices {
+			if i < 0 || a.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			b.Append(a.Value(i))
+		}
+		col = b.NewArray()
+	case arrow.PrimitiveTypes.Uint32:
+		a := s.Interface.(*array.Uint32)
+		b := array.NewUint32Builder(s.pool)
+		defer b.Release()
+		b.Reserve(len(indices))
+		for _, i := range indices {
+			if i < 0 || a.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			b.Append(a.Value(i))
+		}
+		col = b.NewArray()
+	case arrow.PrimitiveTypes.Uint64:
+		a := s.Interface.(*array.Uint64)
+		b := array.NewUint64Builder(s.pool)
+		defer b.Release()
+		b.Reserve(len(indices))
+		for _, i := range indices {
+			if i < 0 || a.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			b.Append(a.Value(i))
+		}
+		col = b.NewArray()
+	case Categorical:
+		a := s.Interface.(*categoricalArray)
+		b := array.NewInt32Builder(s.pool)
+		defer b.Release()
+		b.Reserve(len(indices))
+		for _, i := range indices {
+			if i < 0 || a.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			b.Append(a.Int32.Value(i))
+		}
+		col = newCategoricalArray(b.NewInt32Array(), a.categories)
+	default:
+		if a, ok := s.Interface.(*array.Decimal128); ok {
+			b := array.NewDecimal128Builder(s.pool, s.field.Type.(*arrow.Decimal128Type))
+			defer b.Release()
+			b.Reserve(len(indices))
+			for _, i := range indices {
+				if i < 0 || a.IsNull(i) {
+					b.AppendNull()
+					continue
+				}
+				b.Append(a.Value(i))
+			}
+			col = b.NewArray()
+			break
+		}
+		if a, ok := s.Interface.(binaryArray); ok {
+			vals := make([][]byte, len(indices))
+			valid := make([]bool, len(indices))
+			for k, i := range indices {
+				if i < 0 || a.IsNull(i) {
+					continue
+				}
+				vals[k], valid[k] = a.Value(i), true
+			}
+			col = newBinaryArray(s.pool, s.field.Type, vals, valid)
+			break
+		}
+		if col = nestedTake(s, method, indices); col != nil {
+			break
+		}
+		raw := temporalRaw(s)
+		if raw == nil {
+			panic(fmt.Sprintf("series: %s: unsupported type", method))
+		}
+		b := newTemporalBuilder(s.pool, s.field.Type)
+		defer b.Release()
+		b.Reserve(len(indices))
+		for _, i := range indices {
+			if i < 0 || s.IsNull(i) {
+				b.AppendNull()
+				continue
+			}
+			appendTemporal(b, raw(i))
+		}
+		col = b.NewArray()
+	}
+
+	return FromArrow(s.pool, s.field, col)
+}
+
 // Head returns a Series with n values.
 func (s Series) Head(n int) Series {
 	s.Retain()
@@ -920,61 +2047,90 @@ func (s Series) Head(n int) Series {
 	return Series{
 		pool:      s.pool,
 		field:     s.field,
-		Interface: array.NewSlice(s.Interface, 0, int64(n)),
+		Interface: newSlice(s.Interface, 0, int64(n)),
 	}
 }
 
-// SortValues returns a Series with sorted values.
+// SortValues returns a Series with sorted values. Null values are placed last.
 //
 // TODO(poopoothegorilla): add sort options
 func (s Series) SortValues() Series {
 	s.Retain()
 	defer s.Release()
 
+	var less func(i, j int) bool
 	switch s.field.Type {
 	case arrow.PrimitiveTypes.Int32:
-		vv := make([]int, s.Len())
-		for i, v := range s.Interface.(*array.Int32).Int32Values() {
-			vv[i] = int(v)
-		}
-		sort.Ints(vv)
-		sortedVals := make([]int32, s.Len())
-		for i, v := range vv {
-			sortedVals[i] = int32(v)
-		}
-
-		return FromInt32(s.pool, s.field, sortedVals, nil)
+		a := s.Interface.(*array.Int32)
+		less = func(i, j int) bool { return a.Value(i) < a.Value(j) }
 	case arrow.PrimitiveTypes.Int64:
-		vv := make([]int, s.Len())
-		for i, v := range s.Interface.(*array.Int64).Int64Values() {
-			vv[i] = int(v)
-		}
-		sort.Ints(vv)
-		sortedVals := make([]int64, s.Len())
-		for i, v := range vv {
-			sortedVals[i] = int64(v)
-		}
-
-		return FromInt64(s.pool, s.field, sortedVals, nil)
+		a := s.Interface.(*array.Int64)
+		less = func(i, j int) bool { return a.Value(i) < a.Value(j) }
 	case arrow.PrimitiveTypes.Float32:
-		vv := make([]float64, s.Len())
-		for i, v := range s.Interface.(*array.Float32).Float32Values() {
-			vv[i] = float64(v)
-		}
-		sort.Float64s(vv)
-		sortedVals := make([]float32, s.Len())
-		for i, v := range vv {
-			sortedVals[i] = float32(v)
-		}
-
-		return FromFloat32(s.pool, s.field, sortedVals, nil)
+		a := s.Interface.(*array.Float32)
+		less = func(i, j int) bool { return floatLess(float64(a.Value(i)), float64(a.Value(j))) }
 	case arrow.PrimitiveTypes.Float64:
-		vals := s.Interface.(*array.Float64).Float64Values()
-		sort.Float64s(vals)
-		return FromFloat64(s.pool, s.field, vals, nil)
+		a := s.Interface.(*array.Float64)
+		less = func(i, j int) bool { return floatLess(a.Value(i), a.Value(j)) }
+	case arrow.PrimitiveTypes.Int8:
+		a := s.Interface.(*array.Int8)
+		less = func(i, j int) bool { return a.Value(i) < a.Value(j) }
+	case arrow.PrimitiveTypes.Int16:
+		a := s.Interface.(*array.Int16)
+		less = func(i, j int) bool { return a.Value(i) < a.Value(j) }
+	case arrow.PrimitiveTypes.Uint8:
+		a := s.Interface.(*array.Uint8)
+		less = func(i, j int) bool { return a.Value(i) < a.Value(j) }
+	case arrow.PrimitiveTypes.Uint16:
+		a := s.Interface.(*array.Uint16)
+		less = func(i, j int) bool { return a.Value(i) < a.Value(j) }
+	case arrow.PrimitiveTypes.Uint32:
+		a := s.Interface.(*array.Uint32)
+		less = func(i, j int) bool { return a.Value(i) < a.Value(j) }
+	case arrow.PrimitiveTypes.Uint64:
+		a := s.Interface.(*array.Uint64)
+		less = func(i, j int) bool { return a.Value(i) < a.Value(j) }
+	case Categorical:
+		return categoricalSortValues(s)
 	default:
+		if _, ok := s.Interface.(*array.Decimal128); ok {
+			return decimalSortValues(s)
+		}
+		if isBinary(s.field.Type) {
+			return binarySortValues(s)
+		}
+		if isTemporal(s.field.Type) {
+			return temporalSortValues(s)
+		}
 		panic("series: sort_values: unknown type")
 	}
+
+	return s.take("sort_values", sortedIndices(s, less))
+}
+
+// sortedIndices returns the positions of the valid values of the s Series in
+// the stable order of less, followed by the positions of the null values.
+func sortedIndices(s Series, less func(i, j int) bool) []int {
+	indices := make([]int, 0, s.Len())
+	var nulls []int
+	for i := 0; i < s.Len(); i++ {
+		if s.IsNull(i) {
+			nulls = append(nulls, i)
+			continue
+		}
+		indices = append(indices, i)
+	}
+	sort.SliceStable(indices, func(i, j int) bool {
+		return less(indices[i], indices[j])
+	})
+
+	return append(indices, nulls...)
+}
+
+// floatLess reports whether x sorts before y, placing NaN first as
+// sort.Float64s does.
+func floatLess(x, y float64) bool {
+	return x < y || (math.IsNaN(x) && !math.IsNaN(y))
 }
 
 // DropNA returns a Series without null values.
@@ -1023,13 +2179,94 @@ func (s Series) DropNA() Series {
 			vals = append(vals, is.Value(i))
 		}
 		return FromFloat64(s.pool, s.field, vals, nil)
+	case arrow.PrimitiveTypes.Int8:
+		vals := make([]int8, 0, s.Len()-s.NullN())
+		is := s.Interface.(*array.Int8)
+		for i := 0; i < is.Len(); i++ {
+			if is.IsNull(i) {
+				continue
+			}
+			vals = append(vals, is.Value(i))
+		}
+		return FromInt8(s.pool, s.field, vals, nil)
+	case arrow.PrimitiveTypes.Int16:
+		vals := make([]int16, 0, s.Len()-s.NullN())
+		is := s.Interface.(*array.Int16)
+		for i := 0; i < is.Len(); i++ {
+			if is.IsNull(i) {
+				continue
+			}
+			vals = append(vals, is.Value(i))
+		}
+		return FromInt16(s.pool, s.field, vals, nil)
+	case arrow.PrimitiveTypes.Uint8:
+		vals := make([]uint8, 0, s.Len()-s.NullN())
+		is := s.Interface.(*array.Uint8)
+		for i := 0; i < is.Len(); i++ {
+			if is.IsNull(i) {
+				continue
+			}
+			vals = append(vals, is.Value(i))
+		}
+		return FromUint8(s.pool, s.field, vals, nil)
+	case arrow.PrimitiveTypes.Uint16:
+		vals := make([]uint16, 0, s.Len()-s.NullN())
+		is := s.Interface.(*array.Uint16)
+		for i := 0; i < is.Len(); i++ {
+			if is.IsNull(i) {
+				continue
+			}
+			vals = append(vals, is.Value(i))
+		}
+		return FromUint16(s.pool, s.field, vals, nil)
+	case arrow.PrimitiveTypes.Uint32:
+		vals := make([]uint32, 0, s.Len()-s.NullN())
+		is := s.Interface.(*array.Uint32)
+		for i := 0; i < is.Len(); i++ {
+			if is.IsNull(i) {
+				continue
+			}
+			vals = append(vals, is.Value(i))
+		}
+		return FromUint32(s.pool, s.field, vals, nil)
+	case arrow.PrimitiveTypes.Uint64:
+		vals := make([]uint64, 0, s.Len()-s.NullN())
+		is := s.Interface.(*array.Uint64)
+		for i := 0; i < is.Len(); i++ {
+			if is.IsNull(i) {
+				continue
+			}
+			vals = append(vals, is.Value(i))
+		}
+		return FromUint64(s.pool, s.field, vals, nil)
+	case Categorical:
+		indices := make([]int, 0, s.Len()-s.NullN())
+		for i := 0; i < s.Len(); i++ {
+			if s.IsValid(i) {
+				indices = append(indices, i)
+			}
+		}
+		return s.take("drop_na", indices)
 	default:
-		panic("series: drop_na: unsupported type")
+		switch s.Interface.(type) {
+		case *array.Decimal128, *array.Binary, *array.FixedSizeBinary, *array.List, *array.Struct:
+		default:
+			panic("series: drop_na: unsupported type")
+		}
+		indices := make([]int, 0, s.Len()-s.NullN())
+		for i := 0; i < s.Len(); i++ {
+			if s.IsValid(i) {
+				indices = append(indices, i)
+			}
+		}
+		return s.take("drop_na", indices)
 	}
 }
 
 // Dot returns the Dot product of all values in the Series as a float64 value.
-func (s Series) Dot(ss Series) float64 {
+// Positions with a null in either Series are skipped unless set otherwise by
+// WithSkipNA.
+func (s Series) Dot(ss Series, opts ...AggOption) float64 {
 	s.Retain()
 	defer s.Release()
 	ss.Retain()
@@ -1041,6 +2278,9 @@ func (s Series) Dot(ss Series) float64 {
 	if s.field.Type != ss.field.Type {
 		panic("series: dot: series types do not match")
 	}
+	if newAggConfig(opts...).propagatesNA(s, ss) {
+		return math.NaN()
+	}
 
 	switch s.field.Type {
 	case arrow.PrimitiveTypes.Int32:
@@ -1056,55 +2296,102 @@ func (s Series) Dot(ss Series) float64 {
 		vv := ss.Interface.(*array.Float32)
 		return float64(float32Dot(v, vv))
 	case arrow.PrimitiveTypes.Float64:
-		v := s.Interface.(*array.Float64).Float64Values()
-		vv := ss.Interface.(*array.Float64).Float64Values()
-		return floats.Dot(v, vv)
-	// case arrow.PrimitiveTypes.Uint64:
-	// 	v := s.Interface.(*array.Uint64)
-	// 	vv := ss.Interface.(*array.Uint64)
-	// return float64(uint64Dot(v, vv))
+		v := s.Interface.(*array.Float64)
+		vv := ss.Interface.(*array.Float64)
+		return float64Dot(v, vv)
+	case arrow.PrimitiveTypes.Int8:
+		v := s.Interface.(*array.Int8)
+		vv := ss.Interface.(*array.Int8)
+		return float64(int8Dot(v, vv))
+	case arrow.PrimitiveTypes.Int16:
+		v := s.Interface.(*array.Int16)
+		vv := ss.Interface.(*array.Int16)
+		return float64(int16Dot(v, vv))
+	case arrow.PrimitiveTypes.Uint8:
+		v := s.Interface.(*array.Uint8)
+		vv := ss.Interface.(*array.Uint8)
+		return float64(uint8Dot(v, vv))
+	case arrow.PrimitiveTypes.Uint16:
+		v := s.Interface.(*array.Uint16)
+		vv := ss.Interface.(*array.Uint16)
+		return float64(uint16Dot(v, vv))
+	case arrow.PrimitiveTypes.Uint32:
+		v := s.Interface.(*array.Uint32)
+		vv := ss.Interface.(*array.Uint32)
+		return float64(uint32Dot(v, vv))
+	case arrow.PrimitiveTypes.Uint64:
+		v := s.Interface.(*array.Uint64)
+		vv := ss.Interface.(*array.Uint64)
+		return float64(uint64Dot(v, vv))
 	default:
 		return mat.Dot(s, ss)
 	}
 }
 
-// Abs returns a Series with all absolute values.
-//
-// TODO(poopoothegorilla): need to pass valids into new Series.
+// Abs returns a Series with all absolute values. Null values stay null.
 func (s Series) Abs() Series {
 	s.Retain()
 	defer s.Release()
 
+	valid := validity(s.Interface)
 	switch s.field.Type {
 	case arrow.PrimitiveTypes.Int32:
 		v := s.Interface.(*array.Int32)
 		f := arrow.Field{Name: s.field.Name, Type: arrow.PrimitiveTypes.Int32}
-		return FromInt32(s.pool, f, int32Abs(v), nil)
+		return FromInt32(s.pool, f, int32Abs(v), valid)
 	case arrow.PrimitiveTypes.Int64:
 		v := s.Interface.(*array.Int64)
 		f := arrow.Field{Name: s.field.Name, Type: arrow.PrimitiveTypes.Int64}
-		return FromInt64(s.pool, f, int64Abs(v), nil)
+		return FromInt64(s.pool, f, int64Abs(v), valid)
 	case arrow.PrimitiveTypes.Float32:
 		v := s.Interface.(*array.Float32)
 		f := arrow.Field{Name: s.field.Name, Type: arrow.PrimitiveTypes.Float32}
-		return FromFloat32(s.pool, f, float32Abs(v), nil)
+		return FromFloat32(s.pool, f, float32Abs(v), valid)
 	case arrow.PrimitiveTypes.Float64:
 		v := s.Interface.(*array.Float64)
 		f := arrow.Field{Name: s.field.Name, Type: arrow.PrimitiveTypes.Float64}
-		return FromFloat64(s.pool, f, float64Abs(v), nil)
-	// case arrow.PrimitiveTypes.Uint64:
-	// 	v := s.Interface.(*array.Uint64)
-	// return float64(uint64Square(v))
+		return FromFloat64(s.pool, f, float64Abs(v), valid)
+	case arrow.PrimitiveTypes.Int8:
+		v := s.Interface.(*array.Int8)
+		f := arrow.Field{Name: s.field.Name, Type: arrow.PrimitiveTypes.Int8}
+		return FromInt8(s.pool, f, int8Abs(v), valid)
+	case arrow.PrimitiveTypes.Int16:
+		v := s.Interface.(*array.Int16)
+		f := arrow.Field{Name: s.field.Name, Type: arrow.PrimitiveTypes.Int16}
+		return FromInt16(s.pool, f, int16Abs(v), valid)
+	case arrow.PrimitiveTypes.Uint8:
+		v := s.Interface.(*array.Uint8)
+		f := arrow.Field{Name: s.field.Name, Type: arrow.PrimitiveTypes.Uint8}
+		return FromUint8(s.pool, f, uint8Abs(v), valid)
+	case arrow.PrimitiveTypes.Uint16:
+		v := s.Interface.(*array.Uint16)
+		f := arrow.Field{Name: s.field.Name, Type: arrow.PrimitiveTypes.Uint16}
+		return FromUint16(s.pool, f, uint16Abs(v), valid)
+	case arrow.PrimitiveTypes.Uint32:
+		v := s.Interface.(*array.Uint32)
+		f := arrow.Field{Name: s.field.Name, Type: arrow.PrimitiveTypes.Uint32}
+		return FromUint32(s.pool, f, uint32Abs(v), valid)
+	case arrow.PrimitiveTypes.Uint64:
+		v := s.Interface.(*array.Uint64)
+		f := arrow.Field{Name: s.field.Name, Type: arrow.PrimitiveTypes.Uint64}
+		return FromUint64(s.pool, f, uint64Abs(v), valid)
 	default:
 		panic("series: square: unsupported type")
 	}
 }
 
-// Min returns the minimum value of the Series as a float64.
-func (s Series) Min() float64 {
+// Min returns the minimum value of the Series as a float64, or NaN if there
+// are no values. NaN values are skipped, as are null values unless set
+// otherwise by WithSkipNA.
+// Temporal values are returned raw and may lose precision, see MinTime.
+func (s Series) Min(opts ...AggOption) float64 {
 	s.Retain()
 	defer s.Release()
 
+	if newAggConfig(opts...).propagatesNA(s) {
+		return math.NaN()
+	}
+
 	switch s.field.Type {
 	case arrow.PrimitiveTypes.Int32:
 		v := s.Interface.(*array.Int32)
@@ -1118,16 +2405,44 @@ func (s Series) Min() float64 {
 	case arrow.PrimitiveTypes.Float64:
 		v := s.Interface.(*array.Float64)
 		return float64Min(v)
+	case arrow.PrimitiveTypes.Int8:
+		v := s.Interface.(*array.Int8)
+		return int8Min(v)
+	case arrow.PrimitiveTypes.Int16:
+		v := s.Interface.(*array.Int16)
+		return int16Min(v)
+	case arrow.PrimitiveTypes.Uint8:
+		v := s.Interface.(*array.Uint8)
+		return uint8Min(v)
+	case arrow.PrimitiveTypes.Uint16:
+		v := s.Interface.(*array.Uint16)
+		return uint16Min(v)
+	case arrow.PrimitiveTypes.Uint32:
+		v := s.Interface.(*array.Uint32)
+		return uint32Min(v)
+	case arrow.PrimitiveTypes.Uint64:
+		v := s.Interface.(*array.Uint64)
+		return uint64Min(v)
 	default:
+		if isTemporal(s.field.Type) {
+			return temporalExtreme(s, false)
+		}
 		panic("series: min: unsupported type")
 	}
 }
 
-// Max returns the maximum value of the Series as a float64.
-func (s Series) Max() float64 {
+// Max returns the maximum value of the Series as a float64, or NaN if there
+// are no values. NaN values are skipped, as are null values unless set
+// otherwise by WithSkipNA.
+// Temporal values are returned raw and may lose precision, see MaxTime.
+func (s Series) Max(opts ...AggOption) float64 {
 	s.Retain()
 	defer s.Release()
 
+	if newAggConfig(opts...).propagatesNA(s) {
+		return math.NaN()
+	}
+
 	switch s.field.Type {
 	case arrow.PrimitiveTypes.Int32:
 		v := s.Interface.(*array.Int32)
@@ -1141,16 +2456,42 @@ func (s Series) Max() float64 {
 	case arrow.PrimitiveTypes.Float64:
 		v := s.Interface.(*array.Float64)
 		return float64Max(v)
+	case arrow.PrimitiveTypes.Int8:
+		v := s.Interface.(*array.Int8)
+		return int8Max(v)
+	case arrow.PrimitiveTypes.Int16:
+		v := s.Interface.(*array.Int16)
+		return int16Max(v)
+	case arrow.PrimitiveTypes.Uint8:
+		v := s.Interface.(*array.Uint8)
+		return uint8Max(v)
+	case arrow.PrimitiveTypes.Uint16:
+		v := s.Interface.(*array.Uint16)
+		return uint16Max(v)
+	case arrow.PrimitiveTypes.Uint32:
+		v := s.Interface.(*array.Uint32)
+		return uint32Max(v)
+	case arrow.PrimitiveTypes.Uint64:
+		v := s.Interface.(*array.Uint64)
+		return uint64Max(v)
 	default:
+		if isTemporal(s.field.Type) {
+			return temporalExtreme(s, true)
+		}
 		panic("series: max: unsupported type")
 	}
 }
 
-// Mean retuns the mean of the Series as a float64.
-func (s Series) Mean() float64 {
+// Mean retuns the mean of the Series as a float64. Null values are skipped
+// unless set otherwise by WithSkipNA.
+func (s Series) Mean(opts ...AggOption) float64 {
 	s.Retain()
 	defer s.Release()
 
+	if newAggConfig(opts...).propagatesNA(s) {
+		return math.NaN()
+	}
+
 	switch s.field.Type {
 	case arrow.PrimitiveTypes.Int32:
 		v := s.Interface.(*array.Int32)
@@ -1164,61 +2505,124 @@ func (s Series) Mean() float64 {
 	case arrow.PrimitiveTypes.Float64:
 		v := s.Interface.(*array.Float64)
 		return float64Mean(v)
+	case arrow.PrimitiveTypes.Int8:
+		v := s.Interface.(*array.Int8)
+		return int8Mean(v)
+	case arrow.PrimitiveTypes.Int16:
+		v := s.Interface.(*array.Int16)
+		return int16Mean(v)
+	case arrow.PrimitiveTypes.Uint8:
+		v := s.Interface.(*array.Uint8)
+		return uint8Mean(v)
+	case arrow.PrimitiveTypes.Uint16:
+		v := s.Interface.(*array.Uint16)
+		return uint16Mean(v)
+	case arrow.PrimitiveTypes.Uint32:
+		v := s.Interface.(*array.Uint32)
+		return uint32Mean(v)
+	case arrow.PrimitiveTypes.Uint64:
+		v := s.Interface.(*array.Uint64)
+		return uint64Mean(v)
 	default:
-		panic("series: mean: unsupported type")
+		a, ok := s.Interface.(*array.Decimal128)
+		if !ok {
+			panic("series: mean: unsupported type")
+		}
+		n := a.Len() - a.NullN()
+		if n == 0 {
+			return math.NaN()
+		}
+		mean := new(big.Rat).SetFrac(decimalSum(a), pow10(s.field.Type.(*arrow.Decimal128Type).Scale))
+		mean.Quo(mean, new(big.Rat).SetInt64(int64(n)))
+		val, _ := mean.Float64()
+		return val
 	}
 }
 
-// Median returns the median value of the Series as a float64.
+// Median returns the median value of the Series as a float64, or NaN if there
+// are no values. Null values are skipped unless set otherwise by WithSkipNA.
 //
 // TODO(poopoothegorilla): inefficient could be improved
-func (s Series) Median() float64 {
+func (s Series) Median(opts ...AggOption) float64 {
 	s.Retain()
 	defer s.Release()
 
-	s2 := s.SortValues()
-	defer s2.Release()
+	if newAggConfig(opts...).propagatesNA(s) {
+		return math.NaN()
+	}
 
-	m := s2.Len() / 2
-	rv := s2.AtVec(m)
-	if s2.Len()%2 != 0 {
-		return rv
+	vals := make([]float64, 0, s.Len()-s.NullN())
+	for i := 0; i < s.Len(); i++ {
+		if s.IsValid(i) {
+			vals = append(vals, s.AtVec(i))
+		}
+	}
+	if len(vals) == 0 {
+		return math.NaN()
 	}
-	lv := s2.AtVec(m - 1)
-	return (lv + rv) / float64(2)
+	sort.Float64s(vals)
+
+	m := len(vals) / 2
+	if len(vals)%2 != 0 {
+		return vals[m]
+	}
+	return (vals[m-1] + vals[m]) / float64(2)
 }
 
-// Square returns a Series with all values squared.
+// Square returns a Series with all values squared. Null values stay null.
 func (s Series) Square() Series {
 	s.Retain()
 	defer s.Release()
 
+	valid := validity(s.Interface)
 	switch s.field.Type {
 	case arrow.PrimitiveTypes.Int32:
 		v := s.Interface.(*array.Int32)
 		f := arrow.Field{Name: s.field.Name, Type: arrow.PrimitiveTypes.Int64}
-		return FromInt64(s.pool, f, int32Square(v), nil)
+		return FromInt64(s.pool, f, int32Square(v), valid)
 	case arrow.PrimitiveTypes.Int64:
 		v := s.Interface.(*array.Int64)
 		f := arrow.Field{Name: s.field.Name, Type: arrow.PrimitiveTypes.Int64}
-		return FromInt64(s.pool, f, int64Square(v), nil)
+		return FromInt64(s.pool, f, int64Square(v), valid)
 	case arrow.PrimitiveTypes.Float32:
 		v := s.Interface.(*array.Float32)
 		f := arrow.Field{Name: s.field.Name, Type: arrow.PrimitiveTypes.Float64}
-		return FromFloat64(s.pool, f, float32Square(v), nil)
+		return FromFloat64(s.pool, f, float32Square(v), valid)
 	case arrow.PrimitiveTypes.Float64:
 		v := s.Interface.(*array.Float64)
 		f := arrow.Field{Name: s.field.Name, Type: arrow.PrimitiveTypes.Float64}
-		return FromFloat64(s.pool, f, float64Square(v), nil)
-	// case arrow.PrimitiveTypes.Uint64:
-	// 	v := s.Interface.(*array.Uint64)
-	// return float64(uint64Square(v))
+		return FromFloat64(s.pool, f, float64Square(v), valid)
+	case arrow.PrimitiveTypes.Int8:
+		v := s.Interface.(*array.Int8)
+		f := arrow.Field{Name: s.field.Name, Type: arrow.PrimitiveTypes.Int64}
+		return FromInt64(s.pool, f, int8Square(v), valid)
+	case arrow.PrimitiveTypes.Int16:
+		v := s.Interface.(*array.Int16)
+		f := arrow.Field{Name: s.field.Name, Type: arrow.PrimitiveTypes.Int64}
+		return FromInt64(s.pool, f, int16Square(v), valid)
+	case arrow.PrimitiveTypes.Uint8:
+		v := s.Interface.(*array.Uint8)
+		f := arrow.Field{Name: s.field.Name, Type: arrow.PrimitiveTypes.Uint64}
+		return FromUint64(s.pool, f, uint8Square(v), valid)
+	case arrow.PrimitiveTypes.Uint16:
+		v := s.Interface.(*array.Uint16)
+		f := arrow.Field{Name: s.field.Name, Type: arrow.PrimitiveTypes.Uint64}
+		return FromUint64(s.pool, f, uint16Square(v), valid)
+	case arrow.PrimitiveTypes.Uint32:
+		v := s.Interface.(*array.Uint32)
+		f := arrow.Field{Name: s.field.Name, Type: arrow.PrimitiveTypes.Uint64}
+		return FromUint64(s.pool, f, uint32Square(v), valid)
+	case arrow.PrimitiveTypes.Uint64:
+		v := s.Interface.(*array.Uint64)
+		f := arrow.Field{Name: s.field.Name, Type: arrow.PrimitiveTypes.Uint64}
+		return FromUint64(s.pool, f, uint64Square(v), valid)
 	default:
 		panic("series: square: unsupported type")
 	}
 }
 
-// Sqrt returns a Series with the square root of all values.
+// Sqrt returns a Series with the square root of all values. Null values stay
+// null.
 func (s Series) Sqrt() Series {
 	s.Retain()
 	defer s.Release()
@@ -1237,18 +2641,34 @@ func (s Series) Sqrt() Series {
 	case arrow.PrimitiveTypes.Float64:
 		v := s.Interface.(*array.Float64)
 		vals = float64Sqrt(v)
-	// case arrow.PrimitiveTypes.Uint64:
-	// 	v := s.Interface.(*array.Uint64)
-	// return float64(uint64Square(v))
+	case arrow.PrimitiveTypes.Int8:
+		v := s.Interface.(*array.Int8)
+		vals = int8Sqrt(v)
+	case arrow.PrimitiveTypes.Int16:
+		v := s.Interface.(*array.Int16)
+		vals = int16Sqrt(v)
+	case arrow.PrimitiveTypes.Uint8:
+		v := s.Interface.(*array.Uint8)
+		vals = uint8Sqrt(v)
+	case arrow.PrimitiveTypes.Uint16:
+		v := s.Interface.(*array.Uint16)
+		vals = uint16Sqrt(v)
+	case arrow.PrimitiveTypes.Uint32:
+		v := s.Interface.(*array.Uint32)
+		vals = uint32Sqrt(v)
+	case arrow.PrimitiveTypes.Uint64:
+		v := s.Interface.(*array.Uint64)
+		vals = uint64Sqrt(v)
 	default:
 		panic("series: sqrt: unsupported type")
 	}
 
 	f := arrow.Field{Name: s.field.Name, Type: arrow.PrimitiveTypes.Float64}
-	return FromFloat64(s.pool, f, vals, nil)
+	return FromFloat64(s.pool, f, vals, validity(s.Interface))
 }
 
 // Add adds two equal length and type Series and returns the resulting Series.
+// A null on either side gives a null.
 func (s Series) Add(ss Series) Series {
 	s.Retain()
 	defer s.Release()
@@ -1258,39 +2678,64 @@ func (s Series) Add(ss Series) Series {
 	if s.Len() != ss.Len() {
 		panic("series: add: series lengths do not match")
 	}
+	if _, ok := s.Interface.(*array.Decimal128); ok {
+		return decimalArith("add", s, ss, func(x, y *big.Int) *big.Int { return x.Add(x, y) })
+	}
 	if s.field.Type != ss.field.Type {
 		panic("series: add: series types do not match")
 	}
 
+	valid := validity(s.Interface, ss.Interface)
 	switch s.field.Type {
 	case arrow.PrimitiveTypes.Int32:
 		v := s.Interface.(*array.Int32)
 		vv := ss.Interface.(*array.Int32)
-		return FromInt32(s.pool, s.field, int32Add(v, vv), nil)
+		return FromInt32(s.pool, s.field, int32Add(v, vv), valid)
 	case arrow.PrimitiveTypes.Int64:
 		v := s.Interface.(*array.Int64)
 		vv := ss.Interface.(*array.Int64)
-		return FromInt64(s.pool, s.field, int64Add(v, vv), nil)
+		return FromInt64(s.pool, s.field, int64Add(v, vv), valid)
 	case arrow.PrimitiveTypes.Float32:
 		v := s.Interface.(*array.Float32)
 		vv := ss.Interface.(*array.Float32)
-		return FromFloat32(s.pool, s.field, float32Add(v, vv), nil)
+		return FromFloat32(s.pool, s.field, float32Add(v, vv), valid)
 	case arrow.PrimitiveTypes.Float64:
 		v := s.Interface.(*array.Float64).Float64Values()
 		vv := ss.Interface.(*array.Float64).Float64Values()
 		dst := make([]float64, s.Len())
 		floats.SubTo(dst, v, vv)
-		return FromFloat64(s.pool, s.field, floats.AddTo(dst, v, vv), nil)
-	// case arrow.PrimitiveTypes.Uint64:
-	// 	v := s.Interface.(*array.Uint64).Uint64Values()
-	// 	vv := ss.Interface.(*array.Uint64).Uint64Values()
+		return FromFloat64(s.pool, s.field, floats.AddTo(dst, v, vv), valid)
+	case arrow.PrimitiveTypes.Int8:
+		v := s.Interface.(*array.Int8)
+		vv := ss.Interface.(*array.Int8)
+		return FromInt8(s.pool, s.field, int8Add(v, vv), valid)
+	case arrow.PrimitiveTypes.Int16:
+		v := s.Interface.(*array.Int16)
+		vv := ss.Interface.(*array.Int16)
+		return FromInt16(s.pool, s.field, int16Add(v, vv), valid)
+	case arrow.PrimitiveTypes.Uint8:
+		v := s.Interface.(*array.Uint8)
+		vv := ss.Interface.(*array.Uint8)
+		return FromUint8(s.pool, s.field, uint8Add(v, vv), valid)
+	case arrow.PrimitiveTypes.Uint16:
+		v := s.Interface.(*array.Uint16)
+		vv := ss.Interface.(*array.Uint16)
+		return FromUint16(s.pool, s.field, uint16Add(v, vv), valid)
+	case arrow.PrimitiveTypes.Uint32:
+		v := s.Interface.(*array.Uint32)
+		vv := ss.Interface.(*array.Uint32)
+		return FromUint32(s.pool, s.field, uint32Add(v, vv), valid)
+	case arrow.PrimitiveTypes.Uint64:
+		v := s.Interface.(*array.Uint64)
+		vv := ss.Interface.(*array.Uint64)
+		return FromUint64(s.pool, s.field, uint64Add(v, vv), valid)
 	default:
 		panic("series: add: unsupported type")
 	}
 }
 
 // Subtract subtracts two equal length and type Series and returns the resulting
-// Series.
+// Series. A null on either side gives a null.
 func (s Series) Subtract(ss Series) Series {
 	s.Retain()
 	defer s.Release()
@@ -1300,33 +2745,57 @@ func (s Series) Subtract(ss Series) Series {
 	if s.Len() != ss.Len() {
 		panic("series: subtract: series lengths do not match")
 	}
+	if _, ok := s.Interface.(*array.Decimal128); ok {
+		return decimalArith("subtract", s, ss, func(x, y *big.Int) *big.Int { return x.Sub(x, y) })
+	}
 	if s.field.Type != ss.field.Type {
 		panic("series: subtract: series types do not match")
 	}
 
+	valid := validity(s.Interface, ss.Interface)
 	switch s.field.Type {
 	case arrow.PrimitiveTypes.Int32:
 		v := s.Interface.(*array.Int32)
 		vv := ss.Interface.(*array.Int32)
-		return FromInt32(s.pool, s.field, int32Subtract(v, vv), nil)
+		return FromInt32(s.pool, s.field, int32Subtract(v, vv), valid)
 	case arrow.PrimitiveTypes.Int64:
 		v := s.Interface.(*array.Int64)
 		vv := ss.Interface.(*array.Int64)
-		return FromInt64(s.pool, s.field, int64Subtract(v, vv), nil)
+		return FromInt64(s.pool, s.field, int64Subtract(v, vv), valid)
 	case arrow.PrimitiveTypes.Float32:
 		v := s.Interface.(*array.Float32)
 		vv := ss.Interface.(*array.Float32)
-		return FromFloat32(s.pool, s.field, float32Subtract(v, vv), nil)
+		return FromFloat32(s.pool, s.field, float32Subtract(v, vv), valid)
 	case arrow.PrimitiveTypes.Float64:
 		v := s.Interface.(*array.Float64).Float64Values()
 		vv := ss.Interface.(*array.Float64).Float64Values()
 		dst := make([]float64, s.Len())
 		floats.SubTo(dst, v, vv)
-		return FromFloat64(s.pool, s.field, dst, nil)
-	// case arrow.PrimitiveTypes.Uint64:
-	// 	v := s.Interface.(*array.Uint64)
-	// 	vv := ss.Interface.(*array.Uint64)
-	// return FromUint64(s.field, uint64Subtract(v, vv), s.pool)
+		return FromFloat64(s.pool, s.field, dst, valid)
+	case arrow.PrimitiveTypes.Int8:
+		v := s.Interface.(*array.Int8)
+		vv := ss.Interface.(*array.Int8)
+		return FromInt8(s.pool, s.field, int8Subtract(v, vv), valid)
+	case arrow.PrimitiveTypes.Int16:
+		v := s.Interface.(*array.Int16)
+		vv := ss.Interface.(*array.Int16)
+		return FromInt16(s.pool, s.field, int16Subtract(v, vv), valid)
+	case arrow.PrimitiveTypes.Uint8:
+		v := s.Interface.(*array.Uint8)
+		vv := ss.Interface.(*array.Uint8)
+		return FromUint8(s.pool, s.field, uint8Subtract(v, vv), valid)
+	case arrow.PrimitiveTypes.Uint16:
+		v := s.Interface.(*array.Uint16)
+		vv := ss.Interface.(*array.Uint16)
+		return FromUint16(s.pool, s.field, uint16Subtract(v, vv), valid)
+	case arrow.PrimitiveTypes.Uint32:
+		v := s.Interface.(*array.Uint32)
+		vv := ss.Interface.(*array.Uint32)
+		return FromUint32(s.pool, s.field, uint32Subtract(v, vv), valid)
+	case arrow.PrimitiveTypes.Uint64:
+		v := s.Interface.(*array.Uint64)
+		vv := ss.Interface.(*array.Uint64)
+		return FromUint64(s.pool, s.field, uint64Subtract(v, vv), valid)
 	default:
 		panic("series: subtract: unsupported type")
 	}
@@ -1344,24 +2813,79 @@ func (s Series) Append(ss Series) Series {
 		panic("series: append: series types do not match")
 	}
 
+	// NOTE: the values are copied into a new slice as appending to the values
+	// of s would write into its Arrow buffer when s is a slice.
+	valid := appendValidity(s, ss)
+
 	switch s.field.Type {
 	case arrow.PrimitiveTypes.Int32:
-		v := s.Interface.(*array.Int32).Int32Values()
-		vv := ss.Interface.(*array.Int32).Int32Values()
-		return FromInt32(s.pool, s.field, append(v, vv...), nil)
+		vals := make([]int32, 0, s.Len()+ss.Len())
+		vals = append(vals, s.Interface.(*array.Int32).Int32Values()...)
+		vals = append(vals, ss.Interface.(*array.Int32).Int32Values()...)
+		return FromInt32(s.pool, s.field, vals, valid)
 	case arrow.PrimitiveTypes.Int64:
-		v := s.Interface.(*array.Int64).Int64Values()
-		vv := ss.Interface.(*array.Int64).Int64Values()
-		return FromInt64(s.pool, s.field, append(v, vv...), nil)
+		vals := make([]int64, 0, s.Len()+ss.Len())
+		vals = append(vals, s.Interface.(*array.Int64).Int64Values()...)
+		vals = append(vals, ss.Interface.(*array.Int64).Int64Values()...)
+		return FromInt64(s.pool, s.field, vals, valid)
 	case arrow.PrimitiveTypes.Float32:
-		v := s.Interface.(*array.Float32).Float32Values()
-		vv := ss.Interface.(*array.Float32).Float32Values()
-		return FromFloat32(s.pool, s.field, append(v, vv...), nil)
+		vals := make([]float32, 0, s.Len()+ss.Len())
+		vals = append(vals, s.Interface.(*array.Float32).Float32Values()...)
+		vals = append(vals, ss.Interface.(*array.Float32).Float32Values()...)
+		return FromFloat32(s.pool, s.field, vals, valid)
 	case arrow.PrimitiveTypes.Float64:
-		v := s.Interface.(*array.Float64).Float64Values()
-		vv := ss.Interface.(*array.Float64).Float64Values()
-		return FromFloat64(s.pool, s.field, append(v, vv...), nil)
+		vals := make([]float64, 0, s.Len()+ss.Len())
+		vals = append(vals, s.Interface.(*array.Float64).Float64Values()...)
+		vals = append(vals, ss.Interface.(*array.Float64).Float64Values()...)
+		return FromFloat64(s.pool, s.field, vals, valid)
+	case arrow.PrimitiveTypes.Int8:
+		vals := make([]int8, 0, s.Len()+ss.Len())
+		vals = append(vals, s.Interface.(*array.Int8).Int8Values()...)
+		vals = append(vals, ss.Interface.(*array.Int8).Int8Values()...)
+		return FromInt8(s.pool, s.field, vals, valid)
+	case arrow.PrimitiveTypes.Int16:
+		vals := make([]int16, 0, s.Len()+ss.Len())
+		vals = append(vals, s.Interface.(*array.Int16).Int16Values()...)
+		vals = append(vals, ss.Interface.(*array.Int16).Int16Values()...)
+		return FromInt16(s.pool, s.field, vals, valid)
+	case arrow.PrimitiveTypes.Uint8:
+		vals := make([]uint8, 0, s.Len()+ss.Len())
+		vals = append(vals, s.Interface.(*array.Uint8).Uint8Values()...)
+		vals = append(vals, ss.Interface.(*array.Uint8).Uint8Values()...)
+		return FromUint8(s.pool, s.field, vals, valid)
+	case arrow.PrimitiveTypes.Uint16:
+		vals := make([]uint16, 0, s.Len()+ss.Len())
+		vals = append(vals, s.Interface.(*array.Uint16).Uint16Values()...)
+		vals = append(vals, ss.Interface.(*array.Uint16).Uint16Values()...)
+		return FromUint16(s.pool, s.field, vals, valid)
+	case arrow.PrimitiveTypes.Uint32:
+		vals := make([]uint32, 0, s.Len()+ss.Len())
+		vals = append(vals, s.Interface.(*array.Uint32).Uint32Values()...)
+		vals = append(vals, ss.Interface.(*array.Uint32).Uint32Values()...)
+		return FromUint32(s.pool, s.field, vals, valid)
+	case arrow.PrimitiveTypes.Uint64:
+		vals := make([]uint64, 0, s.Len()+ss.Len())
+		vals = append(vals, s.Interface.(*array.Uint64).Uint64Values()...)
+		vals = append(vals, ss.Interface.(*array.Uint64).Uint64Values()...)
+		return FromUint64(s.pool, s.field, vals, valid)
 	default:
 		panic("series: append: unsupported type")
 	}
 }
+
+// appendValidity returns the validity of the values of s followed by the
+// values of ss, or nil if neither has nulls.
+func appendValidity(s, ss Series) []bool {
+	if s.NullN() == 0 && ss.NullN() == 0 {
+		return nil
+	}
+
+	valid := make([]bool, 0, s.Len()+ss.Len())
+	for i := 0; i < s.Len(); i++ {
+		valid = append(valid, s.IsValid(i))
+	}
+	for i := 0; i < ss.Len(); i++ {
+		valid = append(valid, ss.IsValid(i))
+	}
+	return valid
+}
@@ -1,10 +1,15 @@
 package series_test
 
 import (
+	"math"
+	"reflect"
+	"sort"
 	"testing"
+	"time"
 
 	"github.com/apache/arrow/go/arrow"
 	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/decimal128"
 	"github.com/apache/arrow/go/arrow/memory"
 	"github.com/poopoothegorilla/fastframe/series"
 	"github.com/stretchr/testify/assert"
@@ -79,6 +84,58 @@ func TestUnique(t *testing.T) {
 			},
 			expUnique: []float64{1, 2, 3, 4, 5, 6},
 		},
+		{
+			scenario: "int8 column",
+			inField:  arrow.Field{Name: "f1-i8", Type: arrow.PrimitiveTypes.Int8},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewInt8Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]int8{-1, -1, 2, 2, 3}, nil)
+
+				return b.NewArray()
+			},
+			expUnique: []int8{-1, 2, 3},
+		},
+		{
+			scenario: "uint64 column",
+			inField:  arrow.Field{Name: "f1-u64", Type: arrow.PrimitiveTypes.Uint64},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewUint64Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]uint64{1 << 63, 1 << 63, 1}, nil)
+
+				return b.NewArray()
+			},
+			expUnique: []uint64{1 << 63, 1},
+		},
+		{
+			scenario: "uint16 column with nulls",
+			inField:  arrow.Field{Name: "f1-u16", Type: arrow.PrimitiveTypes.Uint16},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewUint16Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]uint16{1, 2, 1}, []bool{true, false, true})
+
+				return b.NewArray()
+			},
+			expUnique: []uint16{1},
+		},
+		{
+			scenario: "string column with nulls",
+			inField:  arrow.Field{Name: "f1-str", Type: arrow.BinaryTypes.String},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewStringBuilder(pool)
+				defer b.Release()
+
+				b.AppendValues([]string{"a", "", "b"}, []bool{true, false, true})
+
+				return b.NewArray()
+			},
+			expUnique: []string{"a", "b"},
+		},
 	}
 
 	for _, tt := range tests {
@@ -396,6 +453,62 @@ func TestDropIndices(t *testing.T) {
 			inIndices:      []int{1, 2, 3, 5, 7, 8},
 			expDropIndices: []float64{1, 5, 7, 10},
 		},
+		{
+			scenario: "boolean column",
+			inField:  arrow.Field{Name: "f1-bool", Type: arrow.FixedWidthTypes.Boolean},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewBooleanBuilder(pool)
+				defer b.Release()
+
+				b.AppendValues([]bool{true, false, false, true}, nil)
+
+				return b.NewArray()
+			},
+			inIndices:      []int{1, 3},
+			expDropIndices: []bool{true, false},
+		},
+		{
+			scenario: "timestamp column",
+			inField:  arrow.Field{Name: "f1-ts", Type: arrow.FixedWidthTypes.Timestamp_s},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewTimestampBuilder(pool, arrow.FixedWidthTypes.Timestamp_s.(*arrow.TimestampType))
+				defer b.Release()
+
+				b.AppendValues([]arrow.Timestamp{10, 20, 30}, nil)
+
+				return b.NewArray()
+			},
+			inIndices:      []int{0},
+			expDropIndices: []arrow.Timestamp{20, 30},
+		},
+		{
+			scenario: "decimal column",
+			inField:  arrow.Field{Name: "f1-dec", Type: &arrow.Decimal128Type{Precision: 10, Scale: 2}},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewDecimal128Builder(pool, &arrow.Decimal128Type{Precision: 10, Scale: 2})
+				defer b.Release()
+
+				b.AppendValues([]decimal128.Num{decimal128.FromI64(1), decimal128.FromI64(2), decimal128.FromI64(3)}, nil)
+
+				return b.NewArray()
+			},
+			inIndices:      []int{1},
+			expDropIndices: []decimal128.Num{decimal128.FromI64(1), decimal128.FromI64(3)},
+		},
+		{
+			scenario: "binary column",
+			inField:  arrow.Field{Name: "f1-bin", Type: arrow.BinaryTypes.Binary},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewBinaryBuilder(pool, arrow.BinaryTypes.Binary)
+				defer b.Release()
+
+				b.AppendValues([][]byte{[]byte("a"), []byte("b"), []byte("c")}, nil)
+
+				return b.NewArray()
+			},
+			inIndices:      []int{0, 2},
+			expDropIndices: [][]byte{[]byte("b")},
+		},
 	}
 
 	for _, tt := range tests {
@@ -414,6 +527,120 @@ func TestDropIndices(t *testing.T) {
 	}
 }
 
+func TestDropAndSelectIndicesWithNulls(t *testing.T) {
+	tests := []struct {
+		scenario string
+
+		inSeries func(memory.Allocator) series.Series
+
+		expDrop          interface{}
+		expDropNAIndices []int
+		expSelect        interface{}
+		expSelNAIndices  []int
+	}{
+		{
+			scenario: "int64 column",
+			inSeries: func(pool memory.Allocator) series.Series {
+				return series.FromInt64(pool, arrow.Field{Name: "f1-i64", Type: arrow.PrimitiveTypes.Int64}, []int64{1, 2, 3, 4}, []bool{true, false, true, false})
+			},
+			expDrop:          []int64{0, 3, 0},
+			expDropNAIndices: []int{0, 2},
+			expSelect:        []int64{1, 0},
+			expSelNAIndices:  []int{1},
+		},
+		{
+			scenario: "float64 column",
+			inSeries: func(pool memory.Allocator) series.Series {
+				return series.FromFloat64(pool, arrow.Field{Name: "f1-f64", Type: arrow.PrimitiveTypes.Float64}, []float64{1, 2, 3, 4}, []bool{true, false, true, false})
+			},
+			expDrop:          []float64{0, 3, 0},
+			expDropNAIndices: []int{0, 2},
+			expSelect:        []float64{1, 0},
+			expSelNAIndices:  []int{1},
+		},
+		{
+			scenario: "int8 column",
+			inSeries: func(pool memory.Allocator) series.Series {
+				return series.FromInt8(pool, arrow.Field{Name: "f1-int8", Type: arrow.PrimitiveTypes.Int8}, []int8{1, 2, 3, 4}, []bool{true, false, true, false})
+			},
+			expDrop:          []int8{0, 3, 0},
+			expDropNAIndices: []int{0, 2},
+			expSelect:        []int8{1, 0},
+			expSelNAIndices:  []int{1},
+		},
+		{
+			scenario: "int16 column",
+			inSeries: func(pool memory.Allocator) series.Series {
+				return series.FromInt16(pool, arrow.Field{Name: "f1-int16", Type: arrow.PrimitiveTypes.Int16}, []int16{1, 2, 3, 4}, []bool{true, false, true, false})
+			},
+			expDrop:          []int16{0, 3, 0},
+			expDropNAIndices: []int{0, 2},
+			expSelect:        []int16{1, 0},
+			expSelNAIndices:  []int{1},
+		},
+		{
+			scenario: "uint8 column",
+			inSeries: func(pool memory.Allocator) series.Series {
+				return series.FromUint8(pool, arrow.Field{Name: "f1-uint8", Type: arrow.PrimitiveTypes.Uint8}, []uint8{1, 2, 3, 4}, []bool{true, false, true, false})
+			},
+			expDrop:          []uint8{0, 3, 0},
+			expDropNAIndices: []int{0, 2},
+			expSelect:        []uint8{1, 0},
+			expSelNAIndices:  []int{1},
+		},
+		{
+			scenario: "uint16 column",
+			inSeries: func(pool memory.Allocator) series.Series {
+				return series.FromUint16(pool, arrow.Field{Name: "f1-uint16", Type: arrow.PrimitiveTypes.Uint16}, []uint16{1, 2, 3, 4}, []bool{true, false, true, false})
+			},
+			expDrop:          []uint16{0, 3, 0},
+			expDropNAIndices: []int{0, 2},
+			expSelect:        []uint16{1, 0},
+			expSelNAIndices:  []int{1},
+		},
+		{
+			scenario: "uint32 column",
+			inSeries: func(pool memory.Allocator) series.Series {
+				return series.FromUint32(pool, arrow.Field{Name: "f1-uint32", Type: arrow.PrimitiveTypes.Uint32}, []uint32{1, 2, 3, 4}, []bool{true, false, true, false})
+			},
+			expDrop:          []uint32{0, 3, 0},
+			expDropNAIndices: []int{0, 2},
+			expSelect:        []uint32{1, 0},
+			expSelNAIndices:  []int{1},
+		},
+		{
+			scenario: "uint64 column",
+			inSeries: func(pool memory.Allocator) series.Series {
+				return series.FromUint64(pool, arrow.Field{Name: "f1-uint64", Type: arrow.PrimitiveTypes.Uint64}, []uint64{1, 2, 3, 4}, []bool{true, false, true, false})
+			},
+			expDrop:          []uint64{0, 3, 0},
+			expDropNAIndices: []int{0, 2},
+			expSelect:        []uint64{1, 0},
+			expSelNAIndices:  []int{1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.scenario, func(t *testing.T) {
+			pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer pool.AssertSize(t, 0)
+
+			s := tt.inSeries(pool)
+			defer s.Release()
+
+			drop := s.DropIndices([]int{0})
+			defer drop.Release()
+			assert.Equal(t, tt.expDrop, drop.Values())
+			assert.Equal(t, tt.expDropNAIndices, drop.NAIndices())
+
+			sel := s.SelectIndices([]int{0, 1})
+			defer sel.Release()
+			assert.Equal(t, tt.expSelect, sel.Values())
+			assert.Equal(t, tt.expSelNAIndices, sel.NAIndices())
+		})
+	}
+}
+
 func TestSelectIndices(t *testing.T) {
 	tests := []struct {
 		scenario string
@@ -480,6 +707,62 @@ func TestSelectIndices(t *testing.T) {
 			inIndices: []int{1, 2, 3, 5, 7, 8},
 			exp:       []float64{2, 3, 4, 6, 8, 9},
 		},
+		{
+			scenario: "boolean column",
+			inField:  arrow.Field{Name: "f1-bool", Type: arrow.FixedWidthTypes.Boolean},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewBooleanBuilder(pool)
+				defer b.Release()
+
+				b.AppendValues([]bool{true, false, false, true}, nil)
+
+				return b.NewArray()
+			},
+			inIndices: []int{1, 3},
+			exp:       []bool{false, true},
+		},
+		{
+			scenario: "timestamp column",
+			inField:  arrow.Field{Name: "f1-ts", Type: arrow.FixedWidthTypes.Timestamp_s},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewTimestampBuilder(pool, arrow.FixedWidthTypes.Timestamp_s.(*arrow.TimestampType))
+				defer b.Release()
+
+				b.AppendValues([]arrow.Timestamp{10, 20, 30}, nil)
+
+				return b.NewArray()
+			},
+			inIndices: []int{0},
+			exp:       []arrow.Timestamp{10},
+		},
+		{
+			scenario: "decimal column",
+			inField:  arrow.Field{Name: "f1-dec", Type: &arrow.Decimal128Type{Precision: 10, Scale: 2}},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewDecimal128Builder(pool, &arrow.Decimal128Type{Precision: 10, Scale: 2})
+				defer b.Release()
+
+				b.AppendValues([]decimal128.Num{decimal128.FromI64(1), decimal128.FromI64(2), decimal128.FromI64(3)}, nil)
+
+				return b.NewArray()
+			},
+			inIndices: []int{1},
+			exp:       []decimal128.Num{decimal128.FromI64(2)},
+		},
+		{
+			scenario: "binary column",
+			inField:  arrow.Field{Name: "f1-bin", Type: arrow.BinaryTypes.Binary},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewBinaryBuilder(pool, arrow.BinaryTypes.Binary)
+				defer b.Release()
+
+				b.AppendValues([][]byte{[]byte("a"), []byte("b"), []byte("c")}, nil)
+
+				return b.NewArray()
+			},
+			inIndices: []int{0, 2},
+			exp:       [][]byte{[]byte("a"), []byte("c")},
+		},
 	}
 
 	for _, tt := range tests {
@@ -498,6 +781,21 @@ func TestSelectIndices(t *testing.T) {
 	}
 }
 
+func TestTake(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	f := arrow.Field{Name: "str", Type: arrow.BinaryTypes.String}
+	s := series.FromString(pool, f, []string{"a", "b", "c"}, []bool{true, false, true})
+	defer s.Release()
+
+	act := s.Take([]int{2, -1, 1, 0, 2})
+	defer act.Release()
+
+	assert.Equal(t, []string{"c", "", "", "a", "c"}, act.Values())
+	assert.Equal(t, []int{1, 2}, act.NAIndices())
+}
+
 func TestHead(t *testing.T) {
 	tests := []struct {
 		scenario string
@@ -737,6 +1035,34 @@ func TestSum(t *testing.T) {
 			expSum:       28,
 			expMagnitude: 10.198039027185569,
 		},
+		{
+			scenario: "int8 column",
+			inField:  arrow.Field{Name: "f1-i8", Type: arrow.PrimitiveTypes.Int8},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewInt8Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]int8{100, 100, 100}, nil)
+
+				return b.NewArray()
+			},
+			expSum:       300,
+			expMagnitude: 173.20508075688772,
+		},
+		{
+			scenario: "uint8 column",
+			inField:  arrow.Field{Name: "f1-u8", Type: arrow.PrimitiveTypes.Uint8},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewUint8Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]uint8{200, 200}, nil)
+
+				return b.NewArray()
+			},
+			expSum:       400,
+			expMagnitude: 282.842712474619,
+		},
 	}
 
 	for _, tt := range tests {
@@ -1039,66 +1365,117 @@ func TestWhere(t *testing.T) {
 	}
 }
 
-func TestSortValues(t *testing.T) {
+func TestCompare(t *testing.T) {
 	tests := []struct {
 		scenario string
 
-		inField  arrow.Field
-		inColumn func(memory.Allocator) array.Interface
+		inSeries  func(memory.Allocator) series.Series
+		inCompare func(series.Series) series.Series
 
-		expSortValues interface{}
+		expVals      []bool
+		expNAIndices []int
 	}{
 		{
-			scenario: "int32 column",
-			inField:  arrow.Field{Name: "f1-i32", Type: arrow.PrimitiveTypes.Int32},
-			inColumn: func(pool memory.Allocator) array.Interface {
-				b := array.NewInt32Builder(pool)
-				defer b.Release()
-
-				b.AppendValues([]int32{4, 2, 7, 1, 3, 5, 6}, nil)
-
-				return b.NewArray()
+			scenario: "int32 gt",
+			inSeries: func(pool memory.Allocator) series.Series {
+				f := arrow.Field{Name: "i32", Type: arrow.PrimitiveTypes.Int32}
+				return series.FromInt32(pool, f, []int32{1, 2, 3, 4}, []bool{true, true, false, true})
 			},
-			expSortValues: []int32{1, 2, 3, 4, 5, 6, 7},
+			inCompare:    func(s series.Series) series.Series { return s.Gt(2) },
+			expVals:      []bool{false, false, false, true},
+			expNAIndices: []int{2},
 		},
 		{
-			scenario: "int64 column",
-			inField:  arrow.Field{Name: "f1-i64", Type: arrow.PrimitiveTypes.Int64},
-			inColumn: func(pool memory.Allocator) array.Interface {
-				b := array.NewInt64Builder(pool)
-				defer b.Release()
-
-				b.AppendValues([]int64{40, 20, 70, 10, 30, 50, 60}, nil)
-
-				return b.NewArray()
+			scenario: "int64 lt float",
+			inSeries: func(pool memory.Allocator) series.Series {
+				f := arrow.Field{Name: "i64", Type: arrow.PrimitiveTypes.Int64}
+				return series.FromInt64(pool, f, []int64{1, 2, 3, 4}, nil)
 			},
-			expSortValues: []int64{10, 20, 30, 40, 50, 60, 70},
+			inCompare:    func(s series.Series) series.Series { return s.Lt(2.5) },
+			expVals:      []bool{true, true, false, false},
+			expNAIndices: []int{},
 		},
 		{
-			scenario: "float32 column",
-			inField:  arrow.Field{Name: "f1-f32", Type: arrow.PrimitiveTypes.Float32},
-			inColumn: func(pool memory.Allocator) array.Interface {
-				b := array.NewFloat32Builder(pool)
-				defer b.Release()
-
-				b.AppendValues([]float32{4, 2, 7, 1, 3, 5, 6}, nil)
-
-				return b.NewArray()
+			scenario: "float32 eq",
+			inSeries: func(pool memory.Allocator) series.Series {
+				f := arrow.Field{Name: "f32", Type: arrow.PrimitiveTypes.Float32}
+				return series.FromFloat32(pool, f, []float32{1, 0.5, 0.5, 2}, nil)
 			},
-			expSortValues: []float32{1, 2, 3, 4, 5, 6, 7},
+			inCompare:    func(s series.Series) series.Series { return s.Eq(0.5) },
+			expVals:      []bool{false, true, true, false},
+			expNAIndices: []int{},
 		},
 		{
-			scenario: "float64 column",
-			inField:  arrow.Field{Name: "f1-f64", Type: arrow.PrimitiveTypes.Float64},
-			inColumn: func(pool memory.Allocator) array.Interface {
-				b := array.NewFloat64Builder(pool)
-				defer b.Release()
-
-				b.AppendValues([]float64{40, 20, 70, 10, 30, 50, 60}, nil)
-
-				return b.NewArray()
+			scenario: "float64 ne",
+			inSeries: func(pool memory.Allocator) series.Series {
+				f := arrow.Field{Name: "f64", Type: arrow.PrimitiveTypes.Float64}
+				return series.FromFloat64(pool, f, []float64{1, 0.5, 0.5, 2}, []bool{false, true, true, true})
 			},
-			expSortValues: []float64{10, 20, 30, 40, 50, 60, 70},
+			inCompare:    func(s series.Series) series.Series { return s.Ne(0.5) },
+			expVals:      []bool{false, false, false, true},
+			expNAIndices: []int{0},
+		},
+		{
+			scenario: "float64 between",
+			inSeries: func(pool memory.Allocator) series.Series {
+				f := arrow.Field{Name: "f64", Type: arrow.PrimitiveTypes.Float64}
+				return series.FromFloat64(pool, f, []float64{0, 1, 1.5, 2, 3}, nil)
+			},
+			inCompare:    func(s series.Series) series.Series { return s.Between(1, 2) },
+			expVals:      []bool{false, true, true, true, false},
+			expNAIndices: []int{},
+		},
+		{
+			scenario: "string is in",
+			inSeries: func(pool memory.Allocator) series.Series {
+				f := arrow.Field{Name: "str", Type: arrow.BinaryTypes.String}
+				return series.FromString(pool, f, []string{"a", "b", "c", "d"}, []bool{true, true, true, false})
+			},
+			inCompare:    func(s series.Series) series.Series { return s.IsIn("a", "c", "d") },
+			expVals:      []bool{true, false, true, false},
+			expNAIndices: []int{3},
+		},
+		{
+			scenario: "timestamp gt time",
+			inSeries: func(pool memory.Allocator) series.Series {
+				f := arrow.Field{Name: "ts", Type: arrow.FixedWidthTypes.Timestamp_s}
+				return series.FromTimestamp(pool, f, []arrow.Timestamp{1577923199, 1577923200, 1577923201}, nil)
+			},
+			inCompare: func(s series.Series) series.Series {
+				return s.Gt(time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC))
+			},
+			expVals:      []bool{false, false, true},
+			expNAIndices: []int{},
+		},
+		{
+			scenario: "bool eq",
+			inSeries: func(pool memory.Allocator) series.Series {
+				f := arrow.Field{Name: "bool", Type: arrow.FixedWidthTypes.Boolean}
+				return series.FromBool(pool, f, []bool{true, false, true}, nil)
+			},
+			inCompare:    func(s series.Series) series.Series { return s.Eq(true) },
+			expVals:      []bool{true, false, true},
+			expNAIndices: []int{},
+		},
+		{
+			scenario: "uint64 ge",
+			inSeries: func(pool memory.Allocator) series.Series {
+				f := arrow.Field{Name: "u64", Type: arrow.PrimitiveTypes.Uint64}
+				return series.FromUint64(pool, f, []uint64{1, 1 << 63, 1<<64 - 1}, nil)
+			},
+			inCompare:    func(s series.Series) series.Series { return s.Ge(uint64(1 << 63)) },
+			expVals:      []bool{false, true, true},
+			expNAIndices: []int{},
+		},
+		{
+			scenario: "int8 lt negative",
+			inSeries: func(pool memory.Allocator) series.Series {
+				f := arrow.Field{Name: "i8", Type: arrow.PrimitiveTypes.Int8}
+				return series.FromInt8(pool, f, []int8{-3, 0, 3}, nil)
+			},
+			inCompare:    func(s series.Series) series.Series { return s.Lt(-1) },
+			expVals:      []bool{true, false, false},
+			expNAIndices: []int{},
 		},
 	}
 
@@ -1107,81 +1484,63 @@ func TestSortValues(t *testing.T) {
 			pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
 			defer pool.AssertSize(t, 0)
 
-			actSeries := series.FromArrow(pool, tt.inField, tt.inColumn(pool))
-			require.NotNil(t, actSeries)
-			defer actSeries.Release()
+			s := tt.inSeries(pool)
+			defer s.Release()
 
-			actSortValues := actSeries.SortValues()
-			assert.Equal(t, tt.expSortValues, actSortValues.Values())
-			defer actSortValues.Release()
+			act := tt.inCompare(s)
+			defer act.Release()
+
+			assert.Equal(t, arrow.FixedWidthTypes.Boolean, act.DataType())
+			assert.Equal(t, s.Name(), act.Name())
+			assert.Equal(t, tt.expVals, act.Values())
+			assert.Equal(t, tt.expNAIndices, act.NAIndices())
 		})
 	}
 }
 
-func TestDropNA(t *testing.T) {
-	tests := []struct {
-		scenario string
+func TestCompareUnsupportedValue(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
 
-		inField  arrow.Field
-		inColumn func(memory.Allocator) array.Interface
+	f := arrow.Field{Name: "i32", Type: arrow.PrimitiveTypes.Int32}
+	s := series.FromInt32(pool, f, []int32{1, 2}, nil)
+	defer s.Release()
 
-		expDropNA interface{}
-	}{
-		{
-			scenario: "int32 column",
-			inField:  arrow.Field{Name: "f1-i32", Type: arrow.PrimitiveTypes.Int32},
-			inColumn: func(pool memory.Allocator) array.Interface {
-				b := array.NewInt32Builder(pool)
-				defer b.Release()
+	assert.Panics(t, func() { s.Gt("1") })
+}
 
-				b.AppendValues([]int32{1, 2, 3, 4, 5, 6, 7},
-					[]bool{true, false, true, false, true, false, true})
+func TestLogical(t *testing.T) {
+	// Every combination of true, false and null.
+	inA := []bool{true, true, true, false, false, false, false, false, false}
+	inAValid := []bool{true, true, true, true, true, true, false, false, false}
+	inB := []bool{true, false, false, true, false, false, true, false, false}
+	inBValid := []bool{true, true, false, true, true, false, true, true, false}
 
-				return b.NewArray()
-			},
-			expDropNA: []int32{1, 3, 5, 7},
-		},
-		{
-			scenario: "int64 column",
-			inField:  arrow.Field{Name: "f1-i64", Type: arrow.PrimitiveTypes.Int64},
-			inColumn: func(pool memory.Allocator) array.Interface {
-				b := array.NewInt64Builder(pool)
-				defer b.Release()
+	tests := []struct {
+		scenario string
 
-				b.AppendValues([]int64{1, 2, 3, 4, 5, 6, 7},
-					[]bool{true, false, true, false, true, false, true})
+		inLogical func(a, b series.Series) series.Series
 
-				return b.NewArray()
-			},
-			expDropNA: []int64{1, 3, 5, 7},
+		expVals      []bool
+		expNAIndices []int
+	}{
+		{
+			scenario:     "and",
+			inLogical:    func(a, b series.Series) series.Series { return a.And(b) },
+			expVals:      []bool{true, false, false, false, false, false, false, false, false},
+			expNAIndices: []int{2, 6, 8},
 		},
 		{
-			scenario: "float32 column",
-			inField:  arrow.Field{Name: "f1-f32", Type: arrow.PrimitiveTypes.Float32},
-			inColumn: func(pool memory.Allocator) array.Interface {
-				b := array.NewFloat32Builder(pool)
-				defer b.Release()
-
-				b.AppendValues([]float32{1, 2, 3, 4, 5, 6, 7},
-					[]bool{true, false, true, false, true, false, true})
-
-				return b.NewArray()
-			},
-			expDropNA: []float32{1, 3, 5, 7},
+			scenario:     "or",
+			inLogical:    func(a, b series.Series) series.Series { return a.Or(b) },
+			expVals:      []bool{true, true, true, true, false, false, true, false, false},
+			expNAIndices: []int{5, 7, 8},
 		},
 		{
-			scenario: "float64 column",
-			inField:  arrow.Field{Name: "f1-f64", Type: arrow.PrimitiveTypes.Float64},
-			inColumn: func(pool memory.Allocator) array.Interface {
-				b := array.NewFloat64Builder(pool)
-				defer b.Release()
-
-				b.AppendValues([]float64{1, 2, 3, 4, 5, 6, 7},
-					[]bool{true, false, true, false, true, false, true})
-
-				return b.NewArray()
-			},
-			expDropNA: []float64{1, 3, 5, 7},
+			scenario:     "not",
+			inLogical:    func(a, _ series.Series) series.Series { return a.Not() },
+			expVals:      []bool{false, false, false, true, true, true, false, false, false},
+			expNAIndices: []int{6, 7, 8},
 		},
 	}
 
@@ -1190,115 +1549,83 @@ func TestDropNA(t *testing.T) {
 			pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
 			defer pool.AssertSize(t, 0)
 
-			actSeries := series.FromArrow(pool, tt.inField, tt.inColumn(pool))
-			require.NotNil(t, actSeries)
-			defer actSeries.Release()
+			f := arrow.Field{Name: "bool", Type: arrow.FixedWidthTypes.Boolean}
+			a := series.FromBool(pool, f, inA, inAValid)
+			defer a.Release()
+			b := series.FromBool(pool, f, inB, inBValid)
+			defer b.Release()
 
-			actDropNA := actSeries.DropNA()
-			assert.Equal(t, tt.expDropNA, actDropNA.Values())
-			defer actDropNA.Release()
+			act := tt.inLogical(a, b)
+			defer act.Release()
+
+			assert.Equal(t, tt.expVals, act.Values())
+			assert.Equal(t, tt.expNAIndices, act.NAIndices())
 		})
 	}
 }
 
-func TestDot(t *testing.T) {
+func TestFilter(t *testing.T) {
 	tests := []struct {
 		scenario string
 
-		inField   arrow.Field
-		inColumn  func(memory.Allocator) array.Interface
-		inField2  arrow.Field
-		inColumn2 func(memory.Allocator) array.Interface
+		inSeries func(memory.Allocator) series.Series
 
-		expDot float64
+		expFilter    interface{}
+		expNAIndices []int
 	}{
 		{
 			scenario: "int32 column",
-			inField:  arrow.Field{Name: "f1-i32", Type: arrow.PrimitiveTypes.Int32},
-			inColumn: func(pool memory.Allocator) array.Interface {
-				b := array.NewInt32Builder(pool)
-				defer b.Release()
-
-				b.AppendValues([]int32{1, 2, 3, 4, 5, 6, 7}, nil)
-
-				return b.NewArray()
-			},
-			inField2: arrow.Field{Name: "f1-i32", Type: arrow.PrimitiveTypes.Int32},
-			inColumn2: func(pool memory.Allocator) array.Interface {
-				b := array.NewInt32Builder(pool)
-				defer b.Release()
-
-				b.AppendValues([]int32{1, 2, 3, 4, 5, 6, 7}, nil)
-
-				return b.NewArray()
+			inSeries: func(pool memory.Allocator) series.Series {
+				f := arrow.Field{Name: "i32", Type: arrow.PrimitiveTypes.Int32}
+				return series.FromInt32(pool, f, []int32{1, 2, 3, 4, 5}, []bool{true, true, true, false, true})
 			},
-			expDot: 140,
+			expFilter:    []int32{1, 0, 5},
+			expNAIndices: []int{1},
 		},
 		{
 			scenario: "int64 column",
-			inField:  arrow.Field{Name: "f1-i64", Type: arrow.PrimitiveTypes.Int64},
-			inColumn: func(pool memory.Allocator) array.Interface {
-				b := array.NewInt64Builder(pool)
-				defer b.Release()
-
-				b.AppendValues([]int64{1, 2, 3, 4, 5, 6, 7}, nil)
-
-				return b.NewArray()
-			},
-			inField2: arrow.Field{Name: "f1-i64", Type: arrow.PrimitiveTypes.Int64},
-			inColumn2: func(pool memory.Allocator) array.Interface {
-				b := array.NewInt64Builder(pool)
-				defer b.Release()
-
-				b.AppendValues([]int64{1, 2, 3, 4, 5, 6, 7}, nil)
-
-				return b.NewArray()
+			inSeries: func(pool memory.Allocator) series.Series {
+				f := arrow.Field{Name: "i64", Type: arrow.PrimitiveTypes.Int64}
+				return series.FromInt64(pool, f, []int64{1, 2, 3, 4, 5}, nil)
 			},
-			expDot: 140,
+			expFilter:    []int64{1, 4, 5},
+			expNAIndices: []int{},
 		},
 		{
 			scenario: "float32 column",
-			inField:  arrow.Field{Name: "f1-f32", Type: arrow.PrimitiveTypes.Float32},
-			inColumn: func(pool memory.Allocator) array.Interface {
-				b := array.NewFloat32Builder(pool)
-				defer b.Release()
-
-				b.AppendValues([]float32{1, 2, 3, 4, 5, 6, 7}, nil)
-
-				return b.NewArray()
-			},
-			inField2: arrow.Field{Name: "f1-f32", Type: arrow.PrimitiveTypes.Float32},
-			inColumn2: func(pool memory.Allocator) array.Interface {
-				b := array.NewFloat32Builder(pool)
-				defer b.Release()
-
-				b.AppendValues([]float32{1, 2, 3, 4, 5, 6, 7}, nil)
-
-				return b.NewArray()
+			inSeries: func(pool memory.Allocator) series.Series {
+				f := arrow.Field{Name: "f32", Type: arrow.PrimitiveTypes.Float32}
+				return series.FromFloat32(pool, f, []float32{1, 2, 3, 4, 5}, nil)
 			},
-			expDot: 140,
+			expFilter:    []float32{1, 4, 5},
+			expNAIndices: []int{},
 		},
 		{
 			scenario: "float64 column",
-			inField:  arrow.Field{Name: "f1-f64", Type: arrow.PrimitiveTypes.Float64},
-			inColumn: func(pool memory.Allocator) array.Interface {
-				b := array.NewFloat64Builder(pool)
-				defer b.Release()
-
-				b.AppendValues([]float64{1, 2, 3, 4, 5, 6, 7}, nil)
-
-				return b.NewArray()
+			inSeries: func(pool memory.Allocator) series.Series {
+				f := arrow.Field{Name: "f64", Type: arrow.PrimitiveTypes.Float64}
+				return series.FromFloat64(pool, f, []float64{1, 2, 3, 4, 5}, []bool{false, true, true, true, true})
 			},
-			inField2: arrow.Field{Name: "f1-f64", Type: arrow.PrimitiveTypes.Float64},
-			inColumn2: func(pool memory.Allocator) array.Interface {
-				b := array.NewFloat64Builder(pool)
-				defer b.Release()
-
-				b.AppendValues([]float64{1, 2, 3, 4, 5, 6, 7}, nil)
-
-				return b.NewArray()
+			expFilter:    []float64{0, 4, 5},
+			expNAIndices: []int{0},
+		},
+		{
+			scenario: "string column",
+			inSeries: func(pool memory.Allocator) series.Series {
+				f := arrow.Field{Name: "str", Type: arrow.BinaryTypes.String}
+				return series.FromString(pool, f, []string{"a", "b", "c", "d", "e"}, nil)
 			},
-			expDot: 140,
+			expFilter:    []string{"a", "d", "e"},
+			expNAIndices: []int{},
+		},
+		{
+			scenario: "bool column",
+			inSeries: func(pool memory.Allocator) series.Series {
+				f := arrow.Field{Name: "bool", Type: arrow.FixedWidthTypes.Boolean}
+				return series.FromBool(pool, f, []bool{true, true, false, false, true}, nil)
+			},
+			expFilter:    []bool{true, false, true},
+			expNAIndices: []int{},
 		},
 	}
 
@@ -1307,158 +1634,194 @@ func TestDot(t *testing.T) {
 			pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
 			defer pool.AssertSize(t, 0)
 
-			actSeries := series.FromArrow(pool, tt.inField, tt.inColumn(pool))
-			require.NotNil(t, actSeries)
-			defer actSeries.Release()
+			s := tt.inSeries(pool)
+			defer s.Release()
 
-			actSeries2 := series.FromArrow(pool, tt.inField2, tt.inColumn2(pool))
-			require.NotNil(t, actSeries2)
-			defer actSeries2.Release()
+			// The null at position 2 drops the row like false.
+			f := arrow.Field{Name: "mask", Type: arrow.FixedWidthTypes.Boolean}
+			mask := series.FromBool(pool, f,
+				[]bool{true, false, true, true, true},
+				[]bool{true, true, false, true, true})
+			defer mask.Release()
 
-			actDot := actSeries.Dot(actSeries2)
-			assert.Equal(t, tt.expDot, actDot)
+			act := s.Filter(mask)
+			defer act.Release()
+
+			assert.Equal(t, s.Field(), act.Field())
+			assert.Equal(t, tt.expFilter, act.Values())
+			assert.Equal(t, tt.expNAIndices, act.NAIndices())
 		})
 	}
 }
 
-func TestMin(t *testing.T) {
+func TestTemporal(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	ny, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	inTimes := []time.Time{
+		time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+		time.Time{},
+		time.Date(2020, 1, 2, 23, 0, 0, 0, ny),
+	}
+	inValid := []bool{true, false, true}
+
 	tests := []struct {
 		scenario string
 
-		inField  arrow.Field
-		inColumn func(memory.Allocator) array.Interface
+		inType arrow.DataType
 
-		expMin float64
+		expVals    interface{}
+		expStrings []string
+		expTime    time.Time
 	}{
 		{
-			scenario: "int32 column",
-			inField:  arrow.Field{Name: "f1-i32", Type: arrow.PrimitiveTypes.Int32},
-			inColumn: func(pool memory.Allocator) array.Interface {
-				b := array.NewInt32Builder(pool)
-				defer b.Release()
-
-				b.AppendValues([]int32{1, 2, 3, -4, 5, 6, 7}, nil)
-
-				return b.NewArray()
-			},
-			expMin: -4,
-		},
-		{
-			scenario: "int64 column",
-			inField:  arrow.Field{Name: "f1-i64", Type: arrow.PrimitiveTypes.Int64},
-			inColumn: func(pool memory.Allocator) array.Interface {
-				b := array.NewInt64Builder(pool)
-				defer b.Release()
-
-				b.AppendValues([]int64{1, 2, 3, -4, 5, 6, 7}, nil)
-
-				return b.NewArray()
-			},
-			expMin: -4,
+			scenario:   "date32",
+			inType:     arrow.FixedWidthTypes.Date32,
+			expVals:    []arrow.Date32{18263, 0, 18263},
+			expStrings: []string{"2020-01-02", "1970-01-01", "2020-01-02"},
+			expTime:    time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
 		},
 		{
-			scenario: "float32 column",
-			inField:  arrow.Field{Name: "f1-f32", Type: arrow.PrimitiveTypes.Float32},
-			inColumn: func(pool memory.Allocator) array.Interface {
-				b := array.NewFloat32Builder(pool)
-				defer b.Release()
-
-				b.AppendValues([]float32{1, 1, 2, 3, 4, -4, 6, 7}, nil)
-
-				return b.NewArray()
-			},
-			expMin: -4,
+			scenario:   "date64",
+			inType:     arrow.FixedWidthTypes.Date64,
+			expVals:    []arrow.Date64{1577923200000, 0, 1577923200000},
+			expStrings: []string{"2020-01-02", "1970-01-01", "2020-01-02"},
+			expTime:    time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
 		},
 		{
-			scenario: "float64 column",
-			inField:  arrow.Field{Name: "f1-f64", Type: arrow.PrimitiveTypes.Float64},
-			inColumn: func(pool memory.Allocator) array.Interface {
-				b := array.NewFloat64Builder(pool)
-				defer b.Release()
-
-				b.AppendValues([]float64{1, 1, 2, 3, 4, 0, 6, 7}, nil)
-
-				return b.NewArray()
-			},
-			expMin: 0,
+			scenario:   "timestamp with time zone",
+			inType:     &arrow.TimestampType{Unit: arrow.Second, TimeZone: "America/New_York"},
+			expVals:    []arrow.Timestamp{1577934245, 0, 1578024000},
+			expStrings: []string{"2020-01-01T22:04:05-05:00", "1969-12-31T19:00:00-05:00", "2020-01-02T23:00:00-05:00"},
+			expTime:    time.Date(2020, 1, 1, 22, 4, 5, 0, ny),
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.scenario, func(t *testing.T) {
-			pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
-			defer pool.AssertSize(t, 0)
-
-			actSeries := series.FromArrow(pool, tt.inField, tt.inColumn(pool))
-			require.NotNil(t, actSeries)
-			defer actSeries.Release()
-
-			actMin := actSeries.Min()
-			assert.Equal(t, tt.expMin, actMin)
+			f := arrow.Field{Name: "t", Type: tt.inType}
+			s := series.FromTime(pool, f, inTimes, inValid)
+			defer s.Release()
+
+			assert.Equal(t, tt.inType, s.DataType())
+			assert.Equal(t, tt.expVals, s.Values())
+			assert.Equal(t, []int{1}, s.NAIndices())
+			assert.Equal(t, tt.expStrings, s.StringValues())
+			assert.True(t, tt.expTime.Equal(s.Time(0)))
+			assert.Equal(t, tt.expTime.Location().String(), s.Time(0).Location().String())
+
+			vs := reflect.ValueOf(tt.expVals)
+			assert.Equal(t, vs.Index(0).Interface(), s.Value(0))
+
+			fromVals := series.FromInterface(pool, f, tt.expVals, inValid)
+			defer fromVals.Release()
+			assert.Equal(t, tt.expVals, fromVals.Values())
 		})
 	}
 }
 
-func TestMax(t *testing.T) {
+func TestCastTemporal(t *testing.T) {
+	tsType := &arrow.TimestampType{Unit: arrow.Millisecond, TimeZone: "America/New_York"}
+
 	tests := []struct {
 		scenario string
 
-		inField  arrow.Field
-		inColumn func(memory.Allocator) array.Interface
+		inSeries func(memory.Allocator) series.Series
+		inCast   func(series.Series) series.Series
 
-		expMax float64
+		expType      arrow.DataType
+		expVals      interface{}
+		expNAIndices []int
 	}{
 		{
-			scenario: "int32 column",
-			inField:  arrow.Field{Name: "f1-i32", Type: arrow.PrimitiveTypes.Int32},
-			inColumn: func(pool memory.Allocator) array.Interface {
-				b := array.NewInt32Builder(pool)
-				defer b.Release()
-
-				b.AppendValues([]int32{1, -10, 2, 3, -4, 8, 5, 6, 7}, nil)
-
-				return b.NewArray()
+			scenario: "string to date32",
+			inSeries: func(pool memory.Allocator) series.Series {
+				f := arrow.Field{Name: "str", Type: arrow.BinaryTypes.String}
+				return series.FromString(pool, f, []string{"2020-01-02", "", "1970-01-01T12:00:00Z"}, []bool{true, false, true})
 			},
-			expMax: 8,
+			inCast:       func(s series.Series) series.Series { return s.Cast(arrow.FixedWidthTypes.Date32) },
+			expType:      arrow.FixedWidthTypes.Date32,
+			expVals:      []arrow.Date32{18263, 0, 0},
+			expNAIndices: []int{1},
 		},
 		{
-			scenario: "int64 column",
-			inField:  arrow.Field{Name: "f1-i64", Type: arrow.PrimitiveTypes.Int64},
-			inColumn: func(pool memory.Allocator) array.Interface {
-				b := array.NewInt64Builder(pool)
-				defer b.Release()
-
-				b.AppendValues([]int64{1, -10, 3, -4, 8, 5, 6, 7}, nil)
-
-				return b.NewArray()
+			scenario: "string to timestamp with layout and time zone",
+			inSeries: func(pool memory.Allocator) series.Series {
+				f := arrow.Field{Name: "str", Type: arrow.BinaryTypes.String}
+				return series.FromString(pool, f, []string{"01/02/2020 00:00", "01/02/2020 05:30"}, nil)
 			},
-			expMax: 8,
+			inCast:       func(s series.Series) series.Series { return s.CastTime(tsType, "01/02/2006 15:04") },
+			expType:      tsType,
+			expVals:      []arrow.Timestamp{1577941200000, 1577961000000},
+			expNAIndices: []int{},
 		},
 		{
-			scenario: "float32 column",
-			inField:  arrow.Field{Name: "f1-f32", Type: arrow.PrimitiveTypes.Float32},
-			inColumn: func(pool memory.Allocator) array.Interface {
-				b := array.NewFloat32Builder(pool)
-				defer b.Release()
-
-				b.AppendValues([]float32{1.1, -10, 2, 3, 4, 8, 6, 7}, nil)
-
-				return b.NewArray()
+			scenario: "string to duration",
+			inSeries: func(pool memory.Allocator) series.Series {
+				f := arrow.Field{Name: "str", Type: arrow.BinaryTypes.String}
+				return series.FromString(pool, f, []string{"1m30s", "-2ms"}, nil)
 			},
-			expMax: 8,
+			inCast:       func(s series.Series) series.Series { return s.Cast(arrow.FixedWidthTypes.Duration_ms) },
+			expType:      arrow.FixedWidthTypes.Duration_ms,
+			expVals:      []arrow.Duration{90000, -2},
+			expNAIndices: []int{},
 		},
 		{
-			scenario: "float64 column",
-			inField:  arrow.Field{Name: "f1-f64", Type: arrow.PrimitiveTypes.Float64},
-			inColumn: func(pool memory.Allocator) array.Interface {
-				b := array.NewFloat64Builder(pool)
-				defer b.Release()
-
-				b.AppendValues([]float64{1, 1, 2, 3, 4, 8, 6, 7}, nil)
-
-				return b.NewArray()
+			scenario: "int64 to timestamp",
+			inSeries: func(pool memory.Allocator) series.Series {
+				f := arrow.Field{Name: "i64", Type: arrow.PrimitiveTypes.Int64}
+				return series.FromInt64(pool, f, []int64{1577941200000, 0}, []bool{true, false})
 			},
-			expMax: 8,
+			inCast:       func(s series.Series) series.Series { return s.Cast(tsType) },
+			expType:      tsType,
+			expVals:      []arrow.Timestamp{1577941200000, 0},
+			expNAIndices: []int{1},
+		},
+		{
+			scenario: "timestamp to date32 in time zone",
+			inSeries: func(pool memory.Allocator) series.Series {
+				f := arrow.Field{Name: "ts", Type: tsType}
+				return series.FromTimestamp(pool, f, []arrow.Timestamp{1577941200000, 1577941199999}, nil)
+			},
+			inCast:       func(s series.Series) series.Series { return s.Cast(arrow.FixedWidthTypes.Date32) },
+			expType:      arrow.FixedWidthTypes.Date32,
+			expVals:      []arrow.Date32{18263, 18262},
+			expNAIndices: []int{},
+		},
+		{
+			scenario: "duration to duration",
+			inSeries: func(pool memory.Allocator) series.Series {
+				f := arrow.Field{Name: "dur", Type: arrow.FixedWidthTypes.Duration_s}
+				return series.FromDuration(pool, f, []arrow.Duration{2, 3}, nil)
+			},
+			inCast:       func(s series.Series) series.Series { return s.Cast(arrow.FixedWidthTypes.Duration_ms) },
+			expType:      arrow.FixedWidthTypes.Duration_ms,
+			expVals:      []arrow.Duration{2000, 3000},
+			expNAIndices: []int{},
+		},
+		{
+			scenario: "date32 to string",
+			inSeries: func(pool memory.Allocator) series.Series {
+				f := arrow.Field{Name: "d32", Type: arrow.FixedWidthTypes.Date32}
+				return series.FromDate32(pool, f, []arrow.Date32{18263, -1}, nil)
+			},
+			inCast:       func(s series.Series) series.Series { return s.Cast(arrow.BinaryTypes.String) },
+			expType:      arrow.BinaryTypes.String,
+			expVals:      []string{"2020-01-02", "1969-12-31"},
+			expNAIndices: []int{},
+		},
+		{
+			scenario: "duration to int64",
+			inSeries: func(pool memory.Allocator) series.Series {
+				f := arrow.Field{Name: "dur", Type: arrow.FixedWidthTypes.Duration_s}
+				return series.FromDuration(pool, f, []arrow.Duration{2, 3}, nil)
+			},
+			inCast:       func(s series.Series) series.Series { return s.Cast(arrow.PrimitiveTypes.Int64) },
+			expType:      arrow.PrimitiveTypes.Int64,
+			expVals:      []int64{2, 3},
+			expNAIndices: []int{},
 		},
 	}
 
@@ -1467,76 +1830,135 @@ func TestMax(t *testing.T) {
 			pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
 			defer pool.AssertSize(t, 0)
 
-			actSeries := series.FromArrow(pool, tt.inField, tt.inColumn(pool))
-			require.NotNil(t, actSeries)
-			defer actSeries.Release()
+			s := tt.inSeries(pool)
+			defer s.Release()
 
-			actMax := actSeries.Max()
-			assert.Equal(t, tt.expMax, actMax)
+			act := tt.inCast(s)
+			defer act.Release()
+
+			assert.Equal(t, tt.expType, act.DataType())
+			assert.Equal(t, tt.expVals, act.Values())
+			assert.Equal(t, tt.expNAIndices, act.NAIndices())
 		})
 	}
 }
 
-func TestMean(t *testing.T) {
+func TestCastTemporalInvalidValue(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	f := arrow.Field{Name: "str", Type: arrow.BinaryTypes.String}
+	s := series.FromString(pool, f, []string{"2020-01-02", "tomorrow"}, nil)
+	defer s.Release()
+
+	assert.Panics(t, func() { s.Cast(arrow.FixedWidthTypes.Date32) })
+}
+
+func TestTemporalSortValues(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	f := arrow.Field{Name: "ts", Type: arrow.FixedWidthTypes.Timestamp_s}
+	s := series.FromTimestamp(pool, f, []arrow.Timestamp{30, 10, 99, 20}, []bool{true, true, false, true})
+	defer s.Release()
+
+	act := s.SortValues()
+	defer act.Release()
+
+	assert.Equal(t, []arrow.Timestamp{10, 20, 30, 0}, act.Values())
+	assert.Equal(t, []int{3}, act.NAIndices())
+	assert.Equal(t, float64(10), s.Min())
+	assert.Equal(t, float64(30), s.Max())
+
+	actMin, ok := s.MinTime()
+	assert.True(t, ok)
+	assert.Equal(t, time.Unix(10, 0).UTC(), actMin)
+	actMax, ok := s.MaxTime()
+	assert.True(t, ok)
+	assert.Equal(t, time.Unix(30, 0).UTC(), actMax)
+	_, ok = s.MaxTime(series.WithSkipNA(false))
+	assert.False(t, ok)
+
+	// NOTE: nanosecond timestamps are past the precision of a float64.
+	ns := series.FromTimestamp(pool, arrow.Field{Name: "ns", Type: arrow.FixedWidthTypes.Timestamp_ns}, []arrow.Timestamp{1600000000000000002, 1600000000000000001}, nil)
+	defer ns.Release()
+	actMin, ok = ns.MinTime()
+	assert.True(t, ok)
+	assert.Equal(t, time.Unix(0, 1600000000000000001).UTC(), actMin)
+	actMax, ok = ns.MaxTime()
+	assert.True(t, ok)
+	assert.Equal(t, time.Unix(0, 1600000000000000002).UTC(), actMax)
+
+	dur := series.FromDuration(pool, arrow.Field{Name: "d", Type: arrow.FixedWidthTypes.Duration_s}, []arrow.Duration{1}, nil)
+	defer dur.Release()
+	assert.Panics(t, func() { dur.MinTime() })
+}
+
+func TestDt(t *testing.T) {
+	tsType := &arrow.TimestampType{Unit: arrow.Second, TimeZone: "America/New_York"}
+	// 2020-02-29T23:30:45-05:00 (Saturday), null and 2021-01-04T00:00:00-05:00
+	// (Monday).
+	inVals := []arrow.Timestamp{1583037045, 0, 1609736400}
+	inValid := []bool{true, false, true}
+
 	tests := []struct {
 		scenario string
 
-		inField  arrow.Field
-		inColumn func(memory.Allocator) array.Interface
+		inDt func(series.DatetimeAccessor) series.Series
 
-		expMean float64
+		expVals interface{}
 	}{
 		{
-			scenario: "int32 column",
-			inField:  arrow.Field{Name: "f1-i32", Type: arrow.PrimitiveTypes.Int32},
-			inColumn: func(pool memory.Allocator) array.Interface {
-				b := array.NewInt32Builder(pool)
-				defer b.Release()
-
-				b.AppendValues([]int32{1, 2, 3, 4, 5, 6, 7}, nil)
-
-				return b.NewArray()
-			},
-			expMean: 4,
+			scenario: "year",
+			inDt:     func(dt series.DatetimeAccessor) series.Series { return dt.Year() },
+			expVals:  []int32{2020, 0, 2021},
 		},
 		{
-			scenario: "int64 column",
-			inField:  arrow.Field{Name: "f1-i64", Type: arrow.PrimitiveTypes.Int64},
-			inColumn: func(pool memory.Allocator) array.Interface {
-				b := array.NewInt64Builder(pool)
-				defer b.Release()
-
-				b.AppendValues([]int64{1, 2, 3, 4, 5, 6, 7}, nil)
-
-				return b.NewArray()
-			},
-			expMean: 4,
+			scenario: "month",
+			inDt:     func(dt series.DatetimeAccessor) series.Series { return dt.Month() },
+			expVals:  []int32{2, 0, 1},
 		},
 		{
-			scenario: "float32 column",
-			inField:  arrow.Field{Name: "f1-f32", Type: arrow.PrimitiveTypes.Float32},
-			inColumn: func(pool memory.Allocator) array.Interface {
-				b := array.NewFloat32Builder(pool)
-				defer b.Release()
-
-				b.AppendValues([]float32{1, 2, 3, 4, 5, 6, 7}, nil)
-
-				return b.NewArray()
-			},
-			expMean: 4,
+			scenario: "day",
+			inDt:     func(dt series.DatetimeAccessor) series.Series { return dt.Day() },
+			expVals:  []int32{29, 0, 4},
 		},
 		{
-			scenario: "float64 column",
-			inField:  arrow.Field{Name: "f1-f64", Type: arrow.PrimitiveTypes.Float64},
-			inColumn: func(pool memory.Allocator) array.Interface {
-				b := array.NewFloat64Builder(pool)
-				defer b.Release()
-
-				b.AppendValues([]float64{1, 2, 3, 4, 5, 6, 7}, nil)
-
-				return b.NewArray()
-			},
-			expMean: 4,
+			scenario: "weekday",
+			inDt:     func(dt series.DatetimeAccessor) series.Series { return dt.Weekday() },
+			expVals:  []int32{6, 0, 1},
+		},
+		{
+			scenario: "hour",
+			inDt:     func(dt series.DatetimeAccessor) series.Series { return dt.Hour() },
+			expVals:  []int32{23, 0, 0},
+		},
+		{
+			scenario: "truncate month",
+			inDt:     func(dt series.DatetimeAccessor) series.Series { return dt.Truncate(series.UnitMonth) },
+			// 2020-02-01T00:00:00-05:00 and 2021-01-01T00:00:00-05:00
+			expVals: []arrow.Timestamp{1580533200, 0, 1609477200},
+		},
+		{
+			scenario: "truncate week",
+			inDt:     func(dt series.DatetimeAccessor) series.Series { return dt.Truncate(series.UnitWeek) },
+			// 2020-02-24T00:00:00-05:00
+			expVals: []arrow.Timestamp{1582520400, 0, 1609736400},
+		},
+		{
+			scenario: "floor",
+			inDt:     func(dt series.DatetimeAccessor) series.Series { return dt.Floor(15 * time.Minute) },
+			expVals:  []arrow.Timestamp{1583037000, 0, 1609736400},
+		},
+		{
+			scenario: "ceil",
+			inDt:     func(dt series.DatetimeAccessor) series.Series { return dt.Ceil(15 * time.Minute) },
+			expVals:  []arrow.Timestamp{1583037900, 0, 1609736400},
+		},
+		{
+			scenario: "convert time zone",
+			inDt:     func(dt series.DatetimeAccessor) series.Series { return dt.ConvertTimeZone("UTC") },
+			expVals:  inVals,
 		},
 	}
 
@@ -1545,128 +1967,447 @@ func TestMean(t *testing.T) {
 			pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
 			defer pool.AssertSize(t, 0)
 
-			actSeries := series.FromArrow(pool, tt.inField, tt.inColumn(pool))
-			require.NotNil(t, actSeries)
-			defer actSeries.Release()
+			s := series.FromTimestamp(pool, arrow.Field{Name: "ts", Type: tsType}, inVals, inValid)
+			defer s.Release()
 
-			actMean := actSeries.Mean()
-			assert.Equal(t, tt.expMean, actMean)
+			act := tt.inDt(s.Dt())
+			defer act.Release()
+
+			assert.Equal(t, "ts", act.Name())
+			assert.Equal(t, tt.expVals, act.Values())
+			assert.Equal(t, []int{1}, act.NAIndices())
 		})
 	}
 }
 
-func TestMedian(t *testing.T) {
+func TestDtDate(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	// 2020-02-29 and 1969-12-31
+	s := series.FromDate32(pool, arrow.Field{Name: "d", Type: arrow.FixedWidthTypes.Date32}, []arrow.Date32{18321, -1}, nil)
+	defer s.Release()
+
+	year := s.Dt().Year()
+	defer year.Release()
+	assert.Equal(t, []int32{2020, 1969}, year.Values())
+
+	trunc := s.Dt().Truncate(series.UnitYear)
+	defer trunc.Release()
+	assert.Equal(t, arrow.FixedWidthTypes.Date32, trunc.DataType())
+	assert.Equal(t, []arrow.Date32{18262, -365}, trunc.Values())
+
+	assert.Panics(t, func() { s.Dt().ConvertTimeZone("UTC") })
+}
+
+func TestDtConvertTimeZone(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	// 2020-02-29T23:30:45-05:00
+	f := arrow.Field{Name: "ts", Type: &arrow.TimestampType{Unit: arrow.Second, TimeZone: "America/New_York"}}
+	s := series.FromTimestamp(pool, f, []arrow.Timestamp{1583037045}, nil)
+	defer s.Release()
+
+	utc := s.Dt().ConvertTimeZone("UTC")
+	defer utc.Release()
+	assert.Equal(t, &arrow.TimestampType{Unit: arrow.Second, TimeZone: "UTC"}, utc.DataType())
+
+	day := utc.Dt().Day()
+	defer day.Release()
+	assert.Equal(t, []int32{1}, day.Values())
+
+	assert.Panics(t, func() { s.Dt().ConvertTimeZone("Nowhere/Atlantis") })
+}
+
+func TestCategorical(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	f := arrow.Field{Name: "user", Type: arrow.BinaryTypes.String}
+	str := series.FromString(pool, f, []string{"bob", "amy", "bob", "", "cat", "amy"}, []bool{true, true, true, false, true, true})
+	defer str.Release()
+
+	s := str.AsCategorical()
+	defer s.Release()
+	assert.Equal(t, series.Categorical, s.DataType())
+	assert.Equal(t, "user", s.Name())
+	assert.Equal(t, []string{"bob", "amy", "bob", "", "cat", "amy"}, s.Values())
+
+	categories := s.Categories()
+	defer categories.Release()
+	assert.Equal(t, []string{"bob", "amy", "cat"}, categories.Values())
+
+	codes := s.Codes()
+	defer codes.Release()
+	assert.Equal(t, []int32{0, 1, 0, 0, 2, 1}, codes.Values())
+	assert.Equal(t, []int{3}, codes.NAIndices())
+
+	unique := s.Unique()
+	defer unique.Release()
+	assert.Equal(t, series.Categorical, unique.DataType())
+	assert.Equal(t, []string{"bob", "amy", "cat"}, unique.Values())
+
+	assert.Equal(t, []int{1, 5}, s.FindIndices("amy"))
+	assert.Nil(t, s.FindIndices("dan"))
+
+	sorted := s.SortValues()
+	defer sorted.Release()
+	assert.Equal(t, []string{"amy", "amy", "bob", "bob", "cat", ""}, sorted.Values())
+	assert.Equal(t, []int{5}, sorted.NAIndices())
+
+	eq := s.Eq("bob")
+	defer eq.Release()
+	assert.Equal(t, []bool{true, false, true, false, false, false}, eq.Values())
+	gt := s.Gt("bob")
+	defer gt.Release()
+	assert.Equal(t, []bool{false, false, false, false, true, false}, gt.Values())
+
+	head := s.Head(2)
+	defer head.Release()
+	assert.Equal(t, []string{"bob", "amy"}, head.Values())
+
+	cast := s.Cast(arrow.BinaryTypes.String)
+	defer cast.Release()
+	assert.Equal(t, arrow.BinaryTypes.String, cast.DataType())
+	assert.Equal(t, []string{"bob", "amy", "bob", "", "cat", "amy"}, cast.Values())
+
+	assert.Panics(t, func() {
+		fc := series.FromCategorical(pool, arrow.Field{Name: "c", Type: series.Categorical}, []int32{3}, nil, []string{"a"})
+		fc.Release()
+	})
+	assert.Panics(t, func() { codes.AsCategorical() })
+}
+
+func TestDecimal(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	dt := &arrow.Decimal128Type{Precision: 10, Scale: 2}
+	str := series.FromString(pool, arrow.Field{Name: "amount", Type: arrow.BinaryTypes.String}, []string{"0.10", "-3.5", "", "12"}, []bool{true, true, false, true})
+	defer str.Release()
+
+	s := str.Cast(dt)
+	defer s.Release()
+	assert.Equal(t, dt, s.DataType())
+	assert.Equal(t, []decimal128.Num{decimal128.FromI64(10), decimal128.FromI64(-350), {}, decimal128.FromI64(1200)}, s.Values())
+	assert.Equal(t, []string{"0.10", "-3.50", "0.00", "12.00"}, s.StringValues())
+	assert.Equal(t, []int{2}, s.NAIndices())
+	assert.Equal(t, decimal128.FromI64(860), s.DecimalSum())
+	assert.Equal(t, 8.6, s.Sum())
+	assert.InDelta(t, 8.6/3, s.Mean(), 1e-12)
+
+	sorted := s.SortValues()
+	defer sorted.Release()
+	assert.Equal(t, []string{"-3.50", "0.10", "12.00", "0.00"}, sorted.StringValues())
+	assert.Equal(t, []int{3}, sorted.NAIndices())
+
+	gt := s.Gt("0.1")
+	defer gt.Release()
+	assert.Equal(t, []bool{false, false, false, true}, gt.Values())
+	le := s.Le(0.105)
+	defer le.Release()
+	assert.Equal(t, []bool{true, true, false, false}, le.Values())
+	eq := s.Eq(12)
+	defer eq.Release()
+	assert.Equal(t, []bool{false, false, false, true}, eq.Values())
+
+	i64 := series.FromInt64(pool, arrow.Field{Name: "fee", Type: arrow.PrimitiveTypes.Int64}, []int64{1, 2, 3, -4}, nil)
+	defer i64.Release()
+	fee := i64.Cast(&arrow.Decimal128Type{Precision: 5, Scale: 0})
+	defer fee.Release()
+	assert.Equal(t, []string{"1", "2", "3", "-4"}, fee.StringValues())
+
+	add := s.Add(fee)
+	defer add.Release()
+	assert.Equal(t, &arrow.Decimal128Type{Precision: 11, Scale: 2}, add.DataType())
+	assert.Equal(t, []string{"1.10", "-1.50", "0.00", "8.00"}, add.StringValues())
+	assert.Equal(t, []int{2}, add.NAIndices())
+
+	sub := fee.Subtract(s)
+	defer sub.Release()
+	assert.Equal(t, []string{"0.90", "5.50", "0.00", "-16.00"}, sub.StringValues())
+
+	f64 := s.Cast(arrow.PrimitiveTypes.Float64)
+	defer f64.Release()
+	assert.Equal(t, []float64{0.1, -3.5, 0, 12}, f64.Values())
+	assert.Equal(t, []int{2}, f64.NAIndices())
+	f32 := s.Cast(arrow.PrimitiveTypes.Float32)
+	defer f32.Release()
+	assert.Equal(t, []float32{0.1, -3.5, 0, 12}, f32.Values())
+	assert.Equal(t, []int{2}, f32.NAIndices())
+
+	assert.Panics(t, func() {
+		lossy := s.Cast(&arrow.Decimal128Type{Precision: 10, Scale: 0})
+		lossy.Release()
+	})
+	assert.Panics(t, func() {
+		overflow := s.Cast(&arrow.Decimal128Type{Precision: 3, Scale: 2})
+		overflow.Release()
+	})
+}
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		scenario string
+
+		in string
+
+		exp    string
+		expErr bool
+	}{
+		{scenario: "integer", in: "42", exp: "42.000"},
+		{scenario: "fraction", in: "-0.125", exp: "-0.125"},
+		{scenario: "trailing zeros", in: "+1.2500", exp: "1.250"},
+		{scenario: "leading dot", in: ".5", exp: "0.500"},
+		{scenario: "too many fractional digits", in: "1.2345", expErr: true},
+		{scenario: "too many digits", in: "12345.6", expErr: true},
+		{scenario: "not a number", in: "1e3", expErr: true},
+	}
+
+	dt := &arrow.Decimal128Type{Precision: 7, Scale: 3}
+	for _, tt := range tests {
+		t.Run(tt.scenario, func(t *testing.T) {
+			n, err := series.ParseDecimal(tt.in, dt)
+			if tt.expErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.exp, series.FormatDecimal(n, dt.Scale))
+		})
+	}
+}
+
+func TestNested(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	tags := series.FromString(pool, arrow.Field{Name: "item", Type: arrow.BinaryTypes.String, Nullable: true}, []string{"a", "b", "c", "", "d"}, []bool{true, true, true, false, true})
+	defer tags.Release()
+	l := series.FromList(pool, arrow.Field{Name: "tags"}, tags, []int32{0, 2, 2, 2, 5}, []bool{true, true, false, true})
+	defer l.Release()
+
+	assert.Equal(t, arrow.ListOf(arrow.BinaryTypes.String), l.DataType())
+	assert.Equal(t, 4, l.Len())
+	assert.Equal(t, []int{2}, l.NAIndices())
+	assert.Equal(t, []interface{}{"a", "b"}, l.Value(0))
+	assert.Equal(t, [][]interface{}{{"a", "b"}, {}, nil, {"c", nil, "d"}}, l.Values())
+	assert.Equal(t, []string{`["a","b"]`, `[]`, `null`, `["c",null,"d"]`}, l.StringValues())
+
+	lens := l.ListLen()
+	defer lens.Release()
+	assert.Equal(t, []int32{2, 0, 0, 3}, lens.Values())
+	assert.Equal(t, []int{2}, lens.NAIndices())
+
+	tail := l.Truncate(2, 4)
+	defer tail.Release()
+	elems := tail.ListValues()
+	defer elems.Release()
+	assert.Equal(t, []string{"c", "", "d"}, elems.Values())
+
+	taken := l.Take([]int{3, -1, 0})
+	defer taken.Release()
+	assert.Equal(t, [][]interface{}{{"c", nil, "d"}, nil, {"a", "b"}}, taken.Values())
+
+	ids := series.FromInt64(pool, arrow.Field{Name: "id", Type: arrow.PrimitiveTypes.Int64}, []int64{1, 2, 3}, nil)
+	defer ids.Release()
+	names := series.FromString(pool, arrow.Field{Name: "name", Type: arrow.BinaryTypes.String, Nullable: true}, []string{"jim", "", "joe"}, []bool{true, false, true})
+	defer names.Release()
+	st := series.FromStruct(pool, arrow.Field{Name: "user"}, []series.Series{ids, names}, []bool{true, true, false})
+	defer st.Release()
+
+	assert.Equal(t, arrow.StructOf(ids.Field(), names.Field()), st.DataType())
+	assert.Equal(t, map[string]interface{}{"id": int64(1), "name": "jim"}, st.Value(0))
+	assert.Equal(t, []string{`{"id":1,"name":"jim"}`, `{"id":2}`, `null`}, st.StringValues())
+
+	id := st.StructField("id")
+	defer id.Release()
+	assert.Equal(t, []int64{1, 2, 0}, id.Values())
+	assert.Equal(t, []int{2}, id.NAIndices())
+
+	dropped := st.DropNA()
+	defer dropped.Release()
+	name := dropped.StructField("name")
+	defer name.Release()
+	assert.Equal(t, []int{1}, name.NAIndices())
+
+	assert.Panics(t, func() { st.StructField("missing") })
+	assert.Panics(t, func() { ids.ListLen() })
+	assert.Panics(t, func() {
+		bad := series.FromList(pool, arrow.Field{Name: "bad"}, tags, []int32{0, 6}, nil)
+		bad.Release()
+	})
+}
+
+func TestBinary(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	ft := &arrow.FixedSizeBinaryType{ByteWidth: 4}
+	str := series.FromString(pool, arrow.Field{Name: "hash", Type: arrow.BinaryTypes.String}, []string{"deadbeef", "00000001", "", "deadbeef"}, []bool{true, true, false, true})
+	defer str.Release()
+
+	s := str.Cast(ft)
+	defer s.Release()
+	assert.Equal(t, ft, s.DataType())
+	assert.Equal(t, [][]byte{{0xde, 0xad, 0xbe, 0xef}, {0, 0, 0, 1}, nil, {0xde, 0xad, 0xbe, 0xef}}, s.Values())
+	assert.Equal(t, []string{"deadbeef", "00000001", "", "deadbeef"}, s.StringValues())
+	assert.Equal(t, []int{2}, s.NAIndices())
+	assert.Equal(t, []int{0, 3}, s.FindIndices([]byte{0xde, 0xad, 0xbe, 0xef}))
+	assert.Equal(t, []int{1}, s.FindIndices("00000001"))
+
+	unique := s.Unique()
+	defer unique.Release()
+	assert.Equal(t, []string{"deadbeef", "00000001"}, unique.StringValues())
+
+	sorted := s.SortValues()
+	defer sorted.Release()
+	assert.Equal(t, []string{"00000001", "deadbeef", "deadbeef", ""}, sorted.StringValues())
+
+	eq := s.Eq([]byte{0, 0, 0, 1})
+	defer eq.Release()
+	assert.Equal(t, []bool{false, true, false, false}, eq.Values())
+
+	b64 := s.CastBinary(arrow.BinaryTypes.String, series.Base64Encoding)
+	defer b64.Release()
+	assert.Equal(t, []string{"3q2+7w==", "AAAAAQ==", "", "3q2+7w=="}, b64.Values())
+	assert.Equal(t, []int{2}, b64.NAIndices())
+
+	bin := b64.CastBinary(arrow.BinaryTypes.Binary, series.Base64Encoding)
+	defer bin.Release()
+	assert.Equal(t, arrow.BinaryTypes.Binary, bin.DataType())
+	assert.Equal(t, s.Values(), bin.Values())
+
+	hex := bin.Cast(arrow.BinaryTypes.String)
+	defer hex.Release()
+	assert.Equal(t, []string{"deadbeef", "00000001", "", "deadbeef"}, hex.Values())
+
+	assert.Panics(t, func() {
+		short := bin.Cast(&arrow.FixedSizeBinaryType{ByteWidth: 2})
+		short.Release()
+	})
+	assert.Panics(t, func() {
+		bad := series.FromFixedSizeBinary(pool, arrow.Field{Name: "bad", Type: ft}, [][]byte{{1, 2}}, nil)
+		bad.Release()
+	})
+}
+
+func TestSortValues(t *testing.T) {
 	tests := []struct {
 		scenario string
 
 		inField  arrow.Field
 		inColumn func(memory.Allocator) array.Interface
 
-		expMedian float64
+		expSortValues interface{}
+		expNAIndices  []int
 	}{
 		{
-			scenario: "int32 column: odd number of rows",
+			scenario: "int32 column",
 			inField:  arrow.Field{Name: "f1-i32", Type: arrow.PrimitiveTypes.Int32},
 			inColumn: func(pool memory.Allocator) array.Interface {
 				b := array.NewInt32Builder(pool)
 				defer b.Release()
 
-				b.AppendValues([]int32{1, 2, 3, 4, 5, 6, 7}, nil)
+				b.AppendValues([]int32{4, 2, 7, 1, 3, 5, 6}, nil)
 
 				return b.NewArray()
 			},
-			expMedian: 4,
+			expSortValues: []int32{1, 2, 3, 4, 5, 6, 7},
 		},
 		{
-			scenario: "int32 column: even number of rows",
-			inField:  arrow.Field{Name: "f1-i32", Type: arrow.PrimitiveTypes.Int32},
+			scenario: "int64 column",
+			inField:  arrow.Field{Name: "f1-i64", Type: arrow.PrimitiveTypes.Int64},
 			inColumn: func(pool memory.Allocator) array.Interface {
-				b := array.NewInt32Builder(pool)
+				b := array.NewInt64Builder(pool)
 				defer b.Release()
 
-				b.AppendValues([]int32{1, 2, 3, 4, 5, 6}, nil)
+				b.AppendValues([]int64{40, 20, 70, 10, 30, 50, 60}, nil)
 
 				return b.NewArray()
 			},
-			expMedian: float64(3+4) / float64(2),
+			expSortValues: []int64{10, 20, 30, 40, 50, 60, 70},
 		},
 		{
-			scenario: "int64 column: odd number of rows",
-			inField:  arrow.Field{Name: "f1-i64", Type: arrow.PrimitiveTypes.Int64},
+			scenario: "float32 column",
+			inField:  arrow.Field{Name: "f1-f32", Type: arrow.PrimitiveTypes.Float32},
 			inColumn: func(pool memory.Allocator) array.Interface {
-				b := array.NewInt64Builder(pool)
+				b := array.NewFloat32Builder(pool)
 				defer b.Release()
 
-				b.AppendValues([]int64{1, 2, 3, 4, 5, 6, 7}, nil)
+				b.AppendValues([]float32{4, 2, 7, 1, 3, 5, 6}, nil)
 
 				return b.NewArray()
 			},
-			expMedian: 4,
+			expSortValues: []float32{1, 2, 3, 4, 5, 6, 7},
 		},
 		{
-			scenario: "int64 column: even number of rows",
-			inField:  arrow.Field{Name: "f1-i64", Type: arrow.PrimitiveTypes.Int64},
+			scenario: "float64 column",
+			inField:  arrow.Field{Name: "f1-f64", Type: arrow.PrimitiveTypes.Float64},
 			inColumn: func(pool memory.Allocator) array.Interface {
-				b := array.NewInt64Builder(pool)
+				b := array.NewFloat64Builder(pool)
 				defer b.Release()
 
-				b.AppendValues([]int64{1, 2, 3, 4, 5, 6}, nil)
+				b.AppendValues([]float64{40, 20, 70, 10, 30, 50, 60}, nil)
 
 				return b.NewArray()
 			},
-			expMedian: float64(3+4) / float64(2),
+			expSortValues: []float64{10, 20, 30, 40, 50, 60, 70},
 		},
 		{
-			scenario: "float32 column: odd number of rows",
-			inField:  arrow.Field{Name: "f1-f32", Type: arrow.PrimitiveTypes.Float32},
+			scenario: "int16 column",
+			inField:  arrow.Field{Name: "f1-i16", Type: arrow.PrimitiveTypes.Int16},
 			inColumn: func(pool memory.Allocator) array.Interface {
-				b := array.NewFloat32Builder(pool)
+				b := array.NewInt16Builder(pool)
 				defer b.Release()
 
-				b.AppendValues([]float32{1, 2, 3, 4, 5, 6, 7}, nil)
+				b.AppendValues([]int16{300, -2, 7}, nil)
 
 				return b.NewArray()
 			},
-			expMedian: 4,
+			expSortValues: []int16{-2, 7, 300},
 		},
 		{
-			scenario: "float32 column: even number of rows",
-			inField:  arrow.Field{Name: "f1-f32", Type: arrow.PrimitiveTypes.Float32},
+			scenario: "uint64 column",
+			inField:  arrow.Field{Name: "f1-u64", Type: arrow.PrimitiveTypes.Uint64},
 			inColumn: func(pool memory.Allocator) array.Interface {
-				b := array.NewFloat32Builder(pool)
+				b := array.NewUint64Builder(pool)
 				defer b.Release()
 
-				b.AppendValues([]float32{1, 2, 3, 4, 5, 6}, nil)
+				b.AppendValues([]uint64{1<<63 + 1, 5, 1 << 63, 2}, nil)
 
 				return b.NewArray()
 			},
-			expMedian: float64(3+4) / float64(2),
+			expSortValues: []uint64{2, 5, 1 << 63, 1<<63 + 1},
 		},
 		{
-			scenario: "float64 column: odd number of rows",
-			inField:  arrow.Field{Name: "f1-f64", Type: arrow.PrimitiveTypes.Float64},
+			scenario: "uint16 column with nulls",
+			inField:  arrow.Field{Name: "f1-u16", Type: arrow.PrimitiveTypes.Uint16},
 			inColumn: func(pool memory.Allocator) array.Interface {
-				b := array.NewFloat64Builder(pool)
+				b := array.NewUint16Builder(pool)
 				defer b.Release()
 
-				b.AppendValues([]float64{1, 2, 3, 4, 5, 6, 7}, nil)
+				b.AppendValues([]uint16{1, 2, 1}, []bool{true, false, true})
 
 				return b.NewArray()
 			},
-			expMedian: 4,
+			expSortValues: []uint16{1, 1, 0},
+			expNAIndices:  []int{2},
 		},
 		{
-			scenario: "float64 column: even number of rows",
+			scenario: "float64 column with nulls",
 			inField:  arrow.Field{Name: "f1-f64", Type: arrow.PrimitiveTypes.Float64},
 			inColumn: func(pool memory.Allocator) array.Interface {
 				b := array.NewFloat64Builder(pool)
 				defer b.Release()
 
-				b.AppendValues([]float64{1, 2, 3, 4, 5, 6}, nil)
+				b.AppendValues([]float64{3, 9, 1, 2}, []bool{true, false, true, true})
 
 				return b.NewArray()
 			},
-			expMedian: float64(3+4) / float64(2),
+			expSortValues: []float64{1, 2, 3, 0},
+			expNAIndices:  []int{3},
 		},
 	}
 
@@ -1679,20 +2420,24 @@ func TestMedian(t *testing.T) {
 			require.NotNil(t, actSeries)
 			defer actSeries.Release()
 
-			actMedian := actSeries.Median()
-			assert.Equal(t, tt.expMedian, actMedian)
+			actSortValues := actSeries.SortValues()
+			assert.Equal(t, tt.expSortValues, actSortValues.Values())
+			if tt.expNAIndices != nil {
+				assert.Equal(t, tt.expNAIndices, actSortValues.NAIndices())
+			}
+			defer actSortValues.Release()
 		})
 	}
 }
 
-func TestSquare(t *testing.T) {
+func TestDropNA(t *testing.T) {
 	tests := []struct {
 		scenario string
 
 		inField  arrow.Field
 		inColumn func(memory.Allocator) array.Interface
 
-		expSquare interface{}
+		expDropNA interface{}
 	}{
 		{
 			scenario: "int32 column",
@@ -1701,11 +2446,12 @@ func TestSquare(t *testing.T) {
 				b := array.NewInt32Builder(pool)
 				defer b.Release()
 
-				b.AppendValues([]int32{1, 2, 3, 4, 5, 6, 7}, nil)
+				b.AppendValues([]int32{1, 2, 3, 4, 5, 6, 7},
+					[]bool{true, false, true, false, true, false, true})
 
 				return b.NewArray()
 			},
-			expSquare: []int64{1, 4, 9, 16, 25, 36, 49},
+			expDropNA: []int32{1, 3, 5, 7},
 		},
 		{
 			scenario: "int64 column",
@@ -1714,11 +2460,12 @@ func TestSquare(t *testing.T) {
 				b := array.NewInt64Builder(pool)
 				defer b.Release()
 
-				b.AppendValues([]int64{1, 2, 3, 4, 5, 6, 7}, nil)
+				b.AppendValues([]int64{1, 2, 3, 4, 5, 6, 7},
+					[]bool{true, false, true, false, true, false, true})
 
 				return b.NewArray()
 			},
-			expSquare: []int64{1, 4, 9, 16, 25, 36, 49},
+			expDropNA: []int64{1, 3, 5, 7},
 		},
 		{
 			scenario: "float32 column",
@@ -1727,11 +2474,12 @@ func TestSquare(t *testing.T) {
 				b := array.NewFloat32Builder(pool)
 				defer b.Release()
 
-				b.AppendValues([]float32{1, 2, 3, 4, 5, 6, 7}, nil)
+				b.AppendValues([]float32{1, 2, 3, 4, 5, 6, 7},
+					[]bool{true, false, true, false, true, false, true})
 
 				return b.NewArray()
 			},
-			expSquare: []float64{1, 4, 9, 16, 25, 36, 49},
+			expDropNA: []float32{1, 3, 5, 7},
 		},
 		{
 			scenario: "float64 column",
@@ -1740,11 +2488,12 @@ func TestSquare(t *testing.T) {
 				b := array.NewFloat64Builder(pool)
 				defer b.Release()
 
-				b.AppendValues([]float64{1, 2, 3, 4, 5, 6, 7}, nil)
+				b.AppendValues([]float64{1, 2, 3, 4, 5, 6, 7},
+					[]bool{true, false, true, false, true, false, true})
 
 				return b.NewArray()
 			},
-			expSquare: []float64{1, 4, 9, 16, 25, 36, 49},
+			expDropNA: []float64{1, 3, 5, 7},
 		},
 	}
 
@@ -1757,21 +2506,23 @@ func TestSquare(t *testing.T) {
 			require.NotNil(t, actSeries)
 			defer actSeries.Release()
 
-			actSquare := actSeries.Square()
-			assert.Equal(t, tt.expSquare, actSquare.Values())
-			defer actSquare.Release()
+			actDropNA := actSeries.DropNA()
+			assert.Equal(t, tt.expDropNA, actDropNA.Values())
+			defer actDropNA.Release()
 		})
 	}
 }
 
-func TestSqrt(t *testing.T) {
+func TestDot(t *testing.T) {
 	tests := []struct {
 		scenario string
 
-		inField  arrow.Field
-		inColumn func(memory.Allocator) array.Interface
+		inField   arrow.Field
+		inColumn  func(memory.Allocator) array.Interface
+		inField2  arrow.Field
+		inColumn2 func(memory.Allocator) array.Interface
 
-		expSqrt interface{}
+		expDot float64
 	}{
 		{
 			scenario: "int32 column",
@@ -1780,11 +2531,20 @@ func TestSqrt(t *testing.T) {
 				b := array.NewInt32Builder(pool)
 				defer b.Release()
 
-				b.AppendValues([]int32{1, 4, 9, 16, 25, 36, 49}, nil)
+				b.AppendValues([]int32{1, 2, 3, 4, 5, 6, 7}, nil)
 
 				return b.NewArray()
 			},
-			expSqrt: []float64{1, 2, 3, 4, 5, 6, 7},
+			inField2: arrow.Field{Name: "f1-i32", Type: arrow.PrimitiveTypes.Int32},
+			inColumn2: func(pool memory.Allocator) array.Interface {
+				b := array.NewInt32Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]int32{1, 2, 3, 4, 5, 6, 7}, nil)
+
+				return b.NewArray()
+			},
+			expDot: 140,
 		},
 		{
 			scenario: "int64 column",
@@ -1793,11 +2553,20 @@ func TestSqrt(t *testing.T) {
 				b := array.NewInt64Builder(pool)
 				defer b.Release()
 
-				b.AppendValues([]int64{1, 4, 9, 16, 25, 36, 49}, nil)
+				b.AppendValues([]int64{1, 2, 3, 4, 5, 6, 7}, nil)
 
 				return b.NewArray()
 			},
-			expSqrt: []float64{1, 2, 3, 4, 5, 6, 7},
+			inField2: arrow.Field{Name: "f1-i64", Type: arrow.PrimitiveTypes.Int64},
+			inColumn2: func(pool memory.Allocator) array.Interface {
+				b := array.NewInt64Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]int64{1, 2, 3, 4, 5, 6, 7}, nil)
+
+				return b.NewArray()
+			},
+			expDot: 140,
 		},
 		{
 			scenario: "float32 column",
@@ -1806,11 +2575,20 @@ func TestSqrt(t *testing.T) {
 				b := array.NewFloat32Builder(pool)
 				defer b.Release()
 
-				b.AppendValues([]float32{1, 4, 9, 16, 25, 36, 49}, nil)
+				b.AppendValues([]float32{1, 2, 3, 4, 5, 6, 7}, nil)
 
 				return b.NewArray()
 			},
-			expSqrt: []float64{1, 2, 3, 4, 5, 6, 7},
+			inField2: arrow.Field{Name: "f1-f32", Type: arrow.PrimitiveTypes.Float32},
+			inColumn2: func(pool memory.Allocator) array.Interface {
+				b := array.NewFloat32Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]float32{1, 2, 3, 4, 5, 6, 7}, nil)
+
+				return b.NewArray()
+			},
+			expDot: 140,
 		},
 		{
 			scenario: "float64 column",
@@ -1819,11 +2597,20 @@ func TestSqrt(t *testing.T) {
 				b := array.NewFloat64Builder(pool)
 				defer b.Release()
 
-				b.AppendValues([]float64{1, 4, 9, 16, 25, 36, 49}, nil)
+				b.AppendValues([]float64{1, 2, 3, 4, 5, 6, 7}, nil)
 
 				return b.NewArray()
 			},
-			expSqrt: []float64{1, 2, 3, 4, 5, 6, 7},
+			inField2: arrow.Field{Name: "f1-f64", Type: arrow.PrimitiveTypes.Float64},
+			inColumn2: func(pool memory.Allocator) array.Interface {
+				b := array.NewFloat64Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]float64{1, 2, 3, 4, 5, 6, 7}, nil)
+
+				return b.NewArray()
+			},
+			expDot: 140,
 		},
 	}
 
@@ -1836,21 +2623,24 @@ func TestSqrt(t *testing.T) {
 			require.NotNil(t, actSeries)
 			defer actSeries.Release()
 
-			actSqrt := actSeries.Sqrt()
-			assert.Equal(t, tt.expSqrt, actSqrt.Values())
-			defer actSqrt.Release()
+			actSeries2 := series.FromArrow(pool, tt.inField2, tt.inColumn2(pool))
+			require.NotNil(t, actSeries2)
+			defer actSeries2.Release()
+
+			actDot := actSeries.Dot(actSeries2)
+			assert.Equal(t, tt.expDot, actDot)
 		})
 	}
 }
 
-func TestAbs(t *testing.T) {
+func TestMin(t *testing.T) {
 	tests := []struct {
 		scenario string
 
 		inField  arrow.Field
 		inColumn func(memory.Allocator) array.Interface
 
-		expAbs interface{}
+		expMin float64
 	}{
 		{
 			scenario: "int32 column",
@@ -1859,11 +2649,11 @@ func TestAbs(t *testing.T) {
 				b := array.NewInt32Builder(pool)
 				defer b.Release()
 
-				b.AppendValues([]int32{-1, 2, -3, 4, -5, 6, -7}, nil)
+				b.AppendValues([]int32{1, 2, 3, -4, 5, 6, 7}, nil)
 
 				return b.NewArray()
 			},
-			expAbs: []int32{1, 2, 3, 4, 5, 6, 7},
+			expMin: -4,
 		},
 		{
 			scenario: "int64 column",
@@ -1872,11 +2662,11 @@ func TestAbs(t *testing.T) {
 				b := array.NewInt64Builder(pool)
 				defer b.Release()
 
-				b.AppendValues([]int64{-1, 2, -3, 4, -5, 6, -7}, nil)
+				b.AppendValues([]int64{1, 2, 3, -4, 5, 6, 7}, nil)
 
 				return b.NewArray()
 			},
-			expAbs: []int64{1, 2, 3, 4, 5, 6, 7},
+			expMin: -4,
 		},
 		{
 			scenario: "float32 column",
@@ -1885,11 +2675,11 @@ func TestAbs(t *testing.T) {
 				b := array.NewFloat32Builder(pool)
 				defer b.Release()
 
-				b.AppendValues([]float32{-1, 2, -3, 4, -5, 6, -7}, nil)
+				b.AppendValues([]float32{1, 1, 2, 3, 4, -4, 6, 7}, nil)
 
 				return b.NewArray()
 			},
-			expAbs: []float32{1, 2, 3, 4, 5, 6, 7},
+			expMin: -4,
 		},
 		{
 			scenario: "float64 column",
@@ -1898,11 +2688,50 @@ func TestAbs(t *testing.T) {
 				b := array.NewFloat64Builder(pool)
 				defer b.Release()
 
-				b.AppendValues([]float64{-1, 2, -3, 4, -5, 6, -7}, nil)
+				b.AppendValues([]float64{1, 1, 2, 3, 4, 0, 6, 7}, nil)
 
 				return b.NewArray()
 			},
-			expAbs: []float64{1, 2, 3, 4, 5, 6, 7},
+			expMin: 0,
+		},
+		{
+			scenario: "float64 column with NaN",
+			inField:  arrow.Field{Name: "f1-f64", Type: arrow.PrimitiveTypes.Float64},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewFloat64Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]float64{1, math.NaN(), 5, 3}, nil)
+
+				return b.NewArray()
+			},
+			expMin: 1,
+		},
+		{
+			scenario: "float64 column starting with NaN",
+			inField:  arrow.Field{Name: "f1-f64", Type: arrow.PrimitiveTypes.Float64},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewFloat64Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]float64{math.NaN(), 5, 1, 3}, nil)
+
+				return b.NewArray()
+			},
+			expMin: 1,
+		},
+		{
+			scenario: "float32 column with NaN",
+			inField:  arrow.Field{Name: "f1-f32", Type: arrow.PrimitiveTypes.Float32},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewFloat32Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]float32{5, 1, float32(math.NaN()), 3}, nil)
+
+				return b.NewArray()
+			},
+			expMin: 1,
 		},
 	}
 
@@ -1915,23 +2744,20 @@ func TestAbs(t *testing.T) {
 			require.NotNil(t, actSeries)
 			defer actSeries.Release()
 
-			actAbs := actSeries.Abs()
-			assert.Equal(t, tt.expAbs, actAbs.Values())
-			defer actAbs.Release()
+			actMin := actSeries.Min()
+			assert.Equal(t, tt.expMin, actMin)
 		})
 	}
 }
 
-func TestAdd(t *testing.T) {
+func TestMax(t *testing.T) {
 	tests := []struct {
 		scenario string
 
-		inField   arrow.Field
-		inColumn  func(memory.Allocator) array.Interface
-		inField2  arrow.Field
-		inColumn2 func(memory.Allocator) array.Interface
+		inField  arrow.Field
+		inColumn func(memory.Allocator) array.Interface
 
-		expAdd interface{}
+		expMax float64
 	}{
 		{
 			scenario: "int32 column",
@@ -1940,56 +2766,146 @@ func TestAdd(t *testing.T) {
 				b := array.NewInt32Builder(pool)
 				defer b.Release()
 
-				b.AppendValues([]int32{1, 2, 3, 4, 5, 6, 7}, nil)
+				b.AppendValues([]int32{1, -10, 2, 3, -4, 8, 5, 6, 7}, nil)
 
 				return b.NewArray()
 			},
-			inField2: arrow.Field{Name: "f1-i32", Type: arrow.PrimitiveTypes.Int32},
-			inColumn2: func(pool memory.Allocator) array.Interface {
-				b := array.NewInt32Builder(pool)
+			expMax: 8,
+		},
+		{
+			scenario: "int64 column",
+			inField:  arrow.Field{Name: "f1-i64", Type: arrow.PrimitiveTypes.Int64},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewInt64Builder(pool)
 				defer b.Release()
 
-				b.AppendValues([]int32{1, 2, 3, 4, 5, 6, 7}, nil)
+				b.AppendValues([]int64{1, -10, 3, -4, 8, 5, 6, 7}, nil)
 
 				return b.NewArray()
 			},
-			expAdd: []int32{2, 4, 6, 8, 10, 12, 14},
+			expMax: 8,
 		},
 		{
-			scenario: "int64 column",
-			inField:  arrow.Field{Name: "f1-i64", Type: arrow.PrimitiveTypes.Int64},
+			scenario: "float32 column",
+			inField:  arrow.Field{Name: "f1-f32", Type: arrow.PrimitiveTypes.Float32},
 			inColumn: func(pool memory.Allocator) array.Interface {
-				b := array.NewInt64Builder(pool)
+				b := array.NewFloat32Builder(pool)
 				defer b.Release()
 
-				b.AppendValues([]int64{1, 2, 3, 4, 5, 6, 7}, nil)
+				b.AppendValues([]float32{1.1, -10, 2, 3, 4, 8, 6, 7}, nil)
 
 				return b.NewArray()
 			},
-			inField2: arrow.Field{Name: "f1-i64", Type: arrow.PrimitiveTypes.Int64},
-			inColumn2: func(pool memory.Allocator) array.Interface {
-				b := array.NewInt64Builder(pool)
+			expMax: 8,
+		},
+		{
+			scenario: "float64 column",
+			inField:  arrow.Field{Name: "f1-f64", Type: arrow.PrimitiveTypes.Float64},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewFloat64Builder(pool)
 				defer b.Release()
 
-				b.AppendValues([]int64{1, 2, 3, 4, 5, 6, 7}, nil)
+				b.AppendValues([]float64{1, 1, 2, 3, 4, 8, 6, 7}, nil)
 
 				return b.NewArray()
 			},
-			expAdd: []int64{2, 4, 6, 8, 10, 12, 14},
+			expMax: 8,
 		},
 		{
-			scenario: "float32 column",
+			scenario: "float64 column with NaN",
+			inField:  arrow.Field{Name: "f1-f64", Type: arrow.PrimitiveTypes.Float64},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewFloat64Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]float64{1, math.NaN(), 5, 3}, nil)
+
+				return b.NewArray()
+			},
+			expMax: 5,
+		},
+		{
+			scenario: "float64 column starting with NaN",
+			inField:  arrow.Field{Name: "f1-f64", Type: arrow.PrimitiveTypes.Float64},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewFloat64Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]float64{math.NaN(), 1, 5, 3}, nil)
+
+				return b.NewArray()
+			},
+			expMax: 5,
+		},
+		{
+			scenario: "float32 column with NaN",
 			inField:  arrow.Field{Name: "f1-f32", Type: arrow.PrimitiveTypes.Float32},
 			inColumn: func(pool memory.Allocator) array.Interface {
 				b := array.NewFloat32Builder(pool)
 				defer b.Release()
 
-				b.AppendValues([]float32{1, 2, 3, 4, 5, 6, 7}, nil)
+				b.AppendValues([]float32{5, 1, float32(math.NaN()), 3}, nil)
 
 				return b.NewArray()
 			},
-			inField2: arrow.Field{Name: "f1-f32", Type: arrow.PrimitiveTypes.Float32},
-			inColumn2: func(pool memory.Allocator) array.Interface {
+			expMax: 5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.scenario, func(t *testing.T) {
+			pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer pool.AssertSize(t, 0)
+
+			actSeries := series.FromArrow(pool, tt.inField, tt.inColumn(pool))
+			require.NotNil(t, actSeries)
+			defer actSeries.Release()
+
+			actMax := actSeries.Max()
+			assert.Equal(t, tt.expMax, actMax)
+		})
+	}
+}
+
+func TestMean(t *testing.T) {
+	tests := []struct {
+		scenario string
+
+		inField  arrow.Field
+		inColumn func(memory.Allocator) array.Interface
+
+		expMean float64
+	}{
+		{
+			scenario: "int32 column",
+			inField:  arrow.Field{Name: "f1-i32", Type: arrow.PrimitiveTypes.Int32},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewInt32Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]int32{1, 2, 3, 4, 5, 6, 7}, nil)
+
+				return b.NewArray()
+			},
+			expMean: 4,
+		},
+		{
+			scenario: "int64 column",
+			inField:  arrow.Field{Name: "f1-i64", Type: arrow.PrimitiveTypes.Int64},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewInt64Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]int64{1, 2, 3, 4, 5, 6, 7}, nil)
+
+				return b.NewArray()
+			},
+			expMean: 4,
+		},
+		{
+			scenario: "float32 column",
+			inField:  arrow.Field{Name: "f1-f32", Type: arrow.PrimitiveTypes.Float32},
+			inColumn: func(pool memory.Allocator) array.Interface {
 				b := array.NewFloat32Builder(pool)
 				defer b.Release()
 
@@ -1997,7 +2913,7 @@ func TestAdd(t *testing.T) {
 
 				return b.NewArray()
 			},
-			expAdd: []float32{2, 4, 6, 8, 10, 12, 14},
+			expMean: 4,
 		},
 		{
 			scenario: "float64 column",
@@ -2010,16 +2926,1321 @@ func TestAdd(t *testing.T) {
 
 				return b.NewArray()
 			},
-			inField2: arrow.Field{Name: "f1-f64", Type: arrow.PrimitiveTypes.Float64},
-			inColumn2: func(pool memory.Allocator) array.Interface {
-				b := array.NewFloat64Builder(pool)
-				defer b.Release()
+			expMean: 4,
+		},
+	}
 
-				b.AppendValues([]float64{1, 2, 3, 4, 5, 6, 7}, nil)
+	for _, tt := range tests {
+		t.Run(tt.scenario, func(t *testing.T) {
+			pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer pool.AssertSize(t, 0)
+
+			actSeries := series.FromArrow(pool, tt.inField, tt.inColumn(pool))
+			require.NotNil(t, actSeries)
+			defer actSeries.Release()
+
+			actMean := actSeries.Mean()
+			assert.Equal(t, tt.expMean, actMean)
+		})
+	}
+}
+
+func TestMedian(t *testing.T) {
+	tests := []struct {
+		scenario string
+
+		inField  arrow.Field
+		inColumn func(memory.Allocator) array.Interface
+
+		expMedian float64
+	}{
+		{
+			scenario: "int32 column: odd number of rows",
+			inField:  arrow.Field{Name: "f1-i32", Type: arrow.PrimitiveTypes.Int32},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewInt32Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]int32{1, 2, 3, 4, 5, 6, 7}, nil)
+
+				return b.NewArray()
+			},
+			expMedian: 4,
+		},
+		{
+			scenario: "int32 column: even number of rows",
+			inField:  arrow.Field{Name: "f1-i32", Type: arrow.PrimitiveTypes.Int32},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewInt32Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]int32{1, 2, 3, 4, 5, 6}, nil)
+
+				return b.NewArray()
+			},
+			expMedian: float64(3+4) / float64(2),
+		},
+		{
+			scenario: "int64 column: odd number of rows",
+			inField:  arrow.Field{Name: "f1-i64", Type: arrow.PrimitiveTypes.Int64},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewInt64Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]int64{1, 2, 3, 4, 5, 6, 7}, nil)
+
+				return b.NewArray()
+			},
+			expMedian: 4,
+		},
+		{
+			scenario: "int64 column: even number of rows",
+			inField:  arrow.Field{Name: "f1-i64", Type: arrow.PrimitiveTypes.Int64},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewInt64Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]int64{1, 2, 3, 4, 5, 6}, nil)
+
+				return b.NewArray()
+			},
+			expMedian: float64(3+4) / float64(2),
+		},
+		{
+			scenario: "float32 column: odd number of rows",
+			inField:  arrow.Field{Name: "f1-f32", Type: arrow.PrimitiveTypes.Float32},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewFloat32Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]float32{1, 2, 3, 4, 5, 6, 7}, nil)
+
+				return b.NewArray()
+			},
+			expMedian: 4,
+		},
+		{
+			scenario: "float32 column: even number of rows",
+			inField:  arrow.Field{Name: "f1-f32", Type: arrow.PrimitiveTypes.Float32},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewFloat32Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]float32{1, 2, 3, 4, 5, 6}, nil)
+
+				return b.NewArray()
+			},
+			expMedian: float64(3+4) / float64(2),
+		},
+		{
+			scenario: "float64 column: odd number of rows",
+			inField:  arrow.Field{Name: "f1-f64", Type: arrow.PrimitiveTypes.Float64},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewFloat64Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]float64{1, 2, 3, 4, 5, 6, 7}, nil)
+
+				return b.NewArray()
+			},
+			expMedian: 4,
+		},
+		{
+			scenario: "float64 column: even number of rows",
+			inField:  arrow.Field{Name: "f1-f64", Type: arrow.PrimitiveTypes.Float64},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewFloat64Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]float64{1, 2, 3, 4, 5, 6}, nil)
+
+				return b.NewArray()
+			},
+			expMedian: float64(3+4) / float64(2),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.scenario, func(t *testing.T) {
+			pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer pool.AssertSize(t, 0)
+
+			actSeries := series.FromArrow(pool, tt.inField, tt.inColumn(pool))
+			require.NotNil(t, actSeries)
+			defer actSeries.Release()
+
+			actMedian := actSeries.Median()
+			assert.Equal(t, tt.expMedian, actMedian)
+		})
+	}
+}
+
+func TestSquare(t *testing.T) {
+	tests := []struct {
+		scenario string
+
+		inField  arrow.Field
+		inColumn func(memory.Allocator) array.Interface
+
+		expSquare interface{}
+	}{
+		{
+			scenario: "int32 column",
+			inField:  arrow.Field{Name: "f1-i32", Type: arrow.PrimitiveTypes.Int32},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewInt32Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]int32{1, 2, 3, 4, 5, 6, 7}, nil)
+
+				return b.NewArray()
+			},
+			expSquare: []int64{1, 4, 9, 16, 25, 36, 49},
+		},
+		{
+			scenario: "int64 column",
+			inField:  arrow.Field{Name: "f1-i64", Type: arrow.PrimitiveTypes.Int64},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewInt64Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]int64{1, 2, 3, 4, 5, 6, 7}, nil)
+
+				return b.NewArray()
+			},
+			expSquare: []int64{1, 4, 9, 16, 25, 36, 49},
+		},
+		{
+			scenario: "float32 column",
+			inField:  arrow.Field{Name: "f1-f32", Type: arrow.PrimitiveTypes.Float32},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewFloat32Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]float32{1, 2, 3, 4, 5, 6, 7}, nil)
+
+				return b.NewArray()
+			},
+			expSquare: []float64{1, 4, 9, 16, 25, 36, 49},
+		},
+		{
+			scenario: "float64 column",
+			inField:  arrow.Field{Name: "f1-f64", Type: arrow.PrimitiveTypes.Float64},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewFloat64Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]float64{1, 2, 3, 4, 5, 6, 7}, nil)
+
+				return b.NewArray()
+			},
+			expSquare: []float64{1, 4, 9, 16, 25, 36, 49},
+		},
+		{
+			scenario: "int8 column",
+			inField:  arrow.Field{Name: "f1-i8", Type: arrow.PrimitiveTypes.Int8},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewInt8Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]int8{-3, 12}, nil)
+
+				return b.NewArray()
+			},
+			expSquare: []int64{9, 144},
+		},
+		{
+			scenario: "uint16 column",
+			inField:  arrow.Field{Name: "f1-u16", Type: arrow.PrimitiveTypes.Uint16},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewUint16Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]uint16{300, 2}, nil)
+
+				return b.NewArray()
+			},
+			expSquare: []uint64{90000, 4},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.scenario, func(t *testing.T) {
+			pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer pool.AssertSize(t, 0)
+
+			actSeries := series.FromArrow(pool, tt.inField, tt.inColumn(pool))
+			require.NotNil(t, actSeries)
+			defer actSeries.Release()
+
+			actSquare := actSeries.Square()
+			assert.Equal(t, tt.expSquare, actSquare.Values())
+			defer actSquare.Release()
+		})
+	}
+}
+
+func TestSqrt(t *testing.T) {
+	tests := []struct {
+		scenario string
+
+		inField  arrow.Field
+		inColumn func(memory.Allocator) array.Interface
+
+		expSqrt interface{}
+	}{
+		{
+			scenario: "int32 column",
+			inField:  arrow.Field{Name: "f1-i32", Type: arrow.PrimitiveTypes.Int32},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewInt32Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]int32{1, 4, 9, 16, 25, 36, 49}, nil)
+
+				return b.NewArray()
+			},
+			expSqrt: []float64{1, 2, 3, 4, 5, 6, 7},
+		},
+		{
+			scenario: "int64 column",
+			inField:  arrow.Field{Name: "f1-i64", Type: arrow.PrimitiveTypes.Int64},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewInt64Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]int64{1, 4, 9, 16, 25, 36, 49}, nil)
+
+				return b.NewArray()
+			},
+			expSqrt: []float64{1, 2, 3, 4, 5, 6, 7},
+		},
+		{
+			scenario: "float32 column",
+			inField:  arrow.Field{Name: "f1-f32", Type: arrow.PrimitiveTypes.Float32},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewFloat32Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]float32{1, 4, 9, 16, 25, 36, 49}, nil)
+
+				return b.NewArray()
+			},
+			expSqrt: []float64{1, 2, 3, 4, 5, 6, 7},
+		},
+		{
+			scenario: "float64 column",
+			inField:  arrow.Field{Name: "f1-f64", Type: arrow.PrimitiveTypes.Float64},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewFloat64Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]float64{1, 4, 9, 16, 25, 36, 49}, nil)
+
+				return b.NewArray()
+			},
+			expSqrt: []float64{1, 2, 3, 4, 5, 6, 7},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.scenario, func(t *testing.T) {
+			pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer pool.AssertSize(t, 0)
+
+			actSeries := series.FromArrow(pool, tt.inField, tt.inColumn(pool))
+			require.NotNil(t, actSeries)
+			defer actSeries.Release()
+
+			actSqrt := actSeries.Sqrt()
+			assert.Equal(t, tt.expSqrt, actSqrt.Values())
+			defer actSqrt.Release()
+		})
+	}
+}
+
+func TestAbs(t *testing.T) {
+	tests := []struct {
+		scenario string
+
+		inField  arrow.Field
+		inColumn func(memory.Allocator) array.Interface
+
+		expAbs interface{}
+	}{
+		{
+			scenario: "int32 column",
+			inField:  arrow.Field{Name: "f1-i32", Type: arrow.PrimitiveTypes.Int32},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewInt32Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]int32{-1, 2, -3, 4, -5, 6, -7}, nil)
+
+				return b.NewArray()
+			},
+			expAbs: []int32{1, 2, 3, 4, 5, 6, 7},
+		},
+		{
+			scenario: "int64 column",
+			inField:  arrow.Field{Name: "f1-i64", Type: arrow.PrimitiveTypes.Int64},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewInt64Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]int64{-1, 2, -3, 4, -5, 6, -7}, nil)
+
+				return b.NewArray()
+			},
+			expAbs: []int64{1, 2, 3, 4, 5, 6, 7},
+		},
+		{
+			scenario: "float32 column",
+			inField:  arrow.Field{Name: "f1-f32", Type: arrow.PrimitiveTypes.Float32},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewFloat32Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]float32{-1, 2, -3, 4, -5, 6, -7}, nil)
+
+				return b.NewArray()
+			},
+			expAbs: []float32{1, 2, 3, 4, 5, 6, 7},
+		},
+		{
+			scenario: "float64 column",
+			inField:  arrow.Field{Name: "f1-f64", Type: arrow.PrimitiveTypes.Float64},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewFloat64Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]float64{-1, 2, -3, 4, -5, 6, -7}, nil)
+
+				return b.NewArray()
+			},
+			expAbs: []float64{1, 2, 3, 4, 5, 6, 7},
+		},
+		{
+			scenario: "int8 column",
+			inField:  arrow.Field{Name: "f1-i8", Type: arrow.PrimitiveTypes.Int8},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewInt8Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]int8{-1, 2, -3}, nil)
+
+				return b.NewArray()
+			},
+			expAbs: []int8{1, 2, 3},
+		},
+		{
+			scenario: "uint32 column",
+			inField:  arrow.Field{Name: "f1-u32", Type: arrow.PrimitiveTypes.Uint32},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewUint32Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]uint32{1, 2, 3}, nil)
+
+				return b.NewArray()
+			},
+			expAbs: []uint32{1, 2, 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.scenario, func(t *testing.T) {
+			pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer pool.AssertSize(t, 0)
+
+			actSeries := series.FromArrow(pool, tt.inField, tt.inColumn(pool))
+			require.NotNil(t, actSeries)
+			defer actSeries.Release()
+
+			actAbs := actSeries.Abs()
+			assert.Equal(t, tt.expAbs, actAbs.Values())
+			defer actAbs.Release()
+		})
+	}
+}
+
+func TestAdd(t *testing.T) {
+	tests := []struct {
+		scenario string
+
+		inField   arrow.Field
+		inColumn  func(memory.Allocator) array.Interface
+		inField2  arrow.Field
+		inColumn2 func(memory.Allocator) array.Interface
+
+		expAdd interface{}
+	}{
+		{
+			scenario: "int32 column",
+			inField:  arrow.Field{Name: "f1-i32", Type: arrow.PrimitiveTypes.Int32},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewInt32Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]int32{1, 2, 3, 4, 5, 6, 7}, nil)
+
+				return b.NewArray()
+			},
+			inField2: arrow.Field{Name: "f1-i32", Type: arrow.PrimitiveTypes.Int32},
+			inColumn2: func(pool memory.Allocator) array.Interface {
+				b := array.NewInt32Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]int32{1, 2, 3, 4, 5, 6, 7}, nil)
+
+				return b.NewArray()
+			},
+			expAdd: []int32{2, 4, 6, 8, 10, 12, 14},
+		},
+		{
+			scenario: "int64 column",
+			inField:  arrow.Field{Name: "f1-i64", Type: arrow.PrimitiveTypes.Int64},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewInt64Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]int64{1, 2, 3, 4, 5, 6, 7}, nil)
+
+				return b.NewArray()
+			},
+			inField2: arrow.Field{Name: "f1-i64", Type: arrow.PrimitiveTypes.Int64},
+			inColumn2: func(pool memory.Allocator) array.Interface {
+				b := array.NewInt64Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]int64{1, 2, 3, 4, 5, 6, 7}, nil)
+
+				return b.NewArray()
+			},
+			expAdd: []int64{2, 4, 6, 8, 10, 12, 14},
+		},
+		{
+			scenario: "float32 column",
+			inField:  arrow.Field{Name: "f1-f32", Type: arrow.PrimitiveTypes.Float32},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewFloat32Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]float32{1, 2, 3, 4, 5, 6, 7}, nil)
+
+				return b.NewArray()
+			},
+			inField2: arrow.Field{Name: "f1-f32", Type: arrow.PrimitiveTypes.Float32},
+			inColumn2: func(pool memory.Allocator) array.Interface {
+				b := array.NewFloat32Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]float32{1, 2, 3, 4, 5, 6, 7}, nil)
+
+				return b.NewArray()
+			},
+			expAdd: []float32{2, 4, 6, 8, 10, 12, 14},
+		},
+		{
+			scenario: "float64 column",
+			inField:  arrow.Field{Name: "f1-f64", Type: arrow.PrimitiveTypes.Float64},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewFloat64Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]float64{1, 2, 3, 4, 5, 6, 7}, nil)
+
+				return b.NewArray()
+			},
+			inField2: arrow.Field{Name: "f1-f64", Type: arrow.PrimitiveTypes.Float64},
+			inColumn2: func(pool memory.Allocator) array.Interface {
+				b := array.NewFloat64Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]float64{1, 2, 3, 4, 5, 6, 7}, nil)
+
+				return b.NewArray()
+			},
+			expAdd: []float64{2, 4, 6, 8, 10, 12, 14},
+		},
+		{
+			scenario: "uint8 column",
+			inField:  arrow.Field{Name: "f1-u8", Type: arrow.PrimitiveTypes.Uint8},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewUint8Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]uint8{1, 2, 3}, nil)
+
+				return b.NewArray()
+			},
+			inField2: arrow.Field{Name: "f1-u8", Type: arrow.PrimitiveTypes.Uint8},
+			inColumn2: func(pool memory.Allocator) array.Interface {
+				b := array.NewUint8Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]uint8{3, 4, 5}, nil)
+
+				return b.NewArray()
+			},
+			expAdd: []uint8{4, 6, 8},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.scenario, func(t *testing.T) {
+			pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer pool.AssertSize(t, 0)
+
+			actSeries := series.FromArrow(pool, tt.inField, tt.inColumn(pool))
+			require.NotNil(t, actSeries)
+			defer actSeries.Release()
+
+			actSeries2 := series.FromArrow(pool, tt.inField2, tt.inColumn2(pool))
+			require.NotNil(t, actSeries2)
+			defer actSeries2.Release()
+
+			actAdd := actSeries.Add(actSeries2)
+			assert.Equal(t, tt.expAdd, actAdd.Values())
+			defer actAdd.Release()
+		})
+	}
+}
+
+func TestSubtract(t *testing.T) {
+	tests := []struct {
+		scenario string
+
+		inField   arrow.Field
+		inColumn  func(memory.Allocator) array.Interface
+		inField2  arrow.Field
+		inColumn2 func(memory.Allocator) array.Interface
+
+		expSubtract interface{}
+		expPanic    string
+	}{
+		{
+			scenario: "int32 column",
+			inField:  arrow.Field{Name: "f1-i32", Type: arrow.PrimitiveTypes.Int32},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewInt32Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]int32{1, 2, 3, 4, 5, 6, 7}, nil)
+
+				return b.NewArray()
+			},
+			inField2: arrow.Field{Name: "f1-i32", Type: arrow.PrimitiveTypes.Int32},
+			inColumn2: func(pool memory.Allocator) array.Interface {
+				b := array.NewInt32Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]int32{0, 4, 1, 1, 1, 1, 27}, nil)
+
+				return b.NewArray()
+			},
+			expSubtract: []int32{1, -2, 2, 3, 4, 5, -20},
+		},
+		{
+			scenario: "int64 column",
+			inField:  arrow.Field{Name: "f1-i64", Type: arrow.PrimitiveTypes.Int64},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewInt64Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]int64{1, 2, 3, 4, 5, 6, 7}, nil)
+
+				return b.NewArray()
+			},
+			inField2: arrow.Field{Name: "f1-i64", Type: arrow.PrimitiveTypes.Int64},
+			inColumn2: func(pool memory.Allocator) array.Interface {
+				b := array.NewInt64Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]int64{0, 4, 1, 1, 1, 1, 27}, nil)
+
+				return b.NewArray()
+			},
+			expSubtract: []int64{1, -2, 2, 3, 4, 5, -20},
+		},
+		{
+			scenario: "float32 column",
+			inField:  arrow.Field{Name: "f1-f32", Type: arrow.PrimitiveTypes.Float32},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewFloat32Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]float32{1, 2, 3, 4, 5, 6, 7}, nil)
+
+				return b.NewArray()
+			},
+			inField2: arrow.Field{Name: "f1-f32", Type: arrow.PrimitiveTypes.Float32},
+			inColumn2: func(pool memory.Allocator) array.Interface {
+				b := array.NewFloat32Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]float32{0, 4, 1, 1, 1, 1, 27}, nil)
+
+				return b.NewArray()
+			},
+			expSubtract: []float32{1, -2, 2, 3, 4, 5, -20},
+		},
+		{
+			scenario: "float64 column",
+			inField:  arrow.Field{Name: "f1-f64", Type: arrow.PrimitiveTypes.Float64},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewFloat64Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]float64{1, 2, 3, 4, 5, 6, 7}, nil)
+
+				return b.NewArray()
+			},
+			inField2: arrow.Field{Name: "f1-f64", Type: arrow.PrimitiveTypes.Float64},
+			inColumn2: func(pool memory.Allocator) array.Interface {
+				b := array.NewFloat64Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]float64{0, 4, 1, 1, 1, 1, 27}, nil)
+
+				return b.NewArray()
+			},
+			expSubtract: []float64{1, -2, 2, 3, 4, 5, -20},
+		},
+		{
+			scenario: "invalid types",
+			inField:  arrow.Field{Name: "f1-f64", Type: arrow.PrimitiveTypes.Float64},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewFloat64Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]float64{1, 2, 3, 4, 5, 6, 7}, nil)
+
+				return b.NewArray()
+			},
+			inField2: arrow.Field{Name: "f1-f32", Type: arrow.PrimitiveTypes.Float32},
+			inColumn2: func(pool memory.Allocator) array.Interface {
+				b := array.NewFloat32Builder(pool)
+				defer b.Release()
+
+				b.AppendValues([]float32{0, 4, 1, 1, 1, 1, 27}, nil)
+
+				return b.NewArray()
+			},
+			expPanic: "series: subtract: series types do not match",
+		},
+		{
+			scenario: "unsupported type",
+			inField:  arrow.Field{Name: "f1-str", Type: arrow.BinaryTypes.String},
+			inColumn: func(pool memory.Allocator) array.Interface {
+				b := array.NewStringBuilder(pool)
+				defer b.Release()
+
+				b.AppendValues([]string{"1", "2", "3", "4", "5", "6", "7"}, nil)
+
+				return b.NewArray()
+			},
+			inField2: arrow.Field{Name: "f1-str", Type: arrow.BinaryTypes.String},
+			inColumn2: func(pool memory.Allocator) array.Interface {
+				b := array.NewStringBuilder(pool)
+				defer b.Release()
+
+				b.AppendValues([]string{"0", "4", "1", "1", "1", "1", "27"}, nil)
+
+				return b.NewArray()
+			},
+			expPanic: "series: subtract: unsupported type",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.scenario, func(t *testing.T) {
+			pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer pool.AssertSize(t, 0)
+
+			actSeries := series.FromArrow(pool, tt.inField, tt.inColumn(pool))
+			require.NotNil(t, actSeries)
+			defer actSeries.Release()
+
+			actSeries2 := series.FromArrow(pool, tt.inField2, tt.inColumn2(pool))
+			require.NotNil(t, actSeries2)
+			defer actSeries2.Release()
+
+			if tt.expPanic != "" {
+				assert.PanicsWithValue(t, tt.expPanic, func() { actSeries.Subtract(actSeries2) })
+				return
+			}
+
+			actSubtract := actSeries.Subtract(actSeries2)
+			assert.Equal(t, tt.expSubtract, actSubtract.Values())
+			defer actSubtract.Release()
+		})
+	}
+}
+
+// TODO: MAKE UNSUPPORTED TYPE THAT IS NOT A REAL TYPE
+func TestArith(t *testing.T) {
+	i32 := func(pool memory.Allocator) series.Series {
+		return series.FromInt32(pool, arrow.Field{Name: "a", Type: arrow.PrimitiveTypes.Int32}, []int32{7, -7, 2, 5}, []bool{true, true, true, false})
+	}
+	i8 := func(pool memory.Allocator) series.Series {
+		return series.FromInt8(pool, arrow.Field{Name: "b", Type: arrow.PrimitiveTypes.Int8}, []int8{2, 2, 0, 1}, nil)
+	}
+	u16 := func(pool memory.Allocator) series.Series {
+		return series.FromUint16(pool, arrow.Field{Name: "b", Type: arrow.PrimitiveTypes.Uint16}, []uint16{3, 2, 0, 1}, nil)
+	}
+	f32 := func(pool memory.Allocator) series.Series {
+		return series.FromFloat32(pool, arrow.Field{Name: "b", Type: arrow.PrimitiveTypes.Float32}, []float32{2, 0.5, 0, 1}, nil)
+	}
+
+	tests := []struct {
+		scenario string
+
+		inSeries  func(memory.Allocator) series.Series
+		inSeries2 func(memory.Allocator) series.Series
+		op        func(s, ss series.Series) series.Series
+
+		expType      arrow.DataType
+		exp          interface{}
+		expNAIndices []int
+	}{
+		{
+			scenario:     "multiply int32 by int8",
+			inSeries:     i32,
+			inSeries2:    i8,
+			op:           series.Series.Multiply,
+			expType:      arrow.PrimitiveTypes.Int64,
+			exp:          []int64{14, -14, 0, 0},
+			expNAIndices: []int{3},
+		},
+		{
+			scenario:     "multiply uint16 by uint16",
+			inSeries:     u16,
+			inSeries2:    u16,
+			op:           series.Series.Multiply,
+			expType:      arrow.PrimitiveTypes.Uint64,
+			exp:          []uint64{9, 4, 0, 1},
+			expNAIndices: []int{},
+		},
+		{
+			scenario:     "multiply int32 by float32",
+			inSeries:     i32,
+			inSeries2:    f32,
+			op:           series.Series.Multiply,
+			expType:      arrow.PrimitiveTypes.Float64,
+			exp:          []float64{14, -3.5, 0, 0},
+			expNAIndices: []int{3},
+		},
+		{
+			scenario:  "divide by zero gives inf",
+			inSeries:  i32,
+			inSeries2: u16,
+			op: func(s, ss series.Series) series.Series {
+				return s.Divide(ss)
+			},
+			expType:      arrow.PrimitiveTypes.Float64,
+			exp:          []float64{7.0 / 3, -3.5, math.Inf(1), 0},
+			expNAIndices: []int{3},
+		},
+		{
+			scenario:  "divide by zero gives null",
+			inSeries:  i32,
+			inSeries2: f32,
+			op: func(s, ss series.Series) series.Series {
+				return s.Divide(ss, series.WithDivideByZero(series.DivideByZeroNull))
+			},
+			expType:      arrow.PrimitiveTypes.Float64,
+			exp:          []float64{3.5, -14, 0, 0},
+			expNAIndices: []int{2, 3},
+		},
+		{
+			scenario:  "mod int32 by int8",
+			inSeries:  i32,
+			inSeries2: i8,
+			op: func(s, ss series.Series) series.Series {
+				return s.Mod(ss)
+			},
+			expType:      arrow.PrimitiveTypes.Int64,
+			exp:          []int64{1, -1, 0, 0},
+			expNAIndices: []int{2, 3},
+		},
+		{
+			scenario:  "mod int32 by float32",
+			inSeries:  i32,
+			inSeries2: f32,
+			op: func(s, ss series.Series) series.Series {
+				return s.Mod(ss, series.WithDivideByZero(series.DivideByZeroNull))
+			},
+			expType:      arrow.PrimitiveTypes.Float64,
+			exp:          []float64{1, 0, 0, 0},
+			expNAIndices: []int{2, 3},
+		},
+		{
+			scenario:     "pow int32 by int8",
+			inSeries:     i32,
+			inSeries2:    i8,
+			op:           series.Series.Pow,
+			expType:      arrow.PrimitiveTypes.Int64,
+			exp:          []int64{49, 49, 1, 0},
+			expNAIndices: []int{3},
+		},
+		{
+			scenario:     "pow float32 by int32",
+			inSeries:     f32,
+			inSeries2:    i32,
+			op:           series.Series.Pow,
+			expType:      arrow.PrimitiveTypes.Float64,
+			exp:          []float64{128, 128, 0, 0},
+			expNAIndices: []int{3},
+		},
+		{
+			scenario:  "arith subtract float32 from int32",
+			inSeries:  i32,
+			inSeries2: f32,
+			op: func(s, ss series.Series) series.Series {
+				return s.Arith(series.OpSubtract, ss)
+			},
+			expType:      arrow.PrimitiveTypes.Float64,
+			exp:          []float64{5, -7.5, 2, 0},
+			expNAIndices: []int{3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.scenario, func(t *testing.T) {
+			pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer pool.AssertSize(t, 0)
+
+			s := tt.inSeries(pool)
+			defer s.Release()
+			ss := tt.inSeries2(pool)
+			defer ss.Release()
+
+			act := tt.op(s, ss)
+			defer act.Release()
+
+			assert.Equal(t, tt.expType, act.DataType())
+			assert.Equal(t, tt.expNAIndices, act.NAIndices())
+			if exp, ok := tt.exp.([]float64); ok {
+				assert.InDeltaSlice(t, exp, act.Values(), 1e-12)
+				return
+			}
+			assert.Equal(t, tt.exp, act.Values())
+		})
+	}
+
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+	s := i32(pool)
+	defer s.Release()
+	str := series.FromString(pool, arrow.Field{Name: "s", Type: arrow.BinaryTypes.String}, []string{"a"}, nil)
+	defer str.Release()
+	assert.Panics(t, func() { s.Multiply(str) })
+}
+
+func TestArithScalar(t *testing.T) {
+	i32 := func(pool memory.Allocator) series.Series {
+		return series.FromInt32(pool, arrow.Field{Name: "a", Type: arrow.PrimitiveTypes.Int32}, []int32{7, -7, 2, 5}, []bool{true, true, true, false})
+	}
+	u16 := func(pool memory.Allocator) series.Series {
+		return series.FromUint16(pool, arrow.Field{Name: "b", Type: arrow.PrimitiveTypes.Uint16}, []uint16{3, 2, 0, 1}, nil)
+	}
+
+	tests := []struct {
+		scenario string
+
+		inSeries func(memory.Allocator) series.Series
+		op       func(s series.Series) series.Series
+
+		expType      arrow.DataType
+		exp          interface{}
+		expNAIndices []int
+	}{
+		{
+			scenario:     "add int to int32",
+			inSeries:     i32,
+			op:           func(s series.Series) series.Series { return s.AddScalar(3) },
+			expType:      arrow.PrimitiveTypes.Int64,
+			exp:          []int64{10, -4, 5, 0},
+			expNAIndices: []int{3},
+		},
+		{
+			scenario:     "subtract float from int32",
+			inSeries:     i32,
+			op:           func(s series.Series) series.Series { return s.SubScalar(0.5) },
+			expType:      arrow.PrimitiveTypes.Float64,
+			exp:          []float64{6.5, -7.5, 1.5, 0},
+			expNAIndices: []int{3},
+		},
+		{
+			scenario:     "multiply uint16 by int",
+			inSeries:     u16,
+			op:           func(s series.Series) series.Series { return s.MulScalar(2) },
+			expType:      arrow.PrimitiveTypes.Uint64,
+			exp:          []uint64{6, 4, 0, 2},
+			expNAIndices: []int{},
+		},
+		{
+			scenario:     "multiply uint16 by negative int",
+			inSeries:     u16,
+			op:           func(s series.Series) series.Series { return s.MulScalar(-2) },
+			expType:      arrow.PrimitiveTypes.Int64,
+			exp:          []int64{-6, -4, 0, -2},
+			expNAIndices: []int{},
+		},
+		{
+			scenario: "divide int32 by zero",
+			inSeries: i32,
+			op: func(s series.Series) series.Series {
+				return s.DivScalar(0, series.WithDivideByZero(series.DivideByZeroNull))
+			},
+			expType:      arrow.PrimitiveTypes.Float64,
+			exp:          []float64{0, 0, 0, 0},
+			expNAIndices: []int{0, 1, 2, 3},
+		},
+		{
+			scenario:     "mod int32 by int",
+			inSeries:     i32,
+			op:           func(s series.Series) series.Series { return s.ModScalar(4) },
+			expType:      arrow.PrimitiveTypes.Int64,
+			exp:          []int64{3, -3, 2, 0},
+			expNAIndices: []int{3},
+		},
+		{
+			scenario:     "pow uint16 by int",
+			inSeries:     u16,
+			op:           func(s series.Series) series.Series { return s.PowScalar(3) },
+			expType:      arrow.PrimitiveTypes.Uint64,
+			exp:          []uint64{27, 8, 0, 1},
+			expNAIndices: []int{},
+		},
+		{
+			scenario:     "add nil",
+			inSeries:     u16,
+			op:           func(s series.Series) series.Series { return s.AddScalar(nil) },
+			expType:      arrow.PrimitiveTypes.Uint64,
+			exp:          []uint64{0, 0, 0, 0},
+			expNAIndices: []int{0, 1, 2, 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.scenario, func(t *testing.T) {
+			pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer pool.AssertSize(t, 0)
+
+			s := tt.inSeries(pool)
+			defer s.Release()
+
+			act := tt.op(s)
+			defer act.Release()
+
+			assert.Equal(t, tt.expType, act.DataType())
+			assert.Equal(t, tt.expNAIndices, act.NAIndices())
+			assert.Equal(t, tt.exp, act.Values())
+		})
+	}
+
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+	s := i32(pool)
+	defer s.Release()
+	assert.Panics(t, func() { s.AddScalar("a") })
+}
+
+func TestNullAggregations(t *testing.T) {
+	i32 := func(pool memory.Allocator) series.Series {
+		return series.FromInt32(pool, arrow.Field{Name: "a", Type: arrow.PrimitiveTypes.Int32}, []int32{4, -9, 2, 100, 6}, []bool{true, true, true, false, true})
+	}
+	f64 := func(pool memory.Allocator) series.Series {
+		return series.FromFloat64(pool, arrow.Field{Name: "b", Type: arrow.PrimitiveTypes.Float64}, []float64{1, 2, 3, 4, 5}, []bool{false, true, true, true, true})
+	}
+	nulls := func(pool memory.Allocator) series.Series {
+		return series.FromUint8(pool, arrow.Field{Name: "c", Type: arrow.PrimitiveTypes.Uint8}, []uint8{1, 2}, []bool{false, false})
+	}
+
+	tests := []struct {
+		scenario string
+
+		inSeries func(memory.Allocator) series.Series
+		agg      func(s series.Series) float64
+
+		exp float64
+	}{
+		{
+			scenario: "count skips nulls",
+			inSeries: i32,
+			agg:      func(s series.Series) float64 { return float64(s.Count()) },
+			exp:      4,
+		},
+		{
+			scenario: "sum skips nulls",
+			inSeries: i32,
+			agg:      func(s series.Series) float64 { return s.Sum() },
+			exp:      3,
+		},
+		{
+			scenario: "mean skips nulls",
+			inSeries: i32,
+			agg:      func(s series.Series) float64 { return s.Mean() },
+			exp:      0.75,
+		},
+		{
+			scenario: "min skips nulls",
+			inSeries: i32,
+			agg:      func(s series.Series) float64 { return s.Min() },
+			exp:      -9,
+		},
+		{
+			scenario: "max skips nulls",
+			inSeries: i32,
+			agg:      func(s series.Series) float64 { return s.Max() },
+			exp:      6,
+		},
+		{
+			scenario: "median skips nulls",
+			inSeries: i32,
+			agg:      func(s series.Series) float64 { return s.Median() },
+			exp:      3,
+		},
+		{
+			scenario: "std skips nulls",
+			inSeries: i32,
+			agg:      func(s series.Series) float64 { return s.STD() },
+			exp:      math.Sqrt(134.75 / 3),
+		},
+		{
+			scenario: "magnitude skips nulls",
+			inSeries: i32,
+			agg:      func(s series.Series) float64 { return s.Magnitude() },
+			exp:      math.Sqrt(137),
+		},
+		{
+			scenario: "int dot skips nulls",
+			inSeries: i32,
+			agg:      func(s series.Series) float64 { return s.Dot(s) },
+			exp:      137,
+		},
+		{
+			scenario: "float sum skips nulls",
+			inSeries: f64,
+			agg:      func(s series.Series) float64 { return s.Sum() },
+			exp:      14,
+		},
+		{
+			scenario: "float dot skips nulls",
+			inSeries: f64,
+			agg:      func(s series.Series) float64 { return s.Dot(s) },
+			exp:      54,
+		},
+		{
+			scenario: "sum propagates nulls",
+			inSeries: i32,
+			agg:      func(s series.Series) float64 { return s.Sum(series.WithSkipNA(false)) },
+			exp:      math.NaN(),
+		},
+		{
+			scenario: "median propagates nulls",
+			inSeries: i32,
+			agg:      func(s series.Series) float64 { return s.Median(series.WithSkipNA(false)) },
+			exp:      math.NaN(),
+		},
+		{
+			scenario: "dot propagates nulls",
+			inSeries: f64,
+			agg:      func(s series.Series) float64 { return s.Dot(s, series.WithSkipNA(false)) },
+			exp:      math.NaN(),
+		},
+		{
+			scenario: "count of all nulls",
+			inSeries: nulls,
+			agg:      func(s series.Series) float64 { return float64(s.Count()) },
+			exp:      0,
+		},
+		{
+			scenario: "sum of all nulls",
+			inSeries: nulls,
+			agg:      func(s series.Series) float64 { return s.Sum() },
+			exp:      0,
+		},
+		{
+			scenario: "mean of all nulls",
+			inSeries: nulls,
+			agg:      func(s series.Series) float64 { return s.Mean() },
+			exp:      math.NaN(),
+		},
+		{
+			scenario: "min of all nulls",
+			inSeries: nulls,
+			agg:      func(s series.Series) float64 { return s.Min() },
+			exp:      math.NaN(),
+		},
+		{
+			scenario: "max of all nulls",
+			inSeries: nulls,
+			agg:      func(s series.Series) float64 { return s.Max() },
+			exp:      math.NaN(),
+		},
+		{
+			scenario: "median of all nulls",
+			inSeries: nulls,
+			agg:      func(s series.Series) float64 { return s.Median() },
+			exp:      math.NaN(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.scenario, func(t *testing.T) {
+			pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer pool.AssertSize(t, 0)
+
+			s := tt.inSeries(pool)
+			defer s.Release()
+
+			act := tt.agg(s)
+			if math.IsNaN(tt.exp) {
+				assert.True(t, math.IsNaN(act))
+				return
+			}
+			assert.InDelta(t, tt.exp, act, 1e-12)
+		})
+	}
+}
+
+func TestNullArithmetic(t *testing.T) {
+	i32 := func(pool memory.Allocator) series.Series {
+		return series.FromInt32(pool, arrow.Field{Name: "a", Type: arrow.PrimitiveTypes.Int32}, []int32{4, -9, 2, 100, 6}, []bool{true, true, true, false, true})
+	}
+	f64 := func(pool memory.Allocator) series.Series {
+		return series.FromFloat64(pool, arrow.Field{Name: "b", Type: arrow.PrimitiveTypes.Float64}, []float64{1, 2, 3, 4, 5}, []bool{false, true, true, true, true})
+	}
+
+	tests := []struct {
+		scenario string
+
+		inSeries func(memory.Allocator) series.Series
+		op       func(s series.Series) series.Series
+
+		expNAIndices []int
+	}{
+		{
+			scenario:     "add",
+			inSeries:     i32,
+			op:           func(s series.Series) series.Series { return s.Add(s) },
+			expNAIndices: []int{3},
+		},
+		{
+			scenario:     "subtract",
+			inSeries:     f64,
+			op:           func(s series.Series) series.Series { return s.Subtract(s) },
+			expNAIndices: []int{0},
+		},
+		{
+			scenario:     "square",
+			inSeries:     i32,
+			op:           func(s series.Series) series.Series { return s.Square() },
+			expNAIndices: []int{3},
+		},
+		{
+			scenario:     "sqrt",
+			inSeries:     f64,
+			op:           func(s series.Series) series.Series { return s.Sqrt() },
+			expNAIndices: []int{0},
+		},
+		{
+			scenario:     "abs",
+			inSeries:     i32,
+			op:           func(s series.Series) series.Series { return s.Abs() },
+			expNAIndices: []int{3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.scenario, func(t *testing.T) {
+			pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer pool.AssertSize(t, 0)
+
+			s := tt.inSeries(pool)
+			defer s.Release()
+
+			act := tt.op(s)
+			defer act.Release()
+
+			assert.Equal(t, tt.expNAIndices, act.NAIndices())
+		})
+	}
+}
+
+func TestCumulative(t *testing.T) {
+	i32 := func(pool memory.Allocator) series.Series {
+		return series.FromInt32(pool, arrow.Field{Name: "a", Type: arrow.PrimitiveTypes.Int32}, []int32{3, 1, 9, 2, 4}, []bool{true, true, false, true, true})
+	}
+	f32 := func(pool memory.Allocator) series.Series {
+		return series.FromFloat32(pool, arrow.Field{Name: "b", Type: arrow.PrimitiveTypes.Float32}, []float32{2, 0.5, 4, 1, 3}, nil)
+	}
+	u8 := func(pool memory.Allocator) series.Series {
+		return series.FromUint8(pool, arrow.Field{Name: "c", Type: arrow.PrimitiveTypes.Uint8}, []uint8{5, 7, 1, 6, 2}, nil)
+	}
+
+	tests := []struct {
+		scenario string
+
+		inSeries func(memory.Allocator) series.Series
+		op       func(s series.Series) series.Series
 
-				return b.NewArray()
-			},
-			expAdd: []float64{2, 4, 6, 8, 10, 12, 14},
+		expType      arrow.DataType
+		exp          interface{}
+		expNAIndices []int
+	}{
+		{
+			scenario:     "cum sum skips nulls",
+			inSeries:     i32,
+			op:           func(s series.Series) series.Series { return s.CumSum() },
+			expType:      arrow.PrimitiveTypes.Int64,
+			exp:          []int64{3, 4, 0, 6, 10},
+			expNAIndices: []int{2},
+		},
+		{
+			scenario: "cum sum without skipping nulls",
+			inSeries: i32,
+			op: func(s series.Series) series.Series {
+				return s.CumSum(series.WithSkipNA(false))
+			},
+			expType:      arrow.PrimitiveTypes.Int64,
+			exp:          []int64{3, 4, 0, 0, 0},
+			expNAIndices: []int{2, 3, 4},
+		},
+		{
+			scenario:     "cum prod float32",
+			inSeries:     f32,
+			op:           func(s series.Series) series.Series { return s.CumProd() },
+			expType:      arrow.PrimitiveTypes.Float64,
+			exp:          []float64{2, 1, 4, 4, 12},
+			expNAIndices: []int{},
+		},
+		{
+			scenario:     "cum min int32",
+			inSeries:     i32,
+			op:           func(s series.Series) series.Series { return s.CumMin() },
+			expType:      arrow.PrimitiveTypes.Int64,
+			exp:          []int64{3, 1, 0, 1, 1},
+			expNAIndices: []int{2},
+		},
+		{
+			scenario:     "cum max uint8",
+			inSeries:     u8,
+			op:           func(s series.Series) series.Series { return s.CumMax() },
+			expType:      arrow.PrimitiveTypes.Uint64,
+			exp:          []uint64{5, 7, 7, 7, 7},
+			expNAIndices: []int{},
+		},
+		{
+			scenario:     "cum count",
+			inSeries:     i32,
+			op:           func(s series.Series) series.Series { return s.CumCount() },
+			expType:      arrow.PrimitiveTypes.Int64,
+			exp:          []int64{1, 2, 2, 3, 4},
+			expNAIndices: []int{},
 		},
 	}
 
@@ -2028,164 +4249,290 @@ func TestAdd(t *testing.T) {
 			pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
 			defer pool.AssertSize(t, 0)
 
-			actSeries := series.FromArrow(pool, tt.inField, tt.inColumn(pool))
-			require.NotNil(t, actSeries)
-			defer actSeries.Release()
+			s := tt.inSeries(pool)
+			defer s.Release()
 
-			actSeries2 := series.FromArrow(pool, tt.inField2, tt.inColumn2(pool))
-			require.NotNil(t, actSeries2)
-			defer actSeries2.Release()
+			act := tt.op(s)
+			defer act.Release()
 
-			actAdd := actSeries.Add(actSeries2)
-			assert.Equal(t, tt.expAdd, actAdd.Values())
-			defer actAdd.Release()
+			assert.Equal(t, tt.expType, act.DataType())
+			assert.Equal(t, tt.expNAIndices, act.NAIndices())
+			assert.Equal(t, tt.exp, act.Values())
 		})
 	}
+
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+	s := i32(pool)
+	defer s.Release()
+	keys := series.FromString(pool, arrow.Field{Name: "k", Type: arrow.BinaryTypes.String}, []string{"x", "y", "x", "x", ""}, []bool{true, true, true, true, false})
+	defer keys.Release()
+	grouped := s.CumulativeBy(series.OpCumSum, keys)
+	defer grouped.Release()
+	assert.Equal(t, []int64{3, 1, 0, 5, 0}, grouped.Values())
+	assert.Equal(t, []int{2, 4}, grouped.NAIndices())
+
+	assert.Panics(t, func() { keys.CumSum() })
 }
 
-func TestSubtract(t *testing.T) {
+func TestShift(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	i32 := series.FromInt32(pool, arrow.Field{Name: "a", Type: arrow.PrimitiveTypes.Int32}, []int32{1, 2, 4, 8, 16}, []bool{true, true, false, true, true})
+	defer i32.Release()
+	u8 := series.FromUint8(pool, arrow.Field{Name: "b", Type: arrow.PrimitiveTypes.Uint8}, []uint8{5, 3, 0, 6}, nil)
+	defer u8.Release()
+	str := series.FromString(pool, arrow.Field{Name: "c", Type: arrow.BinaryTypes.String}, []string{"x", "y", "z"}, nil)
+	defer str.Release()
+
+	fwd := i32.Shift(2)
+	defer fwd.Release()
+	assert.Equal(t, arrow.PrimitiveTypes.Int32, fwd.DataType())
+	assert.Equal(t, []int32{0, 0, 1, 2, 4}, fwd.Values())
+	assert.Equal(t, []int{0, 1, 4}, fwd.NAIndices())
+
+	back := i32.Shift(-1, series.WithShiftFill(-1))
+	defer back.Release()
+	assert.Equal(t, []int32{2, 4, 8, 16, -1}, back.Values())
+	assert.Equal(t, []int{1}, back.NAIndices())
+
+	none := i32.Shift(0)
+	defer none.Release()
+	assert.Equal(t, []int32{1, 2, 4, 8, 16}, none.Values())
+	assert.Equal(t, []int{2}, none.NAIndices())
+
+	strs := str.Shift(1, series.WithShiftFill("-"))
+	defer strs.Release()
+	assert.Equal(t, []string{"-", "x", "y"}, strs.Values())
+	assert.Panics(t, func() { str.Shift(1, series.WithShiftFill(1)) })
+
+	all := u8.Shift(-9)
+	defer all.Release()
+	assert.Equal(t, []uint8{0, 0, 0, 0}, all.Values())
+	assert.Equal(t, []int{0, 1, 2, 3}, all.NAIndices())
+	assert.Panics(t, func() { u8.Shift(1, series.WithShiftFill(1.5)) })
+
+	cat := str.AsCategorical()
+	defer cat.Release()
+	cats := cat.Shift(-2, series.WithShiftFill("x"))
+	defer cats.Release()
+	assert.Equal(t, series.Categorical, cats.DataType())
+	assert.Equal(t, []string{"z", "x", "x"}, cats.Values())
+	assert.Panics(t, func() { cat.Shift(1, series.WithShiftFill("w")) })
+
+	diff := i32.Diff(1)
+	defer diff.Release()
+	assert.Equal(t, arrow.PrimitiveTypes.Int64, diff.DataType())
+	assert.Equal(t, []int64{0, 1, 0, 0, 8}, diff.Values())
+	assert.Equal(t, []int{0, 2, 3}, diff.NAIndices())
+
+	udiff := u8.Diff(-1)
+	defer udiff.Release()
+	assert.Equal(t, arrow.PrimitiveTypes.Int64, udiff.DataType())
+	assert.Equal(t, []int64{2, 3, -6, 0}, udiff.Values())
+	assert.Equal(t, []int{3}, udiff.NAIndices())
+
+	pct := u8.PctChange(1, series.WithDivideByZero(series.DivideByZeroNull))
+	defer pct.Release()
+	assert.Equal(t, []float64{0, -0.4, -1, 0}, pct.Values())
+	assert.Equal(t, []int{0, 3}, pct.NAIndices())
+
+	assert.Panics(t, func() { str.Diff(1) })
+}
+
+func TestRolling(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	s := series.FromInt32(pool, arrow.Field{Name: "a", Type: arrow.PrimitiveTypes.Int32}, []int32{4, 1, 7, 3, 9, 2, 8}, []bool{true, true, true, false, true, true, true})
+	defer s.Release()
+
 	tests := []struct {
 		scenario string
 
-		inField   arrow.Field
-		inColumn  func(memory.Allocator) array.Interface
-		inField2  arrow.Field
-		inColumn2 func(memory.Allocator) array.Interface
+		op func(r series.Rolling) series.Series
 
-		expSubtract interface{}
-		expPanic    string
+		exp          []float64
+		expNAIndices []int
 	}{
 		{
-			scenario: "int32 column",
-			inField:  arrow.Field{Name: "f1-i32", Type: arrow.PrimitiveTypes.Int32},
-			inColumn: func(pool memory.Allocator) array.Interface {
-				b := array.NewInt32Builder(pool)
-				defer b.Release()
-
-				b.AppendValues([]int32{1, 2, 3, 4, 5, 6, 7}, nil)
-
-				return b.NewArray()
-			},
-			inField2: arrow.Field{Name: "f1-i32", Type: arrow.PrimitiveTypes.Int32},
-			inColumn2: func(pool memory.Allocator) array.Interface {
-				b := array.NewInt32Builder(pool)
-				defer b.Release()
-
-				b.AppendValues([]int32{0, 4, 1, 1, 1, 1, 27}, nil)
-
-				return b.NewArray()
-			},
-			expSubtract: []int32{1, -2, 2, 3, 4, 5, -20},
+			scenario:     "sum",
+			op:           series.Rolling.Sum,
+			exp:          []float64{0, 5, 12, 8, 16, 11, 19},
+			expNAIndices: []int{0},
 		},
 		{
-			scenario: "int64 column",
-			inField:  arrow.Field{Name: "f1-i64", Type: arrow.PrimitiveTypes.Int64},
-			inColumn: func(pool memory.Allocator) array.Interface {
-				b := array.NewInt64Builder(pool)
-				defer b.Release()
-
-				b.AppendValues([]int64{1, 2, 3, 4, 5, 6, 7}, nil)
-
-				return b.NewArray()
-			},
-			inField2: arrow.Field{Name: "f1-i64", Type: arrow.PrimitiveTypes.Int64},
-			inColumn2: func(pool memory.Allocator) array.Interface {
-				b := array.NewInt64Builder(pool)
-				defer b.Release()
-
-				b.AppendValues([]int64{0, 4, 1, 1, 1, 1, 27}, nil)
-
-				return b.NewArray()
-			},
-			expSubtract: []int64{1, -2, 2, 3, 4, 5, -20},
+			scenario:     "mean",
+			op:           series.Rolling.Mean,
+			exp:          []float64{0, 2.5, 4, 4, 8, 5.5, 19.0 / 3},
+			expNAIndices: []int{0},
 		},
 		{
-			scenario: "float32 column",
-			inField:  arrow.Field{Name: "f1-f32", Type: arrow.PrimitiveTypes.Float32},
-			inColumn: func(pool memory.Allocator) array.Interface {
-				b := array.NewFloat32Builder(pool)
-				defer b.Release()
-
-				b.AppendValues([]float32{1, 2, 3, 4, 5, 6, 7}, nil)
-
-				return b.NewArray()
-			},
-			inField2: arrow.Field{Name: "f1-f32", Type: arrow.PrimitiveTypes.Float32},
-			inColumn2: func(pool memory.Allocator) array.Interface {
-				b := array.NewFloat32Builder(pool)
-				defer b.Release()
-
-				b.AppendValues([]float32{0, 4, 1, 1, 1, 1, 27}, nil)
-
-				return b.NewArray()
-			},
-			expSubtract: []float32{1, -2, 2, 3, 4, 5, -20},
+			scenario:     "min",
+			op:           series.Rolling.Min,
+			exp:          []float64{0, 1, 1, 1, 7, 2, 2},
+			expNAIndices: []int{0},
 		},
 		{
-			scenario: "float64 column",
-			inField:  arrow.Field{Name: "f1-f64", Type: arrow.PrimitiveTypes.Float64},
-			inColumn: func(pool memory.Allocator) array.Interface {
-				b := array.NewFloat64Builder(pool)
-				defer b.Release()
-
-				b.AppendValues([]float64{1, 2, 3, 4, 5, 6, 7}, nil)
-
-				return b.NewArray()
-			},
-			inField2: arrow.Field{Name: "f1-f64", Type: arrow.PrimitiveTypes.Float64},
-			inColumn2: func(pool memory.Allocator) array.Interface {
-				b := array.NewFloat64Builder(pool)
-				defer b.Release()
-
-				b.AppendValues([]float64{0, 4, 1, 1, 1, 1, 27}, nil)
-
-				return b.NewArray()
-			},
-			expSubtract: []float64{1, -2, 2, 3, 4, 5, -20},
+			scenario:     "max",
+			op:           series.Rolling.Max,
+			exp:          []float64{0, 4, 7, 7, 9, 9, 9},
+			expNAIndices: []int{0},
 		},
 		{
-			scenario: "invalid types",
-			inField:  arrow.Field{Name: "f1-f64", Type: arrow.PrimitiveTypes.Float64},
-			inColumn: func(pool memory.Allocator) array.Interface {
-				b := array.NewFloat64Builder(pool)
-				defer b.Release()
+			scenario:     "median",
+			op:           series.Rolling.Median,
+			exp:          []float64{0, 2.5, 4, 4, 8, 5.5, 8},
+			expNAIndices: []int{0},
+		},
+		{
+			scenario:     "std",
+			op:           series.Rolling.STD,
+			exp:          []float64{0, math.Sqrt(4.5), 3, math.Sqrt(18), math.Sqrt(2), math.Sqrt(24.5), math.Sqrt(43.0 / 3)},
+			expNAIndices: []int{0},
+		},
+		{
+			scenario: "apply",
+			op: func(r series.Rolling) series.Series {
+				return r.Apply(func(vals []float64) float64 { return float64(len(vals)) })
+			},
+			exp:          []float64{0, 2, 3, 2, 2, 2, 3},
+			expNAIndices: []int{0},
+		},
+	}
 
-				b.AppendValues([]float64{1, 2, 3, 4, 5, 6, 7}, nil)
+	for _, tt := range tests {
+		t.Run(tt.scenario, func(t *testing.T) {
+			act := tt.op(s.Rolling(3, 2))
+			defer act.Release()
 
-				return b.NewArray()
-			},
-			inField2: arrow.Field{Name: "f1-f32", Type: arrow.PrimitiveTypes.Float32},
-			inColumn2: func(pool memory.Allocator) array.Interface {
-				b := array.NewFloat32Builder(pool)
-				defer b.Release()
+			assert.Equal(t, arrow.PrimitiveTypes.Float64, act.DataType())
+			assert.Equal(t, tt.expNAIndices, act.NAIndices())
+			assert.InDeltaSlice(t, tt.exp, act.Values(), 1e-9)
+		})
+	}
 
-				b.AppendValues([]float32{0, 4, 1, 1, 1, 1, 27}, nil)
+	times := series.FromTime(pool, arrow.Field{Name: "t", Type: &arrow.TimestampType{Unit: arrow.Second}}, []time.Time{
+		time.Unix(0, 0), time.Unix(10, 0), time.Unix(15, 0), time.Unix(40, 0), time.Unix(41, 0), time.Unix(42, 0), time.Unix(60, 0),
+	}, nil)
+	defer times.Release()
+	byTime := s.RollingTime(times, 20*time.Second, 1).Max()
+	defer byTime.Release()
+	assert.Equal(t, []float64{4, 4, 7, 0, 9, 9, 9}, byTime.Values())
+	assert.Equal(t, []int{3}, byTime.NAIndices())
+
+	assert.Panics(t, func() { s.Rolling(0, 1) })
+	assert.Panics(t, func() { s.RollingTime(s, time.Second, 1) })
+}
 
-				return b.NewArray()
-			},
-			expPanic: "series: subtract: series types do not match",
-		},
-		{
-			scenario: "unsupported type",
-			inField:  arrow.Field{Name: "f1-u64", Type: arrow.PrimitiveTypes.Uint64},
-			inColumn: func(pool memory.Allocator) array.Interface {
-				b := array.NewUint64Builder(pool)
-				defer b.Release()
+func TestRollingMedianMatchesSort(t *testing.T) {
+	pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	defer pool.AssertSize(t, 0)
+
+	vals := make([]float64, 200)
+	valid := make([]bool, len(vals))
+	for i := range vals {
+		vals[i] = float64((i * 37) % 11)
+		valid[i] = i%7 != 3
+	}
+	s := series.FromFloat64(pool, arrow.Field{Name: "a", Type: arrow.PrimitiveTypes.Float64}, vals, valid)
+	defer s.Release()
+
+	for _, window := range []int{1, 2, 5, 8} {
+		act := s.Rolling(window, 1).Median()
+		exp := s.Rolling(window, 1).Apply(func(xs []float64) float64 {
+			sorted := append([]float64(nil), xs...)
+			sort.Float64s(sorted)
+			m := len(sorted) / 2
+			if len(sorted)%2 != 0 {
+				return sorted[m]
+			}
+			return (sorted[m-1] + sorted[m]) / 2
+		})
+		assert.Equal(t, exp.Values(), act.Values(), "window %d", window)
+		assert.Equal(t, exp.NAIndices(), act.NAIndices(), "window %d", window)
+		act.Release()
+		exp.Release()
+	}
+}
 
-				b.AppendValues([]uint64{1, 2, 3, 4, 5, 6, 7}, nil)
+func TestRollingNonFinite(t *testing.T) {
+	nan, inf := math.NaN(), math.Inf(1)
 
-				return b.NewArray()
-			},
-			inField2: arrow.Field{Name: "f1-u64", Type: arrow.PrimitiveTypes.Uint64},
-			inColumn2: func(pool memory.Allocator) array.Interface {
-				b := array.NewUint64Builder(pool)
-				defer b.Release()
+	tests := []struct {
+		scenario string
 
-				b.AppendValues([]uint64{0, 4, 1, 1, 1, 1, 27}, nil)
+		inValues []float64
+		inWindow int
+		op       func(r series.Rolling) series.Series
 
-				return b.NewArray()
-			},
-			expPanic: "series: subtract: unsupported type",
+		exp          []float64
+		expNAIndices []int
+	}{
+		{
+			scenario:     "sum with nan passing through",
+			inValues:     []float64{1, nan, 2, 3, 4},
+			inWindow:     2,
+			op:           series.Rolling.Sum,
+			exp:          []float64{1, nan, nan, 5, 7},
+			expNAIndices: []int{},
+		},
+		{
+			scenario:     "mean with nan passing through",
+			inValues:     []float64{1, nan, 2, 3, 4},
+			inWindow:     2,
+			op:           series.Rolling.Mean,
+			exp:          []float64{1, nan, nan, 2.5, 3.5},
+			expNAIndices: []int{},
+		},
+		{
+			scenario:     "std with nan passing through",
+			inValues:     []float64{1, nan, 2, 3, 4},
+			inWindow:     2,
+			op:           series.Rolling.STD,
+			exp:          []float64{0, nan, nan, math.Sqrt(0.5), math.Sqrt(0.5)},
+			expNAIndices: []int{0},
+		},
+		{
+			scenario:     "min with nan passing through",
+			inValues:     []float64{1, nan, 2, 3, 4},
+			inWindow:     2,
+			op:           series.Rolling.Min,
+			exp:          []float64{1, nan, nan, 2, 3},
+			expNAIndices: []int{},
+		},
+		{
+			scenario:     "max with nan passing through",
+			inValues:     []float64{1, nan, 2, 3, 4},
+			inWindow:     2,
+			op:           series.Rolling.Max,
+			exp:          []float64{1, nan, nan, 3, 4},
+			expNAIndices: []int{},
+		},
+		{
+			scenario:     "median with nan passing through",
+			inValues:     []float64{1, nan, 2, 3, 4},
+			inWindow:     2,
+			op:           series.Rolling.Median,
+			exp:          []float64{1, nan, nan, 2.5, 3.5},
+			expNAIndices: []int{},
+		},
+		{
+			scenario:     "sum with infinities passing through",
+			inValues:     []float64{1, inf, -inf, 2, 3},
+			inWindow:     2,
+			op:           series.Rolling.Sum,
+			exp:          []float64{1, inf, nan, -inf, 5},
+			expNAIndices: []int{},
+		},
+		{
+			scenario:     "std with large offset and small variance",
+			inValues:     []float64{1e12 + 4, 1e12 + 7, 1e12 + 13, 1e12 + 16, 1e12 + 4},
+			inWindow:     4,
+			op:           series.Rolling.STD,
+			exp:          []float64{0, math.Sqrt(4.5), math.Sqrt(21), math.Sqrt(30), math.Sqrt(30)},
+			expNAIndices: []int{0},
 		},
 	}
 
@@ -2194,27 +4541,27 @@ func TestSubtract(t *testing.T) {
 			pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
 			defer pool.AssertSize(t, 0)
 
-			actSeries := series.FromArrow(pool, tt.inField, tt.inColumn(pool))
-			require.NotNil(t, actSeries)
-			defer actSeries.Release()
-
-			actSeries2 := series.FromArrow(pool, tt.inField2, tt.inColumn2(pool))
-			require.NotNil(t, actSeries2)
-			defer actSeries2.Release()
+			s := series.FromFloat64(pool, arrow.Field{Name: "a", Type: arrow.PrimitiveTypes.Float64}, tt.inValues, nil)
+			defer s.Release()
+			act := tt.op(s.Rolling(tt.inWindow, 1))
+			defer act.Release()
 
-			if tt.expPanic != "" {
-				assert.PanicsWithValue(t, tt.expPanic, func() { actSeries.Subtract(actSeries2) })
-				return
+			assert.Equal(t, tt.expNAIndices, act.NAIndices())
+			vals := act.Values().([]float64)
+			for i, exp := range tt.exp {
+				switch {
+				case math.IsNaN(exp):
+					assert.True(t, math.IsNaN(vals[i]), "position %d: %v", i, vals[i])
+				case math.IsInf(exp, 0):
+					assert.Equal(t, exp, vals[i], "position %d", i)
+				default:
+					assert.InDelta(t, exp, vals[i], 1e-9, "position %d", i)
+				}
 			}
-
-			actSubtract := actSeries.Subtract(actSeries2)
-			assert.Equal(t, tt.expSubtract, actSubtract.Values())
-			defer actSubtract.Release()
 		})
 	}
 }
 
-// TODO: MAKE UNSUPPORTED TYPE THAT IS NOT A REAL TYPE
 func TestAppend(t *testing.T) {
 	tests := []struct {
 		scenario string
@@ -2339,21 +4686,21 @@ func TestAppend(t *testing.T) {
 		},
 		{
 			scenario: "unsupported type",
-			inField:  arrow.Field{Name: "f1-u64", Type: arrow.PrimitiveTypes.Uint64},
+			inField:  arrow.Field{Name: "f1-str", Type: arrow.BinaryTypes.String},
 			inColumn: func(pool memory.Allocator) array.Interface {
-				b := array.NewUint64Builder(pool)
+				b := array.NewStringBuilder(pool)
 				defer b.Release()
 
-				b.AppendValues([]uint64{1, 2, 3, 4, 5, 6, 7}, nil)
+				b.AppendValues([]string{"1", "2", "3", "4", "5", "6", "7"}, nil)
 
 				return b.NewArray()
 			},
-			inField2: arrow.Field{Name: "f1-u64", Type: arrow.PrimitiveTypes.Uint64},
+			inField2: arrow.Field{Name: "f1-str", Type: arrow.BinaryTypes.String},
 			inColumn2: func(pool memory.Allocator) array.Interface {
-				b := array.NewUint64Builder(pool)
+				b := array.NewStringBuilder(pool)
 				defer b.Release()
 
-				b.AppendValues([]uint64{8, 9, 10, 11, 12, 13}, nil)
+				b.AppendValues([]string{"8", "9", "10", "11", "12", "13"}, nil)
 
 				return b.NewArray()
 			},
@@ -2386,6 +4733,65 @@ func TestAppend(t *testing.T) {
 	}
 }
 
+func TestAppendSliceWithNulls(t *testing.T) {
+	tests := []struct {
+		scenario string
+
+		inSeries func(memory.Allocator, []bool) series.Series
+
+		expParent interface{}
+		exp       interface{}
+	}{
+		{
+			scenario: "int64 column",
+			inSeries: func(pool memory.Allocator, valid []bool) series.Series {
+				return series.FromInt64(pool, arrow.Field{Name: "a", Type: arrow.PrimitiveTypes.Int64}, []int64{1, 2, 3, 4, 5}, valid)
+			},
+			expParent: []int64{1, 2, 3, 4, 5},
+			exp:       []int64{1, 2, 2, 3},
+		},
+		{
+			scenario: "float64 column",
+			inSeries: func(pool memory.Allocator, valid []bool) series.Series {
+				return series.FromFloat64(pool, arrow.Field{Name: "a", Type: arrow.PrimitiveTypes.Float64}, []float64{1, 2, 3, 4, 5}, valid)
+			},
+			expParent: []float64{1, 2, 3, 4, 5},
+			exp:       []float64{1, 2, 2, 3},
+		},
+		{
+			scenario: "uint16 column",
+			inSeries: func(pool memory.Allocator, valid []bool) series.Series {
+				return series.FromUint16(pool, arrow.Field{Name: "a", Type: arrow.PrimitiveTypes.Uint16}, []uint16{1, 2, 3, 4, 5}, valid)
+			},
+			expParent: []uint16{1, 2, 3, 4, 5},
+			exp:       []uint16{1, 2, 2, 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.scenario, func(t *testing.T) {
+			pool := memory.NewCheckedAllocator(memory.NewGoAllocator())
+			defer pool.AssertSize(t, 0)
+
+			parent := tt.inSeries(pool, []bool{true, false, true, true, true})
+			defer parent.Release()
+			head := parent.Truncate(0, 2)
+			defer head.Release()
+
+			tail := parent.Truncate(1, 3)
+			defer tail.Release()
+
+			act := head.Append(tail)
+			defer act.Release()
+
+			assert.Equal(t, tt.expParent, parent.Values())
+			assert.Equal(t, []int{1}, parent.NAIndices())
+			assert.Equal(t, tt.exp, act.Values())
+			assert.Equal(t, []int{1, 2}, act.NAIndices())
+		})
+	}
+}
+
 func TestCast(t *testing.T) {
 	type inDataType struct {
 		dataType arrow.DataType
@@ -2416,6 +4822,44 @@ func TestCast(t *testing.T) {
 				inDataType{dataType: arrow.BinaryTypes.String, expVals: []string{"1", "2", "3", "4", "5", "6"}},
 			},
 		},
+		{
+			scenario: "uint8 column",
+			inSeries: func(pool memory.Allocator) series.Series {
+				field := arrow.Field{Name: "u8", Type: arrow.PrimitiveTypes.Uint8}
+				return series.FromUint8(pool, field, []uint8{1, 2, 200}, nil)
+			},
+			inDataTypes: []inDataType{
+				inDataType{dataType: arrow.PrimitiveTypes.Int16, expVals: []int16{1, 2, 200}},
+				inDataType{dataType: arrow.PrimitiveTypes.Int32, expVals: []int32{1, 2, 200}},
+				inDataType{dataType: arrow.PrimitiveTypes.Int64, expVals: []int64{1, 2, 200}},
+				inDataType{dataType: arrow.PrimitiveTypes.Uint16, expVals: []uint16{1, 2, 200}},
+				inDataType{dataType: arrow.PrimitiveTypes.Uint32, expVals: []uint32{1, 2, 200}},
+				inDataType{dataType: arrow.PrimitiveTypes.Uint64, expVals: []uint64{1, 2, 200}},
+				inDataType{dataType: arrow.PrimitiveTypes.Float64, expVals: []float64{1, 2, 200}},
+				inDataType{dataType: arrow.BinaryTypes.String, expVals: []string{"1", "2", "200"}},
+			},
+		},
+		{
+			scenario: "string column",
+			inSeries: func(pool memory.Allocator) series.Series {
+				field := arrow.Field{Name: "str", Type: arrow.BinaryTypes.String}
+				return series.FromString(pool, field, []string{"1", "-2", "127"}, nil)
+			},
+			inDataTypes: []inDataType{
+				inDataType{dataType: arrow.PrimitiveTypes.Int8, expVals: []int8{1, -2, 127}},
+				inDataType{dataType: arrow.PrimitiveTypes.Int16, expVals: []int16{1, -2, 127}},
+			},
+		},
+		{
+			scenario: "unsigned string column",
+			inSeries: func(pool memory.Allocator) series.Series {
+				field := arrow.Field{Name: "str", Type: arrow.BinaryTypes.String}
+				return series.FromString(pool, field, []string{"1", "255", "18446744073709551615"}, nil)
+			},
+			inDataTypes: []inDataType{
+				inDataType{dataType: arrow.PrimitiveTypes.Uint64, expVals: []uint64{1, 255, 1<<64 - 1}},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,358 @@
+package series
+
+import (
+	"fmt"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/decimal128"
+)
+
+// SHIFT
+
+// ShiftOption configures Shift.
+type ShiftOption func(*shiftConfig)
+
+type shiftConfig struct {
+	fill interface{}
+}
+
+func newShiftConfig(opts ...ShiftOption) *shiftConfig {
+	cfg := &shiftConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	return cfg
+}
+
+// WithShiftFill sets the value of the positions left empty by a shift. The
+// value must have the Go type of the values of the Series, or be a Go integer
+// for an integer Series or a Go integer or float for a float Series. The
+// default is nil, which leaves nulls.
+func WithShiftFill(v interface{}) ShiftOption {
+	return func(cfg *shiftConfig) {
+		cfg.fill = v
+	}
+}
+
+// Shift returns a Series with the values moved n positions forward, or
+// backward if n is negative, keeping the length of the Series. The positions
+// left empty are null unless set otherwise by WithShiftFill.
+func (s Series) Shift(n int, opts ...ShiftOption) Series {
+	s.Retain()
+	defer s.Release()
+
+	if n == 0 {
+		// NOTE: nothing moves so the values are shared with a slice.
+		return Series{
+			pool:      s.pool,
+			field:     s.field,
+			Interface: newSlice(s.Interface, 0, int64(s.Len())),
+		}
+	}
+
+	// NOTE: the values kept are a slice of s, copied once next to the block of
+	// positions left empty.
+	empty := n
+	if empty < 0 {
+		empty = -empty
+	}
+	if empty > s.Len() {
+		empty = s.Len()
+	}
+	beg, end := 0, s.Len()-empty
+	if n < 0 {
+		beg, end = empty, s.Len()
+	}
+	kept := Series{
+		pool:      s.pool,
+		field:     s.field,
+		Interface: newSlice(s.Interface, int64(beg), int64(end)),
+	}
+	defer kept.Release()
+
+	cfg := newShiftConfig(opts...)
+	return FromArrow(s.pool, s.field, shiftArray(kept, empty, n < 0, cfg.fill))
+}
+
+// shiftArray returns an array of the values of the kept Series joined with a
+// block of n positions placed before them, or after them if after is true.
+// The block holds the value fill, or nulls if fill is nil. A panic is
+// triggered if fill can not be a value of the Series.
+func shiftArray(kept Series, n int, after bool, fill interface{}) array.Interface {
+	var (
+		appendKept func()
+		appendNull func()
+		appendFill func()
+		newArray   func() array.Interface
+	)
+	// NOTE: ok reports whether fill can be a value of the Series.
+	var ok bool
+	switch kept.field.Type {
+	case arrow.PrimitiveTypes.Int32:
+		a := kept.Interface.(*array.Int32)
+		b := array.NewInt32Builder(kept.pool)
+		defer b.Release()
+		v, isInt := scalarInt64(fill)
+		appendKept = func() { b.AppendValues(a.Int32Values(), validity(a)) }
+		appendNull, newArray, ok = b.AppendNull, b.NewArray, isInt
+		appendFill = func() { b.Append(int32(v)) }
+	case arrow.PrimitiveTypes.Int64:
+		a := kept.Interface.(*array.Int64)
+		b := array.NewInt64Builder(kept.pool)
+		defer b.Release()
+		v, isInt := scalarInt64(fill)
+		appendKept = func() { b.AppendValues(a.Int64Values(), validity(a)) }
+		appendNull, newArray, ok = b.AppendNull, b.NewArray, isInt
+		appendFill = func() { b.Append(v) }
+	case arrow.PrimitiveTypes.Float32:
+		a := kept.Interface.(*array.Float32)
+		b := array.NewFloat32Builder(kept.pool)
+		defer b.Release()
+		v, isFloat := scalarFloat64(fill)
+		appendKept = func() { b.AppendValues(a.Float32Values(), validity(a)) }
+		appendNull, newArray, ok = b.AppendNull, b.NewArray, isFloat
+		appendFill = func() { b.Append(float32(v)) }
+	case arrow.PrimitiveTypes.Float64:
+		a := kept.Interface.(*array.Float64)
+		b := array.NewFloat64Builder(kept.pool)
+		defer b.Release()
+		v, isFloat := scalarFloat64(fill)
+		appendKept = func() { b.AppendValues(a.Float64Values(), validity(a)) }
+		appendNull, newArray, ok = b.AppendNull, b.NewArray, isFloat
+		appendFill = func() { b.Append(v) }
+	case arrow.BinaryTypes.String:
+		a := kept.Interface.(*array.String)
+		b := array.NewStringBuilder(kept.pool)
+		defer b.Release()
+		v, isString := fill.(string)
+		appendKept = func() {
+			for i := 0; i < a.Len(); i++ {
+				if a.IsNull(i) {
+					b.AppendNull()
+					continue
+				}
+				b.Append(a.Value(i))
+			}
+		}
+		appendNull, newArray, ok = b.AppendNull, b.NewArray, isString
+		appendFill = func() { b.Append(v) }
+	case arrow.FixedWidthTypes.Boolean:
+		a := kept.Interface.(*array.Boolean)
+		b := array.NewBooleanBuilder(kept.pool)
+		defer b.Release()
+		v, isBool := fill.(bool)
+		appendKept = func() {
+			for i := 0; i < a.Len(); i++ {
+				if a.IsNull(i) {
+					b.AppendNull()
+					continue
+				}
+				b.Append(a.Value(i))
+			}
+		}
+		appendNull, newArray, ok = b.AppendNull, b.NewArray, isBool
+		appendFill = func() { b.Append(v) }
+	case arrow.PrimitiveTypes.Int8:
+		a := kept.Interface.(*array.Int8)
+		b := array.NewInt8Builder(kept.pool)
+		defer b.Release()
+		v, isInt := scalarInt64(fill)
+		appendKept = func() { b.AppendValues(a.Int8Values(), validity(a)) }
+		appendNull, newArray, ok = b.AppendNull, b.NewArray, isInt
+		appendFill = func() { b.Append(int8(v)) }
+	case arrow.PrimitiveTypes.Int16:
+		a := kept.Interface.(*array.Int16)
+		b := array.NewInt16Builder(kept.pool)
+		defer b.Release()
+		v, isInt := scalarInt64(fill)
+		appendKept = func() { b.AppendValues(a.Int16Values(), validity(a)) }
+		appendNull, newArray, ok = b.AppendNull, b.NewArray, isInt
+		appendFill = func() { b.Append(int16(v)) }
+	case arrow.PrimitiveTypes.Uint8:
+		a := kept.Interface.(*array.Uint8)
+		b := array.NewUint8Builder(kept.pool)
+		defer b.Release()
+		v, isUint := scalarUint64(fill)
+		appendKept = func() { b.AppendValues(a.Uint8Values(), validity(a)) }
+		appendNull, newArray, ok = b.AppendNull, b.NewArray, isUint
+		appendFill = func() { b.Append(uint8(v)) }
+	case arrow.PrimitiveTypes.Uint16:
+		a := kept.Interface.(*array.Uint16)
+		b := array.NewUint16Builder(kept.pool)
+		defer b.Release()
+		v, isUint := scalarUint64(fill)
+		appendKept = func() { b.AppendValues(a.Uint16Values(), validity(a)) }
+		appendNull, newArray, ok = b.AppendNull, b.NewArray, isUint
+		appendFill = func() { b.Append(uint16(v)) }
+	case arrow.PrimitiveTypes.Uint32:
+		a := kept.Interface.(*array.Uint32)
+		b := array.NewUint32Builder(kept.pool)
+		defer b.Release()
+		v, isUint := scalarUint64(fill)
+		appendKept = func() { b.AppendValues(a.Uint32Values(), validity(a)) }
+		appendNull, newArray, ok = b.AppendNull, b.NewArray, isUint
+		appendFill = func() { b.Append(uint32(v)) }
+	case arrow.PrimitiveTypes.Uint64:
+		a := kept.Interface.(*array.Uint64)
+		b := array.NewUint64Builder(kept.pool)
+		defer b.Release()
+		v, isUint := scalarUint64(fill)
+		appendKept = func() { b.AppendValues(a.Uint64Values(), validity(a)) }
+		appendNull, newArray, ok = b.AppendNull, b.NewArray, isUint
+		appendFill = func() { b.Append(v) }
+	case Categorical:
+		a := kept.Interface.(*categoricalArray)
+		b := array.NewInt32Builder(kept.pool)
+		defer b.Release()
+		v, isString := fill.(string)
+		code := a.code(v)
+		appendKept = func() { b.AppendValues(a.Int32.Int32Values(), validity(a.Int32)) }
+		appendNull, ok = b.AppendNull, isString && code >= 0
+		appendFill = func() { b.Append(code) }
+		newArray = func() array.Interface {
+			return newCategoricalArray(b.NewInt32Array(), a.categories)
+		}
+	default:
+		if a, isDecimal := kept.Interface.(*array.Decimal128); isDecimal {
+			b := array.NewDecimal128Builder(kept.pool, kept.field.Type.(*arrow.Decimal128Type))
+			defer b.Release()
+			v, isNum := fill.(decimal128.Num)
+			appendKept = func() { b.AppendValues(a.Values(), validity(a)) }
+			appendNull, newArray, ok = b.AppendNull, b.NewArray, isNum
+			appendFill = func() { b.Append(v) }
+			break
+		}
+		if a, isBinary := kept.Interface.(binaryArray); isBinary {
+			vals := make([][]byte, 0, a.Len()+n)
+			valid := make([]bool, 0, a.Len()+n)
+			v, isBytes := fill.([]byte)
+			appendKept = func() {
+				for i := 0; i < a.Len(); i++ {
+					vals = append(vals, a.Value(i))
+					valid = append(valid, a.IsValid(i))
+				}
+			}
+			appendNull = func() {
+				vals = append(vals, nil)
+				valid = append(valid, false)
+			}
+			appendFill = func() {
+				vals = append(vals, v)
+				valid = append(valid, true)
+			}
+			newArray = func() array.Interface {
+				return newBinaryArray(kept.pool, kept.field.Type, vals, valid)
+			}
+			ok = isBytes
+			break
+		}
+		if raw := temporalRaw(kept); raw != nil {
+			b := newTemporalBuilder(kept.pool, kept.field.Type)
+			defer b.Release()
+			v, isTime := temporalScalar(kept, fill)
+			appendKept = func() {
+				for i := 0; i < kept.Len(); i++ {
+					if kept.IsNull(i) {
+						b.AppendNull()
+						continue
+					}
+					appendTemporal(b, raw(i))
+				}
+			}
+			appendNull, newArray, ok = b.AppendNull, b.NewArray, isTime
+			appendFill = func() { appendTemporal(b, v) }
+			break
+		}
+
+		// NOTE: nested arrays have no builder for their values, so they are
+		// taken with nulls for the block and can not be filled.
+		if fill != nil {
+			break
+		}
+		indices := make([]int, kept.Len()+n)
+		for i := range indices {
+			indices[i] = -1
+		}
+		off := n
+		if after {
+			off = 0
+		}
+		for i := 0; i < kept.Len(); i++ {
+			indices[off+i] = i
+		}
+		if col := nestedTake(kept, "shift", indices); col != nil {
+			return col
+		}
+		panic("series: shift: unsupported type")
+	}
+	if fill != nil && !ok {
+		panic(fmt.Sprintf("series: shift: can not fill %s series with %T", kept.field.Type.Name(), fill))
+	}
+
+	appendBlock := func() {
+		for i := 0; i < n; i++ {
+			if fill == nil {
+				appendNull()
+				continue
+			}
+			appendFill()
+		}
+	}
+	if !after {
+		appendBlock()
+	}
+	appendKept()
+	if after {
+		appendBlock()
+	}
+
+	return newArray()
+}
+
+// Diff returns the differences between every value of a numeric Series and the
+// value periods positions before it, or after it if periods is negative.
+// Values are promoted as in Multiply, except that unsigned integers give Int64
+// so differences can be negative. Positions without a value to compare with
+// and nulls on either side give null.
+func (s Series) Diff(periods int) Series {
+	s.Retain()
+	defer s.Release()
+
+	t := promotedType(s.field.Type)
+	if t == nil {
+		panic("series: diff: unsupported type")
+	}
+	if t == arrow.PrimitiveTypes.Uint64 {
+		t = arrow.PrimitiveTypes.Int64
+	}
+
+	// NOTE: the lagged values are read from s itself instead of a shifted copy.
+	return arith("diff", s, laggedOperand(s, periods), t, OpSubtract.funcs(nil))
+}
+
+// PctChange returns the relative changes as a Float64 Series between every
+// value of a numeric Series and the value periods positions before it, or
+// after it if periods is negative. A change from zero is handled as set by
+// WithDivideByZero. Positions without a value to compare with and nulls on
+// either side give null.
+func (s Series) PctChange(periods int, opts ...DivideOption) Series {
+	s.Retain()
+	defer s.Release()
+
+	if promotedType(s.field.Type) == nil {
+		panic("series: pct_change: unsupported type")
+	}
+
+	cfg := newDivideConfig(opts...)
+	fns := arithFuncs{
+		f: func(x, y float64) (float64, bool) {
+			if y == 0 && cfg.byZero == DivideByZeroNull {
+				return 0, false
+			}
+			return (x - y) / y, true
+		},
+	}
+	return arith("pct_change", s, laggedOperand(s, periods), arrow.PrimitiveTypes.Float64, fns)
+}
@@ -0,0 +1,548 @@
+package series
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/memory"
+)
+
+// TEMPORAL
+
+// DefaultTimeLayouts are the layouts tried, in order, when a String Series is
+// cast into a Date or Timestamp type without layouts.
+var DefaultTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// dateLayout is the layout used to format Date values.
+const dateLayout = "2006-01-02"
+
+const (
+	secondsPerDay = 24 * 60 * 60
+	millisPerDay  = secondsPerDay * 1000
+)
+
+// FromDate32 creates a Series from a slice of Date32 values, the number of days
+// since the UNIX epoch.
+func FromDate32(pool memory.Allocator, field arrow.Field, vals []arrow.Date32, valid []bool) Series {
+	b := array.NewDate32Builder(pool)
+	defer b.Release()
+	b.AppendValues(vals, valid)
+
+	return Series{
+		pool:      pool,
+		field:     field,
+		Interface: b.NewArray(),
+	}
+}
+
+// FromDate64 creates a Series from a slice of Date64 values, the number of
+// milliseconds since the UNIX epoch.
+func FromDate64(pool memory.Allocator, field arrow.Field, vals []arrow.Date64, valid []bool) Series {
+	b := array.NewDate64Builder(pool)
+	defer b.Release()
+	b.AppendValues(vals, valid)
+
+	return Series{
+		pool:      pool,
+		field:     field,
+		Interface: b.NewArray(),
+	}
+}
+
+// FromTimestamp creates a Series from a slice of Timestamp values in the unit
+// of the field type, which must be a Timestamp type.
+func FromTimestamp(pool memory.Allocator, field arrow.Field, vals []arrow.Timestamp, valid []bool) Series {
+	t, ok := field.Type.(*arrow.TimestampType)
+	if !ok {
+		panic("series: from_timestamp: field type is not a timestamp")
+	}
+
+	b := array.NewTimestampBuilder(pool, t)
+	defer b.Release()
+	b.AppendValues(vals, valid)
+
+	return Series{
+		pool:      pool,
+		field:     field,
+		Interface: b.NewArray(),
+	}
+}
+
+// FromDuration creates a Series from a slice of Duration values in the unit of
+// the field type, which must be a Duration type.
+func FromDuration(pool memory.Allocator, field arrow.Field, vals []arrow.Duration, valid []bool) Series {
+	t, ok := field.Type.(*arrow.DurationType)
+	if !ok {
+		panic("series: from_duration: field type is not a duration")
+	}
+
+	b := array.NewDurationBuilder(pool, t)
+	defer b.Release()
+	b.AppendValues(vals, valid)
+
+	return Series{
+		pool:      pool,
+		field:     field,
+		Interface: b.NewArray(),
+	}
+}
+
+// FromTime creates a Series from a slice of times. The field type must be a
+// Date32, Date64 or Timestamp type. Dates hold the calendar day of each time
+// in its own location.
+func FromTime(pool memory.Allocator, field arrow.Field, vals []time.Time, valid []bool) Series {
+	if !isTemporal(field.Type) || field.Type.ID() == arrow.DURATION {
+		panic("series: from_time: field type is not a date or timestamp")
+	}
+
+	b := newTemporalBuilder(pool, field.Type)
+	defer b.Release()
+	for i, v := range vals {
+		if valid != nil && !valid[i] {
+			b.AppendNull()
+			continue
+		}
+		appendTemporal(b, timeToTemporal(v, field.Type))
+	}
+
+	return Series{
+		pool:      pool,
+		field:     field,
+		Interface: b.NewArray(),
+	}
+}
+
+// Time returns the value at position i from a Date or Timestamp Series as a
+// time. Dates are returned as midnight UTC and timestamps in the location of
+// their time zone.
+func (s Series) Time(i int) time.Time {
+	s.Retain()
+	defer s.Release()
+
+	return timeAccessor("time", s)(i)
+}
+
+// timeAccessor returns a function which returns the value at position i of a
+// Date or Timestamp Series as a time, as Series.Time does.
+func timeAccessor(method string, s Series) func(i int) time.Time {
+	switch a := s.Interface.(type) {
+	case *array.Date32:
+		return func(i int) time.Time { return Date32ToTime(a.Value(i)) }
+	case *array.Date64:
+		return func(i int) time.Time { return Date64ToTime(a.Value(i)) }
+	case *array.Timestamp:
+		t := s.field.Type.(*arrow.TimestampType)
+		loc := timeLocation(method, t.TimeZone)
+		return func(i int) time.Time { return TimestampToTime(a.Value(i), t.Unit).In(loc) }
+	default:
+		panic(fmt.Sprintf("series: %s: unsupported type", method))
+	}
+}
+
+// isTemporal reports whether t is a Date, Timestamp or Duration type.
+func isTemporal(t arrow.DataType) bool {
+	switch t.ID() {
+	case arrow.DATE32, arrow.DATE64, arrow.TIMESTAMP, arrow.DURATION:
+		return true
+	}
+	return false
+}
+
+// TimeLocation returns the location of an Arrow time zone. An empty time zone
+// is treated as UTC.
+func TimeLocation(tz string) (*time.Location, error) {
+	if tz == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(tz)
+}
+
+// timeLocation returns the location of an Arrow time zone. A panic is
+// triggered if the time zone is unknown.
+func timeLocation(method, tz string) *time.Location {
+	loc, err := TimeLocation(tz)
+	if err != nil {
+		panic(fmt.Sprintf("series: %s: %s", method, err))
+	}
+	return loc
+}
+
+// UnitDuration returns the length of an Arrow time unit.
+func UnitDuration(unit arrow.TimeUnit) time.Duration {
+	switch unit {
+	case arrow.Second:
+		return time.Second
+	case arrow.Millisecond:
+		return time.Millisecond
+	case arrow.Microsecond:
+		return time.Microsecond
+	default:
+		return time.Nanosecond
+	}
+}
+
+// TimeToTimestamp converts v into an Arrow timestamp with the given unit.
+func TimeToTimestamp(v time.Time, unit arrow.TimeUnit) arrow.Timestamp {
+	if unit == arrow.Second {
+		return arrow.Timestamp(v.Unix())
+	}
+	return arrow.Timestamp(v.UnixNano() / int64(UnitDuration(unit)))
+}
+
+// TimestampToTime converts an Arrow timestamp with the given unit into a UTC
+// time.
+func TimestampToTime(v arrow.Timestamp, unit arrow.TimeUnit) time.Time {
+	if unit == arrow.Second {
+		return time.Unix(int64(v), 0).UTC()
+	}
+	return time.Unix(0, int64(v)*int64(UnitDuration(unit))).UTC()
+}
+
+// TimeToDate32 returns the calendar day of v in its location as a Date32.
+func TimeToDate32(v time.Time) arrow.Date32 {
+	y, m, d := v.Date()
+	return arrow.Date32(time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix() / secondsPerDay)
+}
+
+// Date32ToTime returns the start of the Date32 day as a UTC time.
+func Date32ToTime(v arrow.Date32) time.Time {
+	return time.Unix(int64(v)*secondsPerDay, 0).UTC()
+}
+
+// Date64ToTime converts a Date64 into a UTC time.
+func Date64ToTime(v arrow.Date64) time.Time {
+	return time.Unix(0, int64(v)*int64(time.Millisecond)).UTC()
+}
+
+// timeToTemporal converts v into the raw value of the Date or Timestamp type
+// t.
+func timeToTemporal(v time.Time, t arrow.DataType) int64 {
+	switch t := t.(type) {
+	case *arrow.Date32Type:
+		return int64(TimeToDate32(v))
+	case *arrow.Date64Type:
+		return int64(TimeToDate32(v)) * millisPerDay
+	case *arrow.TimestampType:
+		return int64(TimeToTimestamp(v, t.Unit))
+	default:
+		panic(fmt.Sprintf("series: cast: can not convert time to %s", t.Name()))
+	}
+}
+
+// newTemporalBuilder returns a builder for the temporal type t.
+func newTemporalBuilder(pool memory.Allocator, t arrow.DataType) array.Builder {
+	switch t := t.(type) {
+	case *arrow.Date32Type:
+		return array.NewDate32Builder(pool)
+	case *arrow.Date64Type:
+		return array.NewDate64Builder(pool)
+	case *arrow.TimestampType:
+		return array.NewTimestampBuilder(pool, t)
+	case *arrow.DurationType:
+		return array.NewDurationBuilder(pool, t)
+	default:
+		panic(fmt.Sprintf("series: unsupported temporal type %s", t.Name()))
+	}
+}
+
+// appendTemporal appends the raw value v to a temporal builder.
+func appendTemporal(b array.Builder, v int64) {
+	switch b := b.(type) {
+	case *array.Date32Builder:
+		b.Append(arrow.Date32(v))
+	case *array.Date64Builder:
+		b.Append(arrow.Date64(v))
+	case *array.TimestampBuilder:
+		b.Append(arrow.Timestamp(v))
+	case *array.DurationBuilder:
+		b.Append(arrow.Duration(v))
+	}
+}
+
+// temporalRaw returns a function which returns the raw value at position i of
+// a temporal Series as an int64, or nil if the Series is not temporal.
+func temporalRaw(s Series) func(i int) int64 {
+	switch a := s.Interface.(type) {
+	case *array.Date32:
+		return func(i int) int64 { return int64(a.Value(i)) }
+	case *array.Date64:
+		return func(i int) int64 { return int64(a.Value(i)) }
+	case *array.Timestamp:
+		return func(i int) int64 { return int64(a.Value(i)) }
+	case *array.Duration:
+		return func(i int) int64 { return int64(a.Value(i)) }
+	}
+	return nil
+}
+
+// temporalValue returns the value at position i of a temporal Series.
+func temporalValue(s Series, i int) (interface{}, bool) {
+	switch a := s.Interface.(type) {
+	case *array.Date32:
+		return a.Value(i), true
+	case *array.Date64:
+		return a.Value(i), true
+	case *array.Timestamp:
+		return a.Value(i), true
+	case *array.Duration:
+		return a.Value(i), true
+	}
+	return nil, false
+}
+
+// temporalValues returns the values of a temporal Series as a slice of Arrow
+// values.
+func temporalValues(s Series) (interface{}, bool) {
+	switch a := s.Interface.(type) {
+	case *array.Date32:
+		return a.Date32Values(), true
+	case *array.Date64:
+		return a.Date64Values(), true
+	case *array.Timestamp:
+		return a.TimestampValues(), true
+	case *array.Duration:
+		return a.DurationValues(), true
+	}
+	return nil, false
+}
+
+// temporalFormatter returns a function which formats the value at position i
+// of a temporal Series, or nil if the Series is not temporal. Dates are
+// formatted as 2006-01-02, timestamps in RFC 3339 format in the location of
+// their time zone and durations as time.Duration strings.
+func temporalFormatter(s Series) func(i int) string {
+	switch a := s.Interface.(type) {
+	case *array.Date32:
+		return func(i int) string { return Date32ToTime(a.Value(i)).Format(dateLayout) }
+	case *array.Date64:
+		return func(i int) string { return Date64ToTime(a.Value(i)).Format(dateLayout) }
+	case *array.Timestamp:
+		at := timeAccessor("format", s)
+		return func(i int) string { return at(i).Format(time.RFC3339Nano) }
+	case *array.Duration:
+		unit := UnitDuration(s.field.Type.(*arrow.DurationType).Unit)
+		return func(i int) string { return (time.Duration(a.Value(i)) * unit).String() }
+	}
+	return nil
+}
+
+// CastTime returns a new Series of the temporal type t, parsing the values of
+// a String Series with the first matching layout. DefaultTimeLayouts are used
+// if no layouts are given. Values without a time zone are parsed in the time
+// zone of a Timestamp type and in UTC otherwise. Durations are parsed with
+// time.ParseDuration. Other Series are cast as with Cast. Null values stay
+// null.
+func (s Series) CastTime(t arrow.DataType, layouts ...string) Series {
+	s.Retain()
+	defer s.Release()
+
+	if !isTemporal(t) {
+		panic(fmt.Sprintf("series: cast_time: %s is not a temporal type", t.Name()))
+	}
+	if len(layouts) == 0 {
+		layouts = DefaultTimeLayouts
+	}
+	return castToTemporal(s, t, layouts)
+}
+
+// castToTemporal casts the s Series into the temporal type t. Strings are
+// parsed with layouts, integers are used as raw values in the unit of t and
+// other temporal values are converted. Null values stay null.
+func castToTemporal(s Series, t arrow.DataType, layouts []string) Series {
+	f := s.field
+	f.Type = t
+	if arrow.TypeEqual(s.field.Type, t) {
+		s.Retain()
+		return FromArrow(s.pool, f, s.Interface)
+	}
+
+	var convert func(i int) int64
+	switch a := s.Interface.(type) {
+	case *array.String:
+		convert = parseTemporal(a, t, layouts)
+	case *array.Int32:
+		convert = func(i int) int64 { return int64(a.Value(i)) }
+	case *array.Int64:
+		convert = a.Value
+	case *array.Date32, *array.Date64, *array.Timestamp:
+		if t.ID() == arrow.DURATION {
+			panic(fmt.Sprintf("series: cast: can not cast %s to %s", s.field.Type.Name(), t.Name()))
+		}
+		at := timeAccessor("cast", s)
+		convert = func(i int) int64 { return timeToTemporal(at(i), t) }
+	case *array.Duration:
+		dt, ok := t.(*arrow.DurationType)
+		if !ok {
+			panic(fmt.Sprintf("series: cast: can not cast %s to %s", s.field.Type.Name(), t.Name()))
+		}
+		from := UnitDuration(s.field.Type.(*arrow.DurationType).Unit)
+		to := UnitDuration(dt.Unit)
+		convert = func(i int) int64 { return int64(time.Duration(a.Value(i)) * from / to) }
+	default:
+		panic("series: cast: unsupported type")
+	}
+
+	b := newTemporalBuilder(s.pool, t)
+	defer b.Release()
+	b.Reserve(s.Len())
+	for i := 0; i < s.Len(); i++ {
+		if s.IsNull(i) {
+			b.AppendNull()
+			continue
+		}
+		appendTemporal(b, convert(i))
+	}
+
+	return FromArrow(s.pool, f, b.NewArray())
+}
+
+// parseTemporal returns a function which parses the value at position i of a
+// String array into the raw value of the temporal type t.
+func parseTemporal(a *array.String, t arrow.DataType, layouts []string) func(i int) int64 {
+	if dt, ok := t.(*arrow.DurationType); ok {
+		unit := UnitDuration(dt.Unit)
+		return func(i int) int64 {
+			d, err := time.ParseDuration(a.Value(i))
+			if err != nil {
+				panic(fmt.Sprintf("series: cast: %s", err))
+			}
+			return int64(d / unit)
+		}
+	}
+
+	loc := time.UTC
+	if ts, ok := t.(*arrow.TimestampType); ok {
+		loc = timeLocation("cast", ts.TimeZone)
+	}
+	return func(i int) int64 {
+		v, err := parseTime(a.Value(i), layouts, loc)
+		if err != nil {
+			panic(fmt.Sprintf("series: cast: %s", err))
+		}
+		return timeToTemporal(v, t)
+	}
+}
+
+// parseTime parses val with the first matching layout. Values without a time
+// zone are parsed in loc.
+func parseTime(val string, layouts []string, loc *time.Location) (time.Time, error) {
+	for _, layout := range layouts {
+		if v, err := time.ParseInLocation(layout, val, loc); err == nil {
+			return v, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("parsing time %q: no matching layout", val)
+}
+
+// temporalScalar returns the scalar v as a raw value of the temporal s Series.
+// Dates and timestamps can be compared with times, durations with
+// time.Durations, and any temporal Series with Arrow values of its type or
+// integers holding raw values.
+func temporalScalar(s Series, v interface{}) (int64, bool) {
+	switch v := v.(type) {
+	case time.Time:
+		if s.field.Type.ID() == arrow.DURATION {
+			return 0, false
+		}
+		return timeToTemporal(v, s.field.Type), true
+	case time.Duration:
+		dt, ok := s.field.Type.(*arrow.DurationType)
+		if !ok {
+			return 0, false
+		}
+		return int64(v / UnitDuration(dt.Unit)), true
+	case arrow.Date32:
+		return int64(v), s.field.Type.ID() == arrow.DATE32
+	case arrow.Date64:
+		return int64(v), s.field.Type.ID() == arrow.DATE64
+	case arrow.Timestamp:
+		return int64(v), s.field.Type.ID() == arrow.TIMESTAMP
+	case arrow.Duration:
+		return int64(v), s.field.Type.ID() == arrow.DURATION
+	}
+	return scalarInt64(v)
+}
+
+// temporalSortValues returns a temporal Series with sorted values. Null values
+// are placed last.
+func temporalSortValues(s Series) Series {
+	raw := temporalRaw(s)
+
+	return s.take("sort_values", sortedIndices(s, func(i, j int) bool {
+		return raw(i) < raw(j)
+	}))
+}
+
+// MinTime returns the minimum value of a Date or Timestamp Series as a time,
+// as Series.Time does, and whether there is such a value. Unlike Min the value
+// is exact at every time unit. Null values are skipped unless set otherwise by
+// WithSkipNA.
+func (s Series) MinTime(opts ...AggOption) (time.Time, bool) {
+	return s.extremeTime("min_time", false, opts)
+}
+
+// MaxTime returns the maximum value of a Date or Timestamp Series as a time,
+// as Series.Time does, and whether there is such a value. Unlike Max the value
+// is exact at every time unit. Null values are skipped unless set otherwise by
+// WithSkipNA.
+func (s Series) MaxTime(opts ...AggOption) (time.Time, bool) {
+	return s.extremeTime("max_time", true, opts)
+}
+
+// extremeTime returns the minimum, or maximum if max is true, of a Date or
+// Timestamp Series as a time.
+func (s Series) extremeTime(method string, max bool, opts []AggOption) (time.Time, bool) {
+	s.Retain()
+	defer s.Release()
+
+	at := timeAccessor(method, s)
+	if newAggConfig(opts...).propagatesNA(s) {
+		return time.Time{}, false
+	}
+	i := temporalExtremeIndex(s, max)
+	if i < 0 {
+		return time.Time{}, false
+	}
+
+	return at(i), true
+}
+
+// temporalExtreme returns the raw minimum, or maximum if max is true, of the
+// valid values of a temporal Series as a float64. NaN is returned if the
+// Series has no valid values.
+func temporalExtreme(s Series, max bool) float64 {
+	i := temporalExtremeIndex(s, max)
+	if i < 0 {
+		return math.NaN()
+	}
+
+	return float64(temporalRaw(s)(i))
+}
+
+// temporalExtremeIndex returns the position of the first minimum, or maximum
+// if max is true, of the valid values of a temporal Series, or -1 if the
+// Series has no valid values.
+func temporalExtremeIndex(s Series, max bool) int {
+	raw := temporalRaw(s)
+
+	res := -1
+	var v int64
+	for i := 0; i < s.Len(); i++ {
+		if s.IsNull(i) {
+			continue
+		}
+		x := raw(i)
+		if res < 0 || (max && x > v) || (!max && x < v) {
+			res, v = i, x
+		}
+	}
+
+	return res
+}